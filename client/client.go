@@ -323,6 +323,122 @@ func (c *Client) DeleteAPIKeyContext(ctx context.Context, apiKeyID int64) error
 	return c.request.Delete(ctx, fmt.Sprintf("/v1/api-keys/%d", apiKeyID))
 }
 
+// PublicFeeds returns all public feeds for the authenticated user.
+func (c *Client) PublicFeeds() (PublicFeeds, error) {
+	ctx, cancel := withDefaultTimeout()
+	defer cancel()
+	return c.PublicFeedsContext(ctx)
+}
+
+// PublicFeedsContext returns all public feeds for the authenticated user.
+func (c *Client) PublicFeedsContext(ctx context.Context) (PublicFeeds, error) {
+	body, err := c.request.Get(ctx, "/v1/public-feeds")
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var publicFeeds PublicFeeds
+	if err := json.NewDecoder(body).Decode(&publicFeeds); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return publicFeeds, nil
+}
+
+// CreatePublicFeed creates a new public feed for the authenticated user.
+func (c *Client) CreatePublicFeed(publicFeedCreationRequest *PublicFeedCreationRequest) (*PublicFeed, error) {
+	ctx, cancel := withDefaultTimeout()
+	defer cancel()
+	return c.CreatePublicFeedContext(ctx, publicFeedCreationRequest)
+}
+
+// CreatePublicFeedContext creates a new public feed for the authenticated user.
+func (c *Client) CreatePublicFeedContext(ctx context.Context, publicFeedCreationRequest *PublicFeedCreationRequest) (*PublicFeed, error) {
+	body, err := c.request.Post(ctx, "/v1/public-feeds", publicFeedCreationRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var publicFeed *PublicFeed
+	if err := json.NewDecoder(body).Decode(&publicFeed); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return publicFeed, nil
+}
+
+// DeletePublicFeed removes a public feed and revokes its token.
+func (c *Client) DeletePublicFeed(publicFeedID int64) error {
+	ctx, cancel := withDefaultTimeout()
+	defer cancel()
+	return c.DeletePublicFeedContext(ctx, publicFeedID)
+}
+
+// DeletePublicFeedContext removes a public feed and revokes its token.
+func (c *Client) DeletePublicFeedContext(ctx context.Context, publicFeedID int64) error {
+	return c.request.Delete(ctx, fmt.Sprintf("/v1/public-feeds/%d", publicFeedID))
+}
+
+// NewsletterAddresses returns all newsletter addresses for the authenticated user.
+func (c *Client) NewsletterAddresses() (NewsletterAddresses, error) {
+	ctx, cancel := withDefaultTimeout()
+	defer cancel()
+	return c.NewsletterAddressesContext(ctx)
+}
+
+// NewsletterAddressesContext returns all newsletter addresses for the authenticated user.
+func (c *Client) NewsletterAddressesContext(ctx context.Context) (NewsletterAddresses, error) {
+	body, err := c.request.Get(ctx, "/v1/newsletter-addresses")
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var addresses NewsletterAddresses
+	if err := json.NewDecoder(body).Decode(&addresses); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return addresses, nil
+}
+
+// CreateNewsletterAddress creates a new newsletter address for the authenticated user.
+func (c *Client) CreateNewsletterAddress(addressCreationRequest *NewsletterAddressCreationRequest) (*NewsletterAddress, error) {
+	ctx, cancel := withDefaultTimeout()
+	defer cancel()
+	return c.CreateNewsletterAddressContext(ctx, addressCreationRequest)
+}
+
+// CreateNewsletterAddressContext creates a new newsletter address for the authenticated user.
+func (c *Client) CreateNewsletterAddressContext(ctx context.Context, addressCreationRequest *NewsletterAddressCreationRequest) (*NewsletterAddress, error) {
+	body, err := c.request.Post(ctx, "/v1/newsletter-addresses", addressCreationRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var address *NewsletterAddress
+	if err := json.NewDecoder(body).Decode(&address); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return address, nil
+}
+
+// DeleteNewsletterAddress removes a newsletter address, the feeds created for its senders are kept.
+func (c *Client) DeleteNewsletterAddress(addressID int64) error {
+	ctx, cancel := withDefaultTimeout()
+	defer cancel()
+	return c.DeleteNewsletterAddressContext(ctx, addressID)
+}
+
+// DeleteNewsletterAddressContext removes a newsletter address, the feeds created for its senders are kept.
+func (c *Client) DeleteNewsletterAddressContext(ctx context.Context, addressID int64) error {
+	return c.request.Delete(ctx, fmt.Sprintf("/v1/newsletter-addresses/%d", addressID))
+}
+
 // MarkAllAsRead marks all unread entries as read for a given user.
 func (c *Client) MarkAllAsRead(userID int64) error {
 	ctx, cancel := withDefaultTimeout()
@@ -587,6 +703,419 @@ func (c *Client) RefreshCategoryContext(ctx context.Context, categoryID int64) e
 	return err
 }
 
+// Labels retrieves the list of labels.
+func (c *Client) Labels() (Labels, error) {
+	ctx, cancel := withDefaultTimeout()
+	defer cancel()
+	return c.LabelsContext(ctx)
+}
+
+// LabelsContext retrieves the list of labels.
+func (c *Client) LabelsContext(ctx context.Context) (Labels, error) {
+	body, err := c.request.Get(ctx, "/v1/labels")
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var labels Labels
+	if err := json.NewDecoder(body).Decode(&labels); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return labels, nil
+}
+
+// LabelsWithCounters fetches the labels with their respective entry and unread counts.
+func (c *Client) LabelsWithCounters() (Labels, error) {
+	ctx, cancel := withDefaultTimeout()
+	defer cancel()
+	return c.LabelsWithCountersContext(ctx)
+}
+
+// LabelsWithCountersContext fetches the labels with their respective entry and unread counts.
+func (c *Client) LabelsWithCountersContext(ctx context.Context) (Labels, error) {
+	body, err := c.request.Get(ctx, "/v1/labels?counts=true")
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var labels Labels
+	if err := json.NewDecoder(body).Decode(&labels); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return labels, nil
+}
+
+// CreateLabel creates a new label.
+func (c *Client) CreateLabel(title string) (*Label, error) {
+	ctx, cancel := withDefaultTimeout()
+	defer cancel()
+	return c.CreateLabelContext(ctx, title)
+}
+
+// CreateLabelContext creates a new label.
+func (c *Client) CreateLabelContext(ctx context.Context, title string) (*Label, error) {
+	body, err := c.request.Post(ctx, "/v1/labels", &LabelCreationRequest{
+		Title: title,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var label *Label
+	if err := json.NewDecoder(body).Decode(&label); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return label, nil
+}
+
+// UpdateLabel renames a label.
+func (c *Client) UpdateLabel(labelID int64, title string) (*Label, error) {
+	ctx, cancel := withDefaultTimeout()
+	defer cancel()
+	return c.UpdateLabelContext(ctx, labelID, title)
+}
+
+// UpdateLabelContext renames a label.
+func (c *Client) UpdateLabelContext(ctx context.Context, labelID int64, title string) (*Label, error) {
+	body, err := c.request.Put(ctx, fmt.Sprintf("/v1/labels/%d", labelID), &LabelModificationRequest{
+		Title: new(title),
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var label *Label
+	if err := json.NewDecoder(body).Decode(&label); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return label, nil
+}
+
+// DeleteLabel removes a label, the entries are kept.
+func (c *Client) DeleteLabel(labelID int64) error {
+	ctx, cancel := withDefaultTimeout()
+	defer cancel()
+	return c.DeleteLabelContext(ctx, labelID)
+}
+
+// DeleteLabelContext removes a label, the entries are kept.
+func (c *Client) DeleteLabelContext(ctx context.Context, labelID int64) error {
+	return c.request.Delete(ctx, fmt.Sprintf("/v1/labels/%d", labelID))
+}
+
+// SavedSearches retrieves the list of saved searches.
+func (c *Client) SavedSearches() (SavedSearches, error) {
+	ctx, cancel := withDefaultTimeout()
+	defer cancel()
+	return c.SavedSearchesContext(ctx)
+}
+
+// SavedSearchesContext retrieves the list of saved searches.
+func (c *Client) SavedSearchesContext(ctx context.Context) (SavedSearches, error) {
+	return c.fetchSavedSearches(ctx, "/v1/saved-searches")
+}
+
+// SavedSearchesWithCounters fetches the saved searches with their unread counts.
+func (c *Client) SavedSearchesWithCounters() (SavedSearches, error) {
+	ctx, cancel := withDefaultTimeout()
+	defer cancel()
+	return c.SavedSearchesWithCountersContext(ctx)
+}
+
+// SavedSearchesWithCountersContext fetches the saved searches with their unread counts.
+func (c *Client) SavedSearchesWithCountersContext(ctx context.Context) (SavedSearches, error) {
+	return c.fetchSavedSearches(ctx, "/v1/saved-searches?counts=true")
+}
+
+func (c *Client) fetchSavedSearches(ctx context.Context, path string) (SavedSearches, error) {
+	body, err := c.request.Get(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var savedSearches SavedSearches
+	if err := json.NewDecoder(body).Decode(&savedSearches); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return savedSearches, nil
+}
+
+// SavedSearch gets a saved search.
+func (c *Client) SavedSearch(savedSearchID int64) (*SavedSearch, error) {
+	ctx, cancel := withDefaultTimeout()
+	defer cancel()
+	return c.SavedSearchContext(ctx, savedSearchID)
+}
+
+// SavedSearchContext gets a saved search.
+func (c *Client) SavedSearchContext(ctx context.Context, savedSearchID int64) (*SavedSearch, error) {
+	body, err := c.request.Get(ctx, fmt.Sprintf("/v1/saved-searches/%d", savedSearchID))
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var savedSearch *SavedSearch
+	if err := json.NewDecoder(body).Decode(&savedSearch); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return savedSearch, nil
+}
+
+// CreateSavedSearch creates a new saved search.
+func (c *Client) CreateSavedSearch(savedSearchCreationRequest *SavedSearchCreationRequest) (*SavedSearch, error) {
+	ctx, cancel := withDefaultTimeout()
+	defer cancel()
+	return c.CreateSavedSearchContext(ctx, savedSearchCreationRequest)
+}
+
+// CreateSavedSearchContext creates a new saved search.
+func (c *Client) CreateSavedSearchContext(ctx context.Context, savedSearchCreationRequest *SavedSearchCreationRequest) (*SavedSearch, error) {
+	body, err := c.request.Post(ctx, "/v1/saved-searches", savedSearchCreationRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var savedSearch *SavedSearch
+	if err := json.NewDecoder(body).Decode(&savedSearch); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return savedSearch, nil
+}
+
+// UpdateSavedSearch updates a saved search.
+func (c *Client) UpdateSavedSearch(savedSearchID int64, savedSearchChanges *SavedSearchModificationRequest) (*SavedSearch, error) {
+	ctx, cancel := withDefaultTimeout()
+	defer cancel()
+	return c.UpdateSavedSearchContext(ctx, savedSearchID, savedSearchChanges)
+}
+
+// UpdateSavedSearchContext updates a saved search.
+func (c *Client) UpdateSavedSearchContext(ctx context.Context, savedSearchID int64, savedSearchChanges *SavedSearchModificationRequest) (*SavedSearch, error) {
+	body, err := c.request.Put(ctx, fmt.Sprintf("/v1/saved-searches/%d", savedSearchID), savedSearchChanges)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var savedSearch *SavedSearch
+	if err := json.NewDecoder(body).Decode(&savedSearch); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return savedSearch, nil
+}
+
+// DeleteSavedSearch removes a saved search, the entries are kept.
+func (c *Client) DeleteSavedSearch(savedSearchID int64) error {
+	ctx, cancel := withDefaultTimeout()
+	defer cancel()
+	return c.DeleteSavedSearchContext(ctx, savedSearchID)
+}
+
+// DeleteSavedSearchContext removes a saved search, the entries are kept.
+func (c *Client) DeleteSavedSearchContext(ctx context.Context, savedSearchID int64) error {
+	return c.request.Delete(ctx, fmt.Sprintf("/v1/saved-searches/%d", savedSearchID))
+}
+
+// SavedSearchEntries fetches the entries matching a saved search.
+func (c *Client) SavedSearchEntries(savedSearchID int64, filter *Filter) (*EntryResultSet, error) {
+	ctx, cancel := withDefaultTimeout()
+	defer cancel()
+	return c.SavedSearchEntriesContext(ctx, savedSearchID, filter)
+}
+
+// SavedSearchEntriesContext fetches the entries matching a saved search.
+func (c *Client) SavedSearchEntriesContext(ctx context.Context, savedSearchID int64, filter *Filter) (*EntryResultSet, error) {
+	path := buildFilterQueryString(fmt.Sprintf("/v1/saved-searches/%d/entries", savedSearchID), filter)
+
+	body, err := c.request.Get(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var result EntryResultSet
+	if err := json.NewDecoder(body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return &result, nil
+}
+
+// MarkSavedSearchAsRead marks all unread entries matching a saved search as read.
+func (c *Client) MarkSavedSearchAsRead(savedSearchID int64) error {
+	ctx, cancel := withDefaultTimeout()
+	defer cancel()
+	return c.MarkSavedSearchAsReadContext(ctx, savedSearchID)
+}
+
+// MarkSavedSearchAsReadContext marks all unread entries matching a saved search as read.
+func (c *Client) MarkSavedSearchAsReadContext(ctx context.Context, savedSearchID int64) error {
+	_, err := c.request.Put(ctx, fmt.Sprintf("/v1/saved-searches/%d/mark-all-as-read", savedSearchID), nil)
+	return err
+}
+
+// AddEntryLabel attaches a label to an entry.
+func (c *Client) AddEntryLabel(entryID, labelID int64) error {
+	ctx, cancel := withDefaultTimeout()
+	defer cancel()
+	return c.AddEntryLabelContext(ctx, entryID, labelID)
+}
+
+// AddEntryLabelContext attaches a label to an entry.
+func (c *Client) AddEntryLabelContext(ctx context.Context, entryID, labelID int64) error {
+	_, err := c.request.Put(ctx, fmt.Sprintf("/v1/entries/%d/labels/%d", entryID, labelID), nil)
+	return err
+}
+
+// RemoveEntryLabel detaches a label from an entry.
+func (c *Client) RemoveEntryLabel(entryID, labelID int64) error {
+	ctx, cancel := withDefaultTimeout()
+	defer cancel()
+	return c.RemoveEntryLabelContext(ctx, entryID, labelID)
+}
+
+// RemoveEntryLabelContext detaches a label from an entry.
+func (c *Client) RemoveEntryLabelContext(ctx context.Context, entryID, labelID int64) error {
+	return c.request.Delete(ctx, fmt.Sprintf("/v1/entries/%d/labels/%d", entryID, labelID))
+}
+
+// Highlights fetches the highlights across all entries, the most recent first.
+func (c *Client) Highlights(limit, offset int) (*HighlightResultSet, error) {
+	ctx, cancel := withDefaultTimeout()
+	defer cancel()
+	return c.HighlightsContext(ctx, limit, offset)
+}
+
+// HighlightsContext fetches the highlights across all entries, the most recent first.
+func (c *Client) HighlightsContext(ctx context.Context, limit, offset int) (*HighlightResultSet, error) {
+	body, err := c.request.Get(ctx, fmt.Sprintf("/v1/highlights?limit=%d&offset=%d", limit, offset))
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var result HighlightResultSet
+	if err := json.NewDecoder(body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return &result, nil
+}
+
+// ExportHighlights exports all the highlights as a Markdown document.
+func (c *Client) ExportHighlights() ([]byte, error) {
+	ctx, cancel := withDefaultTimeout()
+	defer cancel()
+	return c.ExportHighlightsContext(ctx)
+}
+
+// ExportHighlightsContext exports all the highlights as a Markdown document.
+func (c *Client) ExportHighlightsContext(ctx context.Context) ([]byte, error) {
+	body, err := c.request.Get(ctx, "/v1/highlights/export")
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	return io.ReadAll(body)
+}
+
+// EntryHighlights fetches the highlights of an entry.
+func (c *Client) EntryHighlights(entryID int64) (Highlights, error) {
+	ctx, cancel := withDefaultTimeout()
+	defer cancel()
+	return c.EntryHighlightsContext(ctx, entryID)
+}
+
+// EntryHighlightsContext fetches the highlights of an entry.
+func (c *Client) EntryHighlightsContext(ctx context.Context, entryID int64) (Highlights, error) {
+	body, err := c.request.Get(ctx, fmt.Sprintf("/v1/entries/%d/highlights", entryID))
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var highlights Highlights
+	if err := json.NewDecoder(body).Decode(&highlights); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return highlights, nil
+}
+
+// CreateHighlight highlights a passage of an entry.
+func (c *Client) CreateHighlight(entryID int64, highlightCreationRequest *HighlightCreationRequest) (*Highlight, error) {
+	ctx, cancel := withDefaultTimeout()
+	defer cancel()
+	return c.CreateHighlightContext(ctx, entryID, highlightCreationRequest)
+}
+
+// CreateHighlightContext highlights a passage of an entry.
+func (c *Client) CreateHighlightContext(ctx context.Context, entryID int64, highlightCreationRequest *HighlightCreationRequest) (*Highlight, error) {
+	body, err := c.request.Post(ctx, fmt.Sprintf("/v1/entries/%d/highlights", entryID), highlightCreationRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var highlight *Highlight
+	if err := json.NewDecoder(body).Decode(&highlight); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return highlight, nil
+}
+
+// UpdateHighlight updates the note of a highlight.
+func (c *Client) UpdateHighlight(entryID, highlightID int64, highlightChanges *HighlightModificationRequest) (*Highlight, error) {
+	ctx, cancel := withDefaultTimeout()
+	defer cancel()
+	return c.UpdateHighlightContext(ctx, entryID, highlightID, highlightChanges)
+}
+
+// UpdateHighlightContext updates the note of a highlight.
+func (c *Client) UpdateHighlightContext(ctx context.Context, entryID, highlightID int64, highlightChanges *HighlightModificationRequest) (*Highlight, error) {
+	body, err := c.request.Put(ctx, fmt.Sprintf("/v1/entries/%d/highlights/%d", entryID, highlightID), highlightChanges)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var highlight *Highlight
+	if err := json.NewDecoder(body).Decode(&highlight); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return highlight, nil
+}
+
+// DeleteHighlight removes a highlight.
+func (c *Client) DeleteHighlight(entryID, highlightID int64) error {
+	ctx, cancel := withDefaultTimeout()
+	defer cancel()
+	return c.DeleteHighlightContext(ctx, entryID, highlightID)
+}
+
+// DeleteHighlightContext removes a highlight.
+func (c *Client) DeleteHighlightContext(ctx context.Context, entryID, highlightID int64) error {
+	return c.request.Delete(ctx, fmt.Sprintf("/v1/entries/%d/highlights/%d", entryID, highlightID))
+}
+
 // Feeds gets all feeds.
 func (c *Client) Feeds() (Feeds, error) {
 	ctx, cancel := withDefaultTimeout()
@@ -819,6 +1348,146 @@ func (c *Client) FeedIconContext(ctx context.Context, feedID int64) (*FeedIcon,
 	return feedIcon, nil
 }
 
+// FeedHistory gets the most recent refresh attempts of a feed.
+func (c *Client) FeedHistory(feedID int64) (FeedFetches, error) {
+	ctx, cancel := withDefaultTimeout()
+	defer cancel()
+	return c.FeedHistoryContext(ctx, feedID)
+}
+
+// FeedHistoryContext gets the most recent refresh attempts of a feed.
+func (c *Client) FeedHistoryContext(ctx context.Context, feedID int64) (FeedFetches, error) {
+	body, err := c.request.Get(ctx, fmt.Sprintf("/v1/feeds/%d/history", feedID))
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var feedFetches FeedFetches
+	if err := json.NewDecoder(body).Decode(&feedFetches); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return feedFetches, nil
+}
+
+// PreviewFeed applies candidate rules to the latest entries of a feed without saving anything.
+func (c *Client) PreviewFeed(feedID int64, feedPreviewRequest *FeedPreviewRequest) (*FeedPreview, error) {
+	ctx, cancel := withDefaultTimeout()
+	defer cancel()
+	return c.PreviewFeedContext(ctx, feedID, feedPreviewRequest)
+}
+
+// PreviewFeedContext applies candidate rules to the latest entries of a feed without saving anything.
+func (c *Client) PreviewFeedContext(ctx context.Context, feedID int64, feedPreviewRequest *FeedPreviewRequest) (*FeedPreview, error) {
+	body, err := c.request.Post(ctx, fmt.Sprintf("/v1/feeds/%d/preview", feedID), feedPreviewRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var feedPreview *FeedPreview
+	if err := json.NewDecoder(body).Decode(&feedPreview); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return feedPreview, nil
+}
+
+// SimulateFilters evaluates candidate filter rules against stored entries without modifying them.
+func (c *Client) SimulateFilters(filterSimulationRequest *FilterSimulationRequest) (*FilterSimulation, error) {
+	ctx, cancel := withDefaultTimeout()
+	defer cancel()
+	return c.SimulateFiltersContext(ctx, filterSimulationRequest)
+}
+
+// SimulateFiltersContext evaluates candidate filter rules against stored entries without modifying them.
+func (c *Client) SimulateFiltersContext(ctx context.Context, filterSimulationRequest *FilterSimulationRequest) (*FilterSimulation, error) {
+	body, err := c.request.Post(ctx, "/v1/filters/simulate", filterSimulationRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var filterSimulation *FilterSimulation
+	if err := json.NewDecoder(body).Decode(&filterSimulation); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return filterSimulation, nil
+}
+
+// ApplyFilters starts applying the saved filter rules to stored entries in the background.
+// The returned job reports the progress, a running job is returned instead of starting another one.
+func (c *Client) ApplyFilters(filterApplyRequest *FilterApplyRequest) (*FilterApplyJob, error) {
+	ctx, cancel := withDefaultTimeout()
+	defer cancel()
+	return c.ApplyFiltersContext(ctx, filterApplyRequest)
+}
+
+// ApplyFiltersContext starts applying the saved filter rules to stored entries in the background.
+// The returned job reports the progress, a running job is returned instead of starting another one.
+func (c *Client) ApplyFiltersContext(ctx context.Context, filterApplyRequest *FilterApplyRequest) (*FilterApplyJob, error) {
+	body, err := c.request.Post(ctx, "/v1/filters/apply", filterApplyRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var filterApplyJob *FilterApplyJob
+	if err := json.NewDecoder(body).Decode(&filterApplyJob); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return filterApplyJob, nil
+}
+
+// FilterApplyJob gets the progress of the filter rules applied in the background.
+func (c *Client) FilterApplyJob(jobID int64) (*FilterApplyJob, error) {
+	ctx, cancel := withDefaultTimeout()
+	defer cancel()
+	return c.FilterApplyJobContext(ctx, jobID)
+}
+
+// FilterApplyJobContext gets the progress of the filter rules applied in the background.
+func (c *Client) FilterApplyJobContext(ctx context.Context, jobID int64) (*FilterApplyJob, error) {
+	body, err := c.request.Get(ctx, fmt.Sprintf("/v1/filters/jobs/%d", jobID))
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var filterApplyJob *FilterApplyJob
+	if err := json.NewDecoder(body).Decode(&filterApplyJob); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return filterApplyJob, nil
+}
+
+// FilterRuleStats gets the number of entries matched by each user and feed filter rule.
+func (c *Client) FilterRuleStats() (FilterRuleStats, error) {
+	ctx, cancel := withDefaultTimeout()
+	defer cancel()
+	return c.FilterRuleStatsContext(ctx)
+}
+
+// FilterRuleStatsContext gets the number of entries matched by each user and feed filter rule.
+func (c *Client) FilterRuleStatsContext(ctx context.Context) (FilterRuleStats, error) {
+	body, err := c.request.Get(ctx, "/v1/filters/stats")
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var stats FilterRuleStats
+	if err := json.NewDecoder(body).Decode(&stats); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return stats, nil
+}
+
 // FeedEntry gets a single feed entry.
 func (c *Client) FeedEntry(feedID, entryID int64) (*Entry, error) {
 	ctx, cancel := withDefaultTimeout()
@@ -929,6 +1598,9 @@ func buildEntryIDsFilterQueryString(path string, filter *EntryIDsFilter) string
 	if filter.Status != "" {
 		params.Set("status", filter.Status)
 	}
+	if filter.LabelID > 0 {
+		params.Set("label_id", strconv.FormatInt(filter.LabelID, 10))
+	}
 
 	if len(params) == 0 {
 		return path
@@ -1282,6 +1954,10 @@ func buildFilterQueryString(path string, filter *Filter) string {
 			values.Set("search", filter.Search)
 		}
 
+		if filter.SearchMode != "" {
+			values.Set("search_mode", filter.SearchMode)
+		}
+
 		if filter.CategoryID > 0 {
 			values.Set("category_id", strconv.FormatInt(filter.CategoryID, 10))
 		}
@@ -1290,10 +1966,18 @@ func buildFilterQueryString(path string, filter *Filter) string {
 			values.Set("feed_id", strconv.FormatInt(filter.FeedID, 10))
 		}
 
+		if filter.LabelID > 0 {
+			values.Set("label_id", strconv.FormatInt(filter.LabelID, 10))
+		}
+
 		if filter.GloballyVisible {
 			values.Set("globally_visible", "true")
 		}
 
+		if filter.GroupBy != "" {
+			values.Set("group_by", filter.GroupBy)
+		}
+
 		for _, status := range filter.Statuses {
 			values.Add("status", status)
 		}
@@ -411,6 +411,360 @@ func TestDeleteAPIKey(t *testing.T) {
 	}
 }
 
+func TestPublicFeeds(t *testing.T) {
+	expected := PublicFeeds{
+		{
+			ID:      1,
+			Token:   "token",
+			Title:   "Starred",
+			Starred: true,
+		},
+		{
+			ID:         2,
+			Token:      "token2",
+			Title:      "Category",
+			CategoryID: 3,
+		},
+	}
+	client := NewClientWithOptions(
+		"http://mf",
+		WithHTTPClient(
+			newFakeHTTPClient(t, func(t *testing.T, req *http.Request) *http.Response {
+				expectRequest(t, http.MethodGet, "http://mf/v1/public-feeds", nil, req)
+				return jsonResponseFrom(t, http.StatusOK, http.Header{}, expected)
+			})))
+	res, err := client.PublicFeedsContext(t.Context())
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !reflect.DeepEqual(res, expected) {
+		t.Fatalf("Expected %+v, got %+v", expected, res)
+	}
+}
+
+func TestCreatePublicFeed(t *testing.T) {
+	request := &PublicFeedCreationRequest{
+		Title:       "Go articles",
+		Tag:         "go",
+		SearchQuery: "generics",
+	}
+	expected := &PublicFeed{
+		ID:          42,
+		Token:       "some-token",
+		Title:       "Go articles",
+		Tag:         "go",
+		SearchQuery: "generics",
+	}
+	client := NewClientWithOptions(
+		"http://mf",
+		WithHTTPClient(
+			newFakeHTTPClient(t, func(t *testing.T, req *http.Request) *http.Response {
+				expectRequest(t, http.MethodPost, "http://mf/v1/public-feeds", func(r io.Reader) {
+					expectFromJSON(t, r, request)
+				}, req)
+				return jsonResponseFrom(t, http.StatusCreated, http.Header{}, expected)
+			})))
+	res, err := client.CreatePublicFeedContext(t.Context(), request)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !reflect.DeepEqual(res, expected) {
+		t.Fatalf("Expected %+v, got %+v", expected, res)
+	}
+}
+
+func TestDeletePublicFeed(t *testing.T) {
+	client := NewClientWithOptions(
+		"http://mf",
+		WithHTTPClient(
+			newFakeHTTPClient(t, func(t *testing.T, req *http.Request) *http.Response {
+				expectRequest(t, http.MethodDelete, "http://mf/v1/public-feeds/1", nil, req)
+				return jsonResponseFrom(t, http.StatusOK, http.Header{}, nil)
+			})))
+	if err := client.DeletePublicFeedContext(t.Context(), 1); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+}
+
+func TestNewsletterAddresses(t *testing.T) {
+	expected := NewsletterAddresses{
+		{
+			ID:             1,
+			CategoryID:     2,
+			Token:          "token",
+			Email:          "token@example.org",
+			Description:    "Newsletters",
+			AllowedSenders: []string{"example.com"},
+		},
+	}
+	client := NewClientWithOptions(
+		"http://mf",
+		WithHTTPClient(
+			newFakeHTTPClient(t, func(t *testing.T, req *http.Request) *http.Response {
+				expectRequest(t, http.MethodGet, "http://mf/v1/newsletter-addresses", nil, req)
+				return jsonResponseFrom(t, http.StatusOK, http.Header{}, expected)
+			})))
+	res, err := client.NewsletterAddressesContext(t.Context())
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !reflect.DeepEqual(res, expected) {
+		t.Fatalf("Expected %+v, got %+v", expected, res)
+	}
+}
+
+func TestCreateNewsletterAddress(t *testing.T) {
+	request := &NewsletterAddressCreationRequest{
+		CategoryID:     2,
+		Description:    "Newsletters",
+		AllowedSenders: []string{"news@example.com"},
+	}
+	expected := &NewsletterAddress{
+		ID:             42,
+		CategoryID:     2,
+		Token:          "token",
+		Email:          "token@example.org",
+		Description:    "Newsletters",
+		AllowedSenders: []string{"news@example.com"},
+	}
+	client := NewClientWithOptions(
+		"http://mf",
+		WithHTTPClient(
+			newFakeHTTPClient(t, func(t *testing.T, req *http.Request) *http.Response {
+				expectRequest(t, http.MethodPost, "http://mf/v1/newsletter-addresses", func(r io.Reader) {
+					expectFromJSON(t, r, request)
+				}, req)
+				return jsonResponseFrom(t, http.StatusCreated, http.Header{}, expected)
+			})))
+	res, err := client.CreateNewsletterAddressContext(t.Context(), request)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !reflect.DeepEqual(res, expected) {
+		t.Fatalf("Expected %+v, got %+v", expected, res)
+	}
+}
+
+func TestDeleteNewsletterAddress(t *testing.T) {
+	client := NewClientWithOptions(
+		"http://mf",
+		WithHTTPClient(
+			newFakeHTTPClient(t, func(t *testing.T, req *http.Request) *http.Response {
+				expectRequest(t, http.MethodDelete, "http://mf/v1/newsletter-addresses/1", nil, req)
+				return jsonResponseFrom(t, http.StatusOK, http.Header{}, nil)
+			})))
+	if err := client.DeleteNewsletterAddressContext(t.Context(), 1); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+}
+
+func TestApplyFilters(t *testing.T) {
+	request := &FilterApplyRequest{FeedID: 1, Outcome: "read"}
+	expected := &FilterApplyJob{
+		ID:      42,
+		FeedID:  1,
+		Outcome: "read",
+		Status:  "running",
+	}
+	client := NewClientWithOptions(
+		"http://mf",
+		WithHTTPClient(
+			newFakeHTTPClient(t, func(t *testing.T, req *http.Request) *http.Response {
+				expectRequest(t, http.MethodPost, "http://mf/v1/filters/apply", func(r io.Reader) {
+					expectFromJSON(t, r, request)
+				}, req)
+				return jsonResponseFrom(t, http.StatusCreated, http.Header{}, expected)
+			})))
+	res, err := client.ApplyFiltersContext(t.Context(), request)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !reflect.DeepEqual(res, expected) {
+		t.Fatalf("Expected %+v, got %+v", expected, res)
+	}
+}
+
+func TestFilterApplyJob(t *testing.T) {
+	expected := &FilterApplyJob{
+		ID:                42,
+		Outcome:           "remove",
+		Status:            "done",
+		FilterApplyResult: FilterApplyResult{EntriesCount: 1000, BlockedCount: 12},
+	}
+	client := NewClientWithOptions(
+		"http://mf",
+		WithHTTPClient(
+			newFakeHTTPClient(t, func(t *testing.T, req *http.Request) *http.Response {
+				expectRequest(t, http.MethodGet, "http://mf/v1/filters/jobs/42", nil, req)
+				return jsonResponseFrom(t, http.StatusOK, http.Header{}, expected)
+			})))
+	res, err := client.FilterApplyJobContext(t.Context(), 42)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !reflect.DeepEqual(res, expected) {
+		t.Fatalf("Expected %+v, got %+v", expected, res)
+	}
+}
+
+func TestSavedSearchesWithCounters(t *testing.T) {
+	expected := SavedSearches{
+		{
+			ID:          1,
+			Title:       "Unread Go",
+			SearchQuery: "golang",
+			Statuses:    []string{"unread"},
+			TotalUnread: new(3),
+		},
+	}
+	client := NewClientWithOptions(
+		"http://mf",
+		WithHTTPClient(
+			newFakeHTTPClient(t, func(t *testing.T, req *http.Request) *http.Response {
+				expectRequest(t, http.MethodGet, "http://mf/v1/saved-searches?counts=true", nil, req)
+				return jsonResponseFrom(t, http.StatusOK, http.Header{}, expected)
+			})))
+	res, err := client.SavedSearchesWithCountersContext(t.Context())
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !reflect.DeepEqual(res, expected) {
+		t.Fatalf("Expected %+v, got %+v", expected, res)
+	}
+}
+
+func TestCreateSavedSearch(t *testing.T) {
+	request := &SavedSearchCreationRequest{
+		Title:       "Recent Go",
+		SearchQuery: "golang",
+		CategoryIDs: []int64{2},
+		MaxAgeDays:  7,
+	}
+	expected := &SavedSearch{
+		ID:          42,
+		Title:       "Recent Go",
+		SearchQuery: "golang",
+		CategoryIDs: []int64{2},
+		MaxAgeDays:  7,
+	}
+	client := NewClientWithOptions(
+		"http://mf",
+		WithHTTPClient(
+			newFakeHTTPClient(t, func(t *testing.T, req *http.Request) *http.Response {
+				expectRequest(t, http.MethodPost, "http://mf/v1/saved-searches", func(r io.Reader) {
+					expectFromJSON(t, r, request)
+				}, req)
+				return jsonResponseFrom(t, http.StatusCreated, http.Header{}, expected)
+			})))
+	res, err := client.CreateSavedSearchContext(t.Context(), request)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !reflect.DeepEqual(res, expected) {
+		t.Fatalf("Expected %+v, got %+v", expected, res)
+	}
+}
+
+func TestSavedSearchEntries(t *testing.T) {
+	expected := &EntryResultSet{Total: 1, Entries: Entries{{ID: 7, Title: "Go 2"}}}
+	client := NewClientWithOptions(
+		"http://mf",
+		WithHTTPClient(
+			newFakeHTTPClient(t, func(t *testing.T, req *http.Request) *http.Response {
+				expectRequest(t, http.MethodGet, "http://mf/v1/saved-searches/3/entries?limit=10&offset=0", nil, req)
+				return jsonResponseFrom(t, http.StatusOK, http.Header{}, expected)
+			})))
+	res, err := client.SavedSearchEntriesContext(t.Context(), 3, &Filter{Limit: 10})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !reflect.DeepEqual(res, expected) {
+		t.Fatalf("Expected %+v, got %+v", expected, res)
+	}
+}
+
+func TestHighlights(t *testing.T) {
+	expected := &HighlightResultSet{
+		Total:      1,
+		Highlights: Highlights{{ID: 5, EntryID: 7, Quote: "quote", EntryTitle: "Go 2"}},
+	}
+	client := NewClientWithOptions(
+		"http://mf",
+		WithHTTPClient(
+			newFakeHTTPClient(t, func(t *testing.T, req *http.Request) *http.Response {
+				expectRequest(t, http.MethodGet, "http://mf/v1/highlights?limit=10&offset=20", nil, req)
+				return jsonResponseFrom(t, http.StatusOK, http.Header{}, expected)
+			})))
+	res, err := client.HighlightsContext(t.Context(), 10, 20)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !reflect.DeepEqual(res, expected) {
+		t.Fatalf("Expected %+v, got %+v", expected, res)
+	}
+}
+
+func TestCreateHighlight(t *testing.T) {
+	request := &HighlightCreationRequest{
+		Quote:       "quote",
+		Prefix:      "a ",
+		StartOffset: 2,
+		EndOffset:   7,
+		Note:        "note",
+	}
+	expected := &Highlight{ID: 5, EntryID: 7, Quote: "quote", Prefix: "a ", StartOffset: 2, EndOffset: 7, Note: "note"}
+	client := NewClientWithOptions(
+		"http://mf",
+		WithHTTPClient(
+			newFakeHTTPClient(t, func(t *testing.T, req *http.Request) *http.Response {
+				expectRequest(t, http.MethodPost, "http://mf/v1/entries/7/highlights", func(r io.Reader) {
+					expectFromJSON(t, r, request)
+				}, req)
+				return jsonResponseFrom(t, http.StatusCreated, http.Header{}, expected)
+			})))
+	res, err := client.CreateHighlightContext(t.Context(), 7, request)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !reflect.DeepEqual(res, expected) {
+		t.Fatalf("Expected %+v, got %+v", expected, res)
+	}
+}
+
+func TestUpdateHighlight(t *testing.T) {
+	request := &HighlightModificationRequest{Note: SetOptionalField("new note")}
+	expected := &Highlight{ID: 5, EntryID: 7, Quote: "quote", Note: "new note"}
+	client := NewClientWithOptions(
+		"http://mf",
+		WithHTTPClient(
+			newFakeHTTPClient(t, func(t *testing.T, req *http.Request) *http.Response {
+				expectRequest(t, http.MethodPut, "http://mf/v1/entries/7/highlights/5", func(r io.Reader) {
+					expectFromJSON(t, r, request)
+				}, req)
+				return jsonResponseFrom(t, http.StatusCreated, http.Header{}, expected)
+			})))
+	res, err := client.UpdateHighlightContext(t.Context(), 7, 5, request)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !reflect.DeepEqual(res, expected) {
+		t.Fatalf("Expected %+v, got %+v", expected, res)
+	}
+}
+
+func TestDeleteHighlight(t *testing.T) {
+	client := NewClientWithOptions(
+		"http://mf",
+		WithHTTPClient(
+			newFakeHTTPClient(t, func(t *testing.T, req *http.Request) *http.Response {
+				expectRequest(t, http.MethodDelete, "http://mf/v1/entries/7/highlights/5", nil, req)
+				return jsonResponseFrom(t, http.StatusNoContent, http.Header{}, nil)
+			})))
+	if err := client.DeleteHighlightContext(t.Context(), 7, 5); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+}
+
 func TestMarkAllAsRead(t *testing.T) {
 	client := NewClientWithOptions(
 		"http://mf",
@@ -941,6 +1295,39 @@ func TestFeedIcon(t *testing.T) {
 	}
 }
 
+func TestFeedHistory(t *testing.T) {
+	expected := FeedFetches{
+		{
+			ID:              2,
+			FeedID:          1,
+			StatusCode:      http.StatusOK,
+			Duration:        120,
+			ResponseSize:    2048,
+			NewEntriesCount: 3,
+		},
+		{
+			ID:         1,
+			FeedID:     1,
+			StatusCode: http.StatusNotModified,
+			CacheHit:   true,
+		},
+	}
+	client := NewClientWithOptions(
+		"http://mf",
+		WithHTTPClient(
+			newFakeHTTPClient(t, func(t *testing.T, req *http.Request) *http.Response {
+				expectRequest(t, http.MethodGet, "http://mf/v1/feeds/1/history", nil, req)
+				return jsonResponseFrom(t, http.StatusOK, http.Header{}, expected)
+			})))
+	res, err := client.FeedHistoryContext(t.Context(), 1)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !reflect.DeepEqual(res, expected) {
+		t.Fatalf("Expected %s, got %s", asJSON(expected), asJSON(res))
+	}
+}
+
 func TestFeedEntry(t *testing.T) {
 	expected := &Entry{
 		ID:    1,
@@ -1031,6 +1418,37 @@ func TestEntries(t *testing.T) {
 	}
 }
 
+func TestEntriesWithFuzzySearch(t *testing.T) {
+	expected := &EntryResultSet{
+		Total: 1,
+		Entries: Entries{
+			{
+				ID:            1,
+				Title:         "Golang",
+				SearchSnippet: &SearchSnippet{Title: "Golang"},
+			},
+		},
+	}
+
+	client := NewClientWithOptions(
+		"http://mf",
+		WithHTTPClient(
+			newFakeHTTPClient(t, func(t *testing.T, req *http.Request) *http.Response {
+				expectRequest(t, http.MethodGet, "http://mf/v1/entries?limit=0&offset=0&search=golnag&search_mode=fuzzy", nil, req)
+				return jsonResponseFrom(t, http.StatusOK, http.Header{}, expected)
+			})))
+	res, err := client.EntriesContext(t.Context(), &Filter{
+		Search:     "golnag",
+		SearchMode: "fuzzy",
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !reflect.DeepEqual(res, expected) {
+		t.Fatalf("Expected %s, got %s", asJSON(expected), asJSON(res))
+	}
+}
+
 func TestFeedEntries(t *testing.T) {
 	expected := &EntryResultSet{
 		Total: 1,
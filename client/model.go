@@ -16,37 +16,41 @@ const (
 
 // User represents a user in the system.
 type User struct {
-	ID                        int64      `json:"id"`
-	Username                  string     `json:"username"`
-	Password                  string     `json:"password,omitempty"`
-	IsAdmin                   bool       `json:"is_admin"`
-	Theme                     string     `json:"theme"`
-	Language                  string     `json:"language"`
-	Timezone                  string     `json:"timezone"`
-	EntryDirection            string     `json:"entry_sorting_direction"`
-	EntryOrder                string     `json:"entry_sorting_order"`
-	Stylesheet                string     `json:"stylesheet"`
-	CustomJS                  string     `json:"custom_js"`
-	GoogleID                  string     `json:"google_id"`
-	OpenIDConnectID           string     `json:"openid_connect_id"`
-	EntriesPerPage            int        `json:"entries_per_page"`
-	KeyboardShortcuts         bool       `json:"keyboard_shortcuts"`
-	ShowReadingTime           bool       `json:"show_reading_time"`
-	EntrySwipe                bool       `json:"entry_swipe"`
-	GestureNav                string     `json:"gesture_nav"`
-	LastLoginAt               *time.Time `json:"last_login_at"`
-	DisplayMode               string     `json:"display_mode"`
-	DefaultReadingSpeed       int        `json:"default_reading_speed"`
-	CJKReadingSpeed           int        `json:"cjk_reading_speed"`
-	DefaultHomePage           string     `json:"default_home_page"`
-	CategoriesSortingOrder    string     `json:"categories_sorting_order"`
-	MarkReadOnView            bool       `json:"mark_read_on_view"`
-	MediaPlaybackRate         float64    `json:"media_playback_rate"`
-	BlockFilterEntryRules     string     `json:"block_filter_entry_rules"`
-	KeepFilterEntryRules      string     `json:"keep_filter_entry_rules"`
-	ExternalFontHosts         string     `json:"external_font_hosts"`
-	AlwaysOpenExternalLinks   bool       `json:"always_open_external_links"`
-	OpenExternalLinksInNewTab bool       `json:"open_external_links_in_new_tab"`
+	ID                         int64      `json:"id"`
+	Username                   string     `json:"username"`
+	Password                   string     `json:"password,omitempty"`
+	IsAdmin                    bool       `json:"is_admin"`
+	Theme                      string     `json:"theme"`
+	Language                   string     `json:"language"`
+	Timezone                   string     `json:"timezone"`
+	EntryDirection             string     `json:"entry_sorting_direction"`
+	EntryOrder                 string     `json:"entry_sorting_order"`
+	Stylesheet                 string     `json:"stylesheet"`
+	CustomJS                   string     `json:"custom_js"`
+	GoogleID                   string     `json:"google_id"`
+	OpenIDConnectID            string     `json:"openid_connect_id"`
+	EntriesPerPage             int        `json:"entries_per_page"`
+	KeyboardShortcuts          bool       `json:"keyboard_shortcuts"`
+	ShowReadingTime            bool       `json:"show_reading_time"`
+	EntrySwipe                 bool       `json:"entry_swipe"`
+	GestureNav                 string     `json:"gesture_nav"`
+	EntryListLayout            string     `json:"entry_list_layout"`
+	MarkDuplicateEntriesAsRead bool       `json:"mark_duplicate_entries_as_read"`
+	GroupEntriesByStory        bool       `json:"group_entries_by_story"`
+	LastLoginAt                *time.Time `json:"last_login_at"`
+	DisplayMode                string     `json:"display_mode"`
+	DefaultReadingSpeed        int        `json:"default_reading_speed"`
+	CJKReadingSpeed            int        `json:"cjk_reading_speed"`
+	DefaultHomePage            string     `json:"default_home_page"`
+	CategoriesSortingOrder     string     `json:"categories_sorting_order"`
+	MarkReadOnView             bool       `json:"mark_read_on_view"`
+	MediaPlaybackRate          float64    `json:"media_playback_rate"`
+	BlockFilterEntryRules      string     `json:"block_filter_entry_rules"`
+	KeepFilterEntryRules       string     `json:"keep_filter_entry_rules"`
+	ActionEntryRules           string     `json:"action_entry_rules"`
+	ExternalFontHosts          string     `json:"external_font_hosts"`
+	AlwaysOpenExternalLinks    bool       `json:"always_open_external_links"`
+	OpenExternalLinksInNewTab  bool       `json:"open_external_links_in_new_tab"`
 }
 
 func (u User) String() string {
@@ -64,33 +68,37 @@ type UserCreationRequest struct {
 
 // UserModificationRequest represents the request to update a user.
 type UserModificationRequest struct {
-	Username                  *string  `json:"username"`
-	Password                  *string  `json:"password"`
-	IsAdmin                   *bool    `json:"is_admin"`
-	Theme                     *string  `json:"theme"`
-	Language                  *string  `json:"language"`
-	Timezone                  *string  `json:"timezone"`
-	EntryDirection            *string  `json:"entry_sorting_direction"`
-	EntryOrder                *string  `json:"entry_sorting_order"`
-	Stylesheet                *string  `json:"stylesheet"`
-	CustomJS                  *string  `json:"custom_js"`
-	EntriesPerPage            *int     `json:"entries_per_page"`
-	KeyboardShortcuts         *bool    `json:"keyboard_shortcuts"`
-	ShowReadingTime           *bool    `json:"show_reading_time"`
-	EntrySwipe                *bool    `json:"entry_swipe"`
-	GestureNav                *string  `json:"gesture_nav"`
-	DisplayMode               *string  `json:"display_mode"`
-	DefaultReadingSpeed       *int     `json:"default_reading_speed"`
-	CJKReadingSpeed           *int     `json:"cjk_reading_speed"`
-	DefaultHomePage           *string  `json:"default_home_page"`
-	CategoriesSortingOrder    *string  `json:"categories_sorting_order"`
-	MarkReadOnView            *bool    `json:"mark_read_on_view"`
-	MediaPlaybackRate         *float64 `json:"media_playback_rate"`
-	BlockFilterEntryRules     *string  `json:"block_filter_entry_rules"`
-	KeepFilterEntryRules      *string  `json:"keep_filter_entry_rules"`
-	ExternalFontHosts         *string  `json:"external_font_hosts"`
-	AlwaysOpenExternalLinks   *bool    `json:"always_open_external_links"`
-	OpenExternalLinksInNewTab *bool    `json:"open_external_links_in_new_tab"`
+	Username                   *string  `json:"username"`
+	Password                   *string  `json:"password"`
+	IsAdmin                    *bool    `json:"is_admin"`
+	Theme                      *string  `json:"theme"`
+	Language                   *string  `json:"language"`
+	Timezone                   *string  `json:"timezone"`
+	EntryDirection             *string  `json:"entry_sorting_direction"`
+	EntryOrder                 *string  `json:"entry_sorting_order"`
+	Stylesheet                 *string  `json:"stylesheet"`
+	CustomJS                   *string  `json:"custom_js"`
+	EntriesPerPage             *int     `json:"entries_per_page"`
+	KeyboardShortcuts          *bool    `json:"keyboard_shortcuts"`
+	ShowReadingTime            *bool    `json:"show_reading_time"`
+	EntrySwipe                 *bool    `json:"entry_swipe"`
+	GestureNav                 *string  `json:"gesture_nav"`
+	EntryListLayout            *string  `json:"entry_list_layout"`
+	MarkDuplicateEntriesAsRead *bool    `json:"mark_duplicate_entries_as_read"`
+	GroupEntriesByStory        *bool    `json:"group_entries_by_story"`
+	DisplayMode                *string  `json:"display_mode"`
+	DefaultReadingSpeed        *int     `json:"default_reading_speed"`
+	CJKReadingSpeed            *int     `json:"cjk_reading_speed"`
+	DefaultHomePage            *string  `json:"default_home_page"`
+	CategoriesSortingOrder     *string  `json:"categories_sorting_order"`
+	MarkReadOnView             *bool    `json:"mark_read_on_view"`
+	MediaPlaybackRate          *float64 `json:"media_playback_rate"`
+	BlockFilterEntryRules      *string  `json:"block_filter_entry_rules"`
+	KeepFilterEntryRules       *string  `json:"keep_filter_entry_rules"`
+	ActionEntryRules           *string  `json:"action_entry_rules"`
+	ExternalFontHosts          *string  `json:"external_font_hosts"`
+	AlwaysOpenExternalLinks    *bool    `json:"always_open_external_links"`
+	OpenExternalLinksInNewTab  *bool    `json:"open_external_links_in_new_tab"`
 }
 
 // Users represents a list of users.
@@ -125,6 +133,32 @@ type CategoryModificationRequest struct {
 	HideGlobally *bool   `json:"hide_globally"`
 }
 
+// Label represents a label attached to entries by the user.
+type Label struct {
+	ID          int64  `json:"id"`
+	UserID      int64  `json:"user_id,omitempty"`
+	Title       string `json:"title"`
+	EntryCount  *int   `json:"entry_count,omitempty"`
+	TotalUnread *int   `json:"total_unread,omitempty"`
+}
+
+func (l Label) String() string {
+	return fmt.Sprintf("#%d %s", l.ID, l.Title)
+}
+
+// Labels represents a list of labels.
+type Labels []*Label
+
+// LabelCreationRequest represents the request to create a label.
+type LabelCreationRequest struct {
+	Title string `json:"title"`
+}
+
+// LabelModificationRequest represents the request to rename a label.
+type LabelModificationRequest struct {
+	Title *string `json:"title"`
+}
+
 // Subscription represents a feed subscription.
 type Subscription struct {
 	Title string `json:"title"`
@@ -166,8 +200,10 @@ type Feed struct {
 	KeeplistRules               string    `json:"keeplist_rules"`
 	BlockFilterEntryRules       string    `json:"block_filter_entry_rules"`
 	KeepFilterEntryRules        string    `json:"keep_filter_entry_rules"`
+	ActionEntryRules            string    `json:"action_entry_rules"`
 	Crawler                     bool      `json:"crawler"`
 	IgnoreEntryUpdates          bool      `json:"ignore_entry_updates"`
+	MarkUnreadOnChangeThreshold int       `json:"mark_unread_on_change_threshold"`
 	UserAgent                   string    `json:"user_agent"`
 	Cookie                      string    `json:"cookie"`
 	Username                    string    `json:"username"`
@@ -176,6 +212,7 @@ type Feed struct {
 	HideGlobally                bool      `json:"hide_globally"`
 	DisableHTTP2                bool      `json:"disable_http2"`
 	ProxyURL                    string    `json:"proxy_url"`
+	UnsubscribeURL              string    `json:"unsubscribe_url,omitempty"`
 	AppriseServiceURLs          string    `json:"apprise_service_urls"`
 	WebhookURL                  string    `json:"webhook_url"`
 	NtfyEnabled                 bool      `json:"ntfy_enabled"`
@@ -183,6 +220,11 @@ type Feed struct {
 	NtfyTopic                   string    `json:"ntfy_topic"`
 	PushoverEnabled             bool      `json:"pushover_enabled"`
 	PushoverPriority            int       `json:"pushover_priority"`
+	PageItemSelector            string    `json:"page_item_selector"`
+	PageTitleSelector           string    `json:"page_title_selector"`
+	PageLinkSelector            string    `json:"page_link_selector"`
+	PageDateSelector            string    `json:"page_date_selector"`
+	PageContentSelector         string    `json:"page_content_selector"`
 	Icon                        *FeedIcon `json:"icon"`
 }
 
@@ -211,6 +253,11 @@ type FeedCreationRequest struct {
 	HideGlobally                bool   `json:"hide_globally"`
 	DisableHTTP2                bool   `json:"disable_http2"`
 	ProxyURL                    string `json:"proxy_url"`
+	PageItemSelector            string `json:"page_item_selector"`
+	PageTitleSelector           string `json:"page_title_selector"`
+	PageLinkSelector            string `json:"page_link_selector"`
+	PageDateSelector            string `json:"page_date_selector"`
+	PageContentSelector         string `json:"page_content_selector"`
 }
 
 // FeedModificationRequest represents the request to update a feed.
@@ -226,8 +273,10 @@ type FeedModificationRequest struct {
 	KeeplistRules               *string `json:"keeplist_rules"`
 	BlockFilterEntryRules       *string `json:"block_filter_entry_rules"`
 	KeepFilterEntryRules        *string `json:"keep_filter_entry_rules"`
+	ActionEntryRules            *string `json:"action_entry_rules"`
 	Crawler                     *bool   `json:"crawler"`
 	IgnoreEntryUpdates          *bool   `json:"ignore_entry_updates"`
+	MarkUnreadOnChangeThreshold *int    `json:"mark_unread_on_change_threshold"`
 	UserAgent                   *string `json:"user_agent"`
 	Cookie                      *string `json:"cookie"`
 	Username                    *string `json:"username"`
@@ -241,6 +290,11 @@ type FeedModificationRequest struct {
 	HideGlobally                *bool   `json:"hide_globally"`
 	DisableHTTP2                *bool   `json:"disable_http2"`
 	ProxyURL                    *string `json:"proxy_url"`
+	PageItemSelector            *string `json:"page_item_selector"`
+	PageTitleSelector           *string `json:"page_title_selector"`
+	PageLinkSelector            *string `json:"page_link_selector"`
+	PageDateSelector            *string `json:"page_date_selector"`
+	PageContentSelector         *string `json:"page_content_selector"`
 }
 
 // FeedIcon represents the feed icon.
@@ -250,6 +304,152 @@ type FeedIcon struct {
 	Data     string `json:"data"`
 }
 
+// FeedFetch represents a refresh attempt of a feed.
+type FeedFetch struct {
+	ID                  int64     `json:"id"`
+	UserID              int64     `json:"user_id"`
+	FeedID              int64     `json:"feed_id"`
+	FetchedAt           time.Time `json:"fetched_at"`
+	StatusCode          int       `json:"status_code"`
+	Duration            int64     `json:"duration"` // In milliseconds.
+	ResponseSize        int64     `json:"response_size"`
+	CacheHit            bool      `json:"cache_hit"`
+	NewEntriesCount     int       `json:"new_entries_count"`
+	UpdatedEntriesCount int       `json:"updated_entries_count"`
+	ErrorMsg            string    `json:"error_message"`
+	RedirectURL         string    `json:"redirect_url"`
+	FeedURLUpdated      bool      `json:"feed_url_updated"`
+}
+
+// FeedFetches represents a list of feed refresh attempts.
+type FeedFetches []*FeedFetch
+
+// FeedPreviewRequest represents the candidate rules to try on the latest entries of a feed.
+type FeedPreviewRequest struct {
+	ScraperRules    *string `json:"scraper_rules,omitempty"`
+	RewriteRules    *string `json:"rewrite_rules,omitempty"`
+	UrlRewriteRules *string `json:"urlrewrite_rules,omitempty"`
+	Crawler         *bool   `json:"crawler,omitempty"`
+	Limit           int     `json:"limit,omitempty"`
+}
+
+// FeedPreview represents the result of the rules applied to the latest entries of a feed.
+type FeedPreview struct {
+	Errors  []*FeedRuleError `json:"errors"`
+	Entries []*EntryPreview  `json:"entries"`
+}
+
+// FeedRuleError represents an invalid feed rule.
+type FeedRuleError struct {
+	Field string `json:"field"`
+	Error string `json:"error"`
+}
+
+// EntryPreview represents an entry before and after applying the feed rules.
+type EntryPreview struct {
+	Before EntryPreviewContent `json:"before"`
+	After  EntryPreviewContent `json:"after"`
+	Error  string              `json:"error,omitempty"`
+}
+
+// EntryPreviewContent represents the entry fields modified by the feed rules.
+type EntryPreviewContent struct {
+	URL     string `json:"url"`
+	Title   string `json:"title"`
+	Content string `json:"content"`
+}
+
+// FilterSimulationRequest represents candidate filter rules to evaluate against stored entries.
+// When FeedID is set, the rules are evaluated as feed rules, otherwise as user rules.
+type FilterSimulationRequest struct {
+	FeedID                int64   `json:"feed_id,omitempty"`
+	BlockFilterEntryRules *string `json:"block_filter_entry_rules,omitempty"`
+	KeepFilterEntryRules  *string `json:"keep_filter_entry_rules,omitempty"`
+	Limit                 int     `json:"limit,omitempty"`
+}
+
+// FilterSimulation represents the result of filter rules evaluated against stored entries.
+type FilterSimulation struct {
+	EntriesCount   int                      `json:"entries_count"`
+	BlockedCount   int                      `json:"blocked_count"`
+	NotKeptCount   int                      `json:"not_kept_count"`
+	NotKeptEntries []*FilterSimulationEntry `json:"not_kept_entries"`
+	Rules          []*FilterRuleSimulation  `json:"rules"`
+	Errors         []*FilterRuleError       `json:"errors"`
+}
+
+// FilterRuleSimulation represents the entries matched by a single rule line.
+type FilterRuleSimulation struct {
+	Source          string                   `json:"source"`
+	Kind            string                   `json:"kind"`
+	Line            int                      `json:"line"`
+	Rule            string                   `json:"rule"`
+	MatchCount      int                      `json:"match_count"`
+	FirstMatchCount int                      `json:"first_match_count"`
+	Entries         []*FilterSimulationEntry `json:"entries"`
+}
+
+// FilterSimulationEntry represents an entry matched by a rule.
+type FilterSimulationEntry struct {
+	EntryID    int64  `json:"entry_id"`
+	FeedID     int64  `json:"feed_id"`
+	Title      string `json:"title"`
+	URL        string `json:"url"`
+	FirstMatch bool   `json:"first_match"`
+}
+
+// FilterRuleError represents an invalid filter rule line.
+type FilterRuleError struct {
+	Source string `json:"source"`
+	Kind   string `json:"kind"`
+	Line   int    `json:"line"`
+	Rule   string `json:"rule"`
+	Error  string `json:"error"`
+}
+
+// FilterApplyRequest represents a request to apply the saved filter rules to stored entries.
+// Outcome is "read", "remove" (deleted and never fetched again) or "delete".
+// The scope is a feed, a category, or the whole account when both IDs are zero.
+type FilterApplyRequest struct {
+	FeedID     int64  `json:"feed_id,omitempty"`
+	CategoryID int64  `json:"category_id,omitempty"`
+	Outcome    string `json:"outcome"`
+}
+
+// FilterApplyResult represents the number of entries evaluated and blocked by the filter rules.
+type FilterApplyResult struct {
+	EntriesCount int `json:"entries_count"`
+	BlockedCount int `json:"blocked_count"`
+}
+
+// FilterApplyJob represents the filter rules applied in the background to stored entries.
+// Status is "running", "done" or "failed", the counters are updated while the job is running.
+type FilterApplyJob struct {
+	ID         int64  `json:"id"`
+	UserID     int64  `json:"user_id"`
+	FeedID     int64  `json:"feed_id"`
+	CategoryID int64  `json:"category_id"`
+	Outcome    string `json:"outcome"`
+	Status     string `json:"status"`
+	FilterApplyResult
+	Error      string     `json:"error,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+	FinishedAt *time.Time `json:"finished_at"`
+}
+
+// FilterRuleStat represents the number of entries matched by a filter rule and the time of the last match.
+// FeedID is zero for the rules defined in the user settings.
+type FilterRuleStat struct {
+	UserID        int64     `json:"user_id"`
+	FeedID        int64     `json:"feed_id,omitempty"`
+	Rule          string    `json:"rule"`
+	HitCount      int64     `json:"hit_count"`
+	LastMatchedAt time.Time `json:"last_matched_at"`
+}
+
+// FilterRuleStats represents a list of filter rule statistics.
+type FilterRuleStats []*FilterRuleStat
+
 type FeedCounters struct {
 	ReadCounters   map[int64]int `json:"reads"`
 	UnreadCounters map[int64]int `json:"unreads"`
@@ -276,10 +476,82 @@ type Entry struct {
 	ShareCode   string     `json:"share_code"`
 	Enclosures  Enclosures `json:"enclosures,omitempty"`
 	Tags        []string   `json:"tags"`
+	Labels      Labels     `json:"labels,omitempty"`
+	Highlights  Highlights `json:"highlights,omitempty"`
+	Revisions   Revisions  `json:"revisions,omitempty"`
 	ReadingTime int        `json:"reading_time"`
 	UserID      int64      `json:"user_id"`
 	FeedID      int64      `json:"feed_id"`
 	Starred     bool       `json:"starred"`
+	Podcast     *Podcast   `json:"podcast,omitempty"`
+	Transcript  string     `json:"transcript,omitempty"`
+
+	ThumbnailURL    string `json:"thumbnail_url"`
+	ThumbnailWidth  int    `json:"thumbnail_width"`
+	ThumbnailHeight int    `json:"thumbnail_height"`
+
+	DuplicateOfID int64             `json:"duplicate_of_id,omitempty"`
+	Duplicates    []*EntryDuplicate `json:"duplicates,omitempty"`
+	StoryID       int64             `json:"story_id,omitempty"`
+	StoryEntries  []*EntryDuplicate `json:"story_entries,omitempty"`
+
+	SearchSnippet *SearchSnippet `json:"search_snippet,omitempty"`
+}
+
+// SearchSnippet represents the title and content fragments matching a search query.
+// The matching terms are wrapped in HTML mark tags.
+type SearchSnippet struct {
+	Title   string `json:"title"`
+	Content string `json:"content"`
+}
+
+// EntryDuplicate represents an entry with the same URL or telling the same story in another feed.
+type EntryDuplicate struct {
+	EntryID   int64  `json:"entry_id"`
+	FeedID    int64  `json:"feed_id"`
+	FeedTitle string `json:"feed_title"`
+	Status    string `json:"status"`
+}
+
+// Podcast represents the Podcasting 2.0 attributes of an entry.
+type Podcast struct {
+	Season *struct {
+		Number int    `json:"number"`
+		Name   string `json:"name,omitempty"`
+	} `json:"season,omitempty"`
+	Episode *struct {
+		Number  float64 `json:"number"`
+		Display string  `json:"display,omitempty"`
+	} `json:"episode,omitempty"`
+	Transcripts []struct {
+		URL      string `json:"url"`
+		MimeType string `json:"mime_type"`
+		Language string `json:"language,omitempty"`
+		Rel      string `json:"rel,omitempty"`
+	} `json:"transcripts,omitempty"`
+	ChaptersURL string `json:"chapters_url,omitempty"`
+	Chapters    []struct {
+		StartTime float64 `json:"start_time"`
+		Title     string  `json:"title,omitempty"`
+		URL       string  `json:"url,omitempty"`
+		ImageURL  string  `json:"image_url,omitempty"`
+	} `json:"chapters,omitempty"`
+	Persons []struct {
+		Name     string `json:"name"`
+		Role     string `json:"role,omitempty"`
+		Group    string `json:"group,omitempty"`
+		ImageURL string `json:"image_url,omitempty"`
+		URL      string `json:"url,omitempty"`
+	} `json:"persons,omitempty"`
+	Funding []struct {
+		URL   string `json:"url"`
+		Title string `json:"title,omitempty"`
+	} `json:"funding,omitempty"`
+	Soundbites []struct {
+		StartTime float64 `json:"start_time"`
+		Duration  float64 `json:"duration"`
+		Title     string  `json:"title,omitempty"`
+	} `json:"soundbites,omitempty"`
 }
 
 // EntryModificationRequest represents a request to modify an entry.
@@ -300,6 +572,8 @@ type Enclosure struct {
 	MimeType         string `json:"mime_type"`
 	Size             int    `json:"size"`
 	MediaProgression int64  `json:"media_progression"`
+	Title            string `json:"title,omitempty"`
+	Alternate        bool   `json:"alternate"`
 }
 
 type EnclosureUpdateRequest struct {
@@ -331,11 +605,14 @@ type Filter struct {
 	BeforeEntryID   int64
 	AfterEntryID    int64
 	Search          string
+	SearchMode      string
 	CategoryID      int64
 	FeedID          int64
+	LabelID         int64
 	Statuses        []string
 	Tags            []string
 	GloballyVisible bool
+	GroupBy         string
 }
 
 // EntryResultSet represents the response when fetching entries.
@@ -350,6 +627,7 @@ type EntryIDsFilter struct {
 	Offset  int
 	Starred *bool
 	Status  string
+	LabelID int64
 }
 
 // EntryIDsResultSet represents the response when fetching entry ID lists.
@@ -387,6 +665,153 @@ type APIKeyCreationRequest struct {
 	Description string `json:"description"`
 }
 
+// PublicFeed represents a token-protected feed that republishes a selection of entries.
+type PublicFeed struct {
+	ID             int64      `json:"id"`
+	UserID         int64      `json:"user_id"`
+	Token          string     `json:"token"`
+	Title          string     `json:"title"`
+	Starred        bool       `json:"starred"`
+	CategoryID     int64      `json:"category_id"`
+	Tag            string     `json:"tag"`
+	SearchQuery    string     `json:"search_query"`
+	LastAccessedAt *time.Time `json:"last_accessed_at"`
+	CreatedAt      time.Time  `json:"created_at"`
+}
+
+// PublicFeeds represents a collection of public feeds.
+type PublicFeeds []*PublicFeed
+
+// PublicFeedCreationRequest represents the request to create a public feed.
+type PublicFeedCreationRequest struct {
+	Title       string `json:"title"`
+	Starred     bool   `json:"starred"`
+	CategoryID  int64  `json:"category_id"`
+	Tag         string `json:"tag"`
+	SearchQuery string `json:"search_query"`
+}
+
+// NewsletterAddress represents an email address that turns the received newsletters into feed entries.
+type NewsletterAddress struct {
+	ID             int64      `json:"id"`
+	UserID         int64      `json:"user_id"`
+	CategoryID     int64      `json:"category_id"`
+	Token          string     `json:"token"`
+	Email          string     `json:"email"`
+	Description    string     `json:"description"`
+	AllowedSenders []string   `json:"allowed_senders"`
+	LastReceivedAt *time.Time `json:"last_received_at"`
+	CreatedAt      time.Time  `json:"created_at"`
+}
+
+// NewsletterAddresses represents a collection of newsletter addresses.
+type NewsletterAddresses []*NewsletterAddress
+
+// NewsletterAddressCreationRequest represents the request to create a newsletter address.
+type NewsletterAddressCreationRequest struct {
+	CategoryID     int64    `json:"category_id"`
+	Description    string   `json:"description"`
+	AllowedSenders []string `json:"allowed_senders"`
+}
+
+// SavedSearch represents a named entry query that behaves like a virtual feed.
+type SavedSearch struct {
+	ID          int64     `json:"id"`
+	UserID      int64     `json:"user_id"`
+	Title       string    `json:"title"`
+	SearchQuery string    `json:"search_query"`
+	Statuses    []string  `json:"statuses"`
+	Starred     bool      `json:"starred"`
+	CategoryIDs []int64   `json:"category_ids"`
+	FeedIDs     []int64   `json:"feed_ids"`
+	Tags        []string  `json:"tags"`
+	MaxAgeDays  int       `json:"max_age_days"`
+	CreatedAt   time.Time `json:"created_at"`
+	TotalUnread *int      `json:"total_unread,omitempty"`
+}
+
+// SavedSearches represents a collection of saved searches.
+type SavedSearches []*SavedSearch
+
+// SavedSearchCreationRequest represents the request to create a saved search.
+type SavedSearchCreationRequest struct {
+	Title       string   `json:"title"`
+	SearchQuery string   `json:"search_query,omitempty"`
+	Statuses    []string `json:"statuses,omitempty"`
+	Starred     bool     `json:"starred,omitempty"`
+	CategoryIDs []int64  `json:"category_ids,omitempty"`
+	FeedIDs     []int64  `json:"feed_ids,omitempty"`
+	Tags        []string `json:"tags,omitempty"`
+	MaxAgeDays  int      `json:"max_age_days,omitempty"`
+}
+
+// SavedSearchModificationRequest represents the request to update a saved search.
+type SavedSearchModificationRequest struct {
+	Title       *string   `json:"title,omitempty"`
+	SearchQuery *string   `json:"search_query,omitempty"`
+	Statuses    *[]string `json:"statuses,omitempty"`
+	Starred     *bool     `json:"starred,omitempty"`
+	CategoryIDs *[]int64  `json:"category_ids,omitempty"`
+	FeedIDs     *[]int64  `json:"feed_ids,omitempty"`
+	Tags        *[]string `json:"tags,omitempty"`
+	MaxAgeDays  *int      `json:"max_age_days,omitempty"`
+}
+
+// Revision represents a previous version of an entry, replaced when the feed updated the article.
+type Revision struct {
+	ID        int64     `json:"id"`
+	EntryID   int64     `json:"entry_id"`
+	Title     string    `json:"title"`
+	Content   string    `json:"content"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Revisions represents a list of entry revisions, the most recent first.
+type Revisions []*Revision
+
+// Highlight represents a passage of an entry highlighted by the user, with an optional note.
+type Highlight struct {
+	ID          int64     `json:"id"`
+	UserID      int64     `json:"user_id"`
+	EntryID     int64     `json:"entry_id"`
+	Quote       string    `json:"quote"`
+	Prefix      string    `json:"prefix"`
+	Suffix      string    `json:"suffix"`
+	StartOffset int       `json:"start_offset"`
+	EndOffset   int       `json:"end_offset"`
+	Note        string    `json:"note"`
+	CreatedAt   time.Time `json:"created_at"`
+	ChangedAt   time.Time `json:"changed_at"`
+	EntryTitle  string    `json:"entry_title,omitempty"`
+	EntryURL    string    `json:"entry_url,omitempty"`
+	FeedID      int64     `json:"feed_id,omitempty"`
+	FeedTitle   string    `json:"feed_title,omitempty"`
+}
+
+// Highlights represents a list of highlights.
+type Highlights []*Highlight
+
+// HighlightResultSet represents the response when fetching the highlights across entries.
+type HighlightResultSet struct {
+	Total      int        `json:"total"`
+	Highlights Highlights `json:"highlights"`
+}
+
+// HighlightCreationRequest represents the request to highlight a passage of an entry.
+type HighlightCreationRequest struct {
+	Quote       string `json:"quote"`
+	Prefix      string `json:"prefix,omitempty"`
+	Suffix      string `json:"suffix,omitempty"`
+	StartOffset int    `json:"start_offset,omitempty"`
+	EndOffset   int    `json:"end_offset,omitempty"`
+	Note        string `json:"note,omitempty"`
+}
+
+// HighlightModificationRequest represents the request to update the note of a highlight.
+type HighlightModificationRequest struct {
+	Note *string `json:"note,omitempty"`
+}
+
 // SetOptionalField returns a pointer to the given value so optional request fields can be marked as set.
 //
 //go:fix inline
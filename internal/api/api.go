@@ -38,6 +38,10 @@ func NewHandler(store *storage.Storage, pool *worker.Pool) http.Handler {
 	mux.HandleFunc("PUT /v1/categories/{categoryID}/refresh", handler.refreshCategoryHandler)
 	mux.HandleFunc("GET /v1/categories/{categoryID}/entries", handler.getCategoryEntriesHandler)
 	mux.HandleFunc("GET /v1/categories/{categoryID}/entries/{entryID}", handler.getCategoryEntryHandler)
+	mux.HandleFunc("POST /v1/labels", handler.createLabelHandler)
+	mux.HandleFunc("GET /v1/labels", handler.getLabelsHandler)
+	mux.HandleFunc("PUT /v1/labels/{labelID}", handler.updateLabelHandler)
+	mux.HandleFunc("DELETE /v1/labels/{labelID}", handler.removeLabelHandler)
 	mux.HandleFunc("POST /v1/discover", handler.discoverSubscriptionsHandler)
 	mux.HandleFunc("POST /v1/feeds", handler.createFeedHandler)
 	mux.HandleFunc("GET /v1/feeds", handler.getFeedsHandler)
@@ -47,7 +51,13 @@ func NewHandler(store *storage.Storage, pool *worker.Pool) http.Handler {
 	mux.HandleFunc("GET /v1/feeds/{feedID}", handler.getFeedHandler)
 	mux.HandleFunc("PUT /v1/feeds/{feedID}", handler.updateFeedHandler)
 	mux.HandleFunc("DELETE /v1/feeds/{feedID}", handler.removeFeedHandler)
+	mux.HandleFunc("GET /v1/feeds/{feedID}/history", handler.getFeedHistoryHandler)
 	mux.HandleFunc("GET /v1/feeds/{feedID}/icon", handler.getIconByFeedIDHandler)
+	mux.HandleFunc("POST /v1/feeds/{feedID}/preview", handler.previewFeedHandler)
+	mux.HandleFunc("POST /v1/filters/simulate", handler.simulateFiltersHandler)
+	mux.HandleFunc("POST /v1/filters/apply", handler.applyFiltersHandler)
+	mux.HandleFunc("GET /v1/filters/jobs/{jobID}", handler.filterApplyJobHandler)
+	mux.HandleFunc("GET /v1/filters/stats", handler.filterRuleStatsHandler)
 	mux.HandleFunc("PUT /v1/feeds/{feedID}/mark-all-as-read", handler.markFeedAsReadHandler)
 	mux.HandleFunc("GET /v1/export", handler.exportFeedsHandler)
 	mux.HandleFunc("POST /v1/import", handler.importFeedsHandler)
@@ -63,6 +73,14 @@ func NewHandler(store *storage.Storage, pool *worker.Pool) http.Handler {
 	mux.HandleFunc("PUT /v1/entries/{entryID}/star", handler.toggleStarredHandler)
 	mux.HandleFunc("POST /v1/entries/{entryID}/save", handler.saveEntryHandler)
 	mux.HandleFunc("GET /v1/entries/{entryID}/fetch-content", handler.fetchContentHandler)
+	mux.HandleFunc("PUT /v1/entries/{entryID}/labels/{labelID}", handler.attachEntryLabelHandler)
+	mux.HandleFunc("DELETE /v1/entries/{entryID}/labels/{labelID}", handler.detachEntryLabelHandler)
+	mux.HandleFunc("GET /v1/entries/{entryID}/highlights", handler.getEntryHighlightsHandler)
+	mux.HandleFunc("POST /v1/entries/{entryID}/highlights", handler.createHighlightHandler)
+	mux.HandleFunc("PUT /v1/entries/{entryID}/highlights/{highlightID}", handler.updateHighlightHandler)
+	mux.HandleFunc("DELETE /v1/entries/{entryID}/highlights/{highlightID}", handler.removeHighlightHandler)
+	mux.HandleFunc("GET /v1/highlights", handler.getHighlightsHandler)
+	mux.HandleFunc("GET /v1/highlights/export", handler.exportHighlightsHandler)
 	mux.HandleFunc("PUT /v1/flush-history", handler.flushHistoryHandler)
 	mux.HandleFunc("DELETE /v1/flush-history", handler.flushHistoryHandler)
 	mux.HandleFunc("GET /v1/icons/{iconID}", handler.getIconByIconIDHandler)
@@ -73,6 +91,19 @@ func NewHandler(store *storage.Storage, pool *worker.Pool) http.Handler {
 	mux.HandleFunc("POST /v1/api-keys", handler.createAPIKeyHandler)
 	mux.HandleFunc("GET /v1/api-keys", handler.getAPIKeysHandler)
 	mux.HandleFunc("DELETE /v1/api-keys/{apiKeyID}", handler.deleteAPIKeyHandler)
+	mux.HandleFunc("POST /v1/public-feeds", handler.createPublicFeedHandler)
+	mux.HandleFunc("GET /v1/public-feeds", handler.getPublicFeedsHandler)
+	mux.HandleFunc("DELETE /v1/public-feeds/{publicFeedID}", handler.removePublicFeedHandler)
+	mux.HandleFunc("POST /v1/newsletter-addresses", handler.createNewsletterAddressHandler)
+	mux.HandleFunc("GET /v1/newsletter-addresses", handler.getNewsletterAddressesHandler)
+	mux.HandleFunc("DELETE /v1/newsletter-addresses/{addressID}", handler.removeNewsletterAddressHandler)
+	mux.HandleFunc("POST /v1/saved-searches", handler.createSavedSearchHandler)
+	mux.HandleFunc("GET /v1/saved-searches", handler.getSavedSearchesHandler)
+	mux.HandleFunc("GET /v1/saved-searches/{savedSearchID}", handler.getSavedSearchHandler)
+	mux.HandleFunc("PUT /v1/saved-searches/{savedSearchID}", handler.updateSavedSearchHandler)
+	mux.HandleFunc("DELETE /v1/saved-searches/{savedSearchID}", handler.removeSavedSearchHandler)
+	mux.HandleFunc("GET /v1/saved-searches/{savedSearchID}/entries", handler.getSavedSearchEntriesHandler)
+	mux.HandleFunc("PUT /v1/saved-searches/{savedSearchID}/mark-all-as-read", handler.markSavedSearchAsReadHandler)
 
 	return middleware.withCORSHeaders(middleware.validateAPIKeyAuth(middleware.validateBasicAuth(mux)))
 }
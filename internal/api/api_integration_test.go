@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"io"
 	"math/rand/v2"
+	"net/http"
 	"os"
 	"strings"
 	"testing"
@@ -886,6 +887,261 @@ func TestAPIKeysEndpoint(t *testing.T) {
 	}
 }
 
+func TestPublicFeedsEndpoint(t *testing.T) {
+	t.Parallel()
+
+	testConfig := newIntegrationTestConfig()
+	if !testConfig.isConfigured() {
+		t.Skip(skipIntegrationTestsMessage)
+	}
+
+	adminClient := miniflux.NewClient(testConfig.testBaseURL, testConfig.testAdminUsername, testConfig.testAdminPassword)
+	regularTestUser, err := adminClient.CreateUser(testConfig.genRandomUsername(), testConfig.testRegularPassword, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer adminClient.DeleteUser(regularTestUser.ID)
+
+	regularUserClient := miniflux.NewClient(testConfig.testBaseURL, regularTestUser.Username, testConfig.testRegularPassword)
+
+	publicFeed, err := regularUserClient.CreatePublicFeed(&miniflux.PublicFeedCreationRequest{Title: "Starred", Starred: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if publicFeed.Token == "" {
+		t.Fatalf(`Invalid public feed token, got "%v"`, publicFeed.Token)
+	}
+	if !publicFeed.Starred {
+		t.Fatal(`The public feed should be filtered on starred entries`)
+	}
+
+	// Create a duplicate public feed with the same title.
+	if _, err := regularUserClient.CreatePublicFeed(&miniflux.PublicFeedCreationRequest{Title: "Starred"}); err == nil {
+		t.Fatal(`Creating a duplicate public feed with the same title should raise an error`)
+	}
+
+	// Create a public feed with a category that does not exist.
+	if _, err := regularUserClient.CreatePublicFeed(&miniflux.PublicFeedCreationRequest{Title: "Category", CategoryID: 123456789}); err == nil {
+		t.Fatal(`Creating a public feed with an unknown category should raise an error`)
+	}
+
+	publicFeedURL := testConfig.testBaseURL + "/public/" + publicFeed.Token + "/atom"
+	response, err := http.Get(publicFeedURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		t.Fatalf(`Unexpected status code, got %d`, response.StatusCode)
+	}
+	if contentType := response.Header.Get("Content-Type"); contentType != "application/atom+xml; charset=utf-8" {
+		t.Fatalf(`Unexpected content type, got %q`, contentType)
+	}
+
+	etag := response.Header.Get("ETag")
+	if etag == "" {
+		t.Fatal(`The public feed response should have an ETag`)
+	}
+
+	request, err := http.NewRequest(http.MethodGet, publicFeedURL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	request.Header.Set("If-None-Match", etag)
+	response, err = http.DefaultClient.Do(request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	response.Body.Close()
+
+	if response.StatusCode != http.StatusNotModified {
+		t.Fatalf(`Expected a 304 status code, got %d`, response.StatusCode)
+	}
+
+	publicFeeds, err := regularUserClient.PublicFeeds()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(publicFeeds) != 1 || publicFeeds[0].ID != publicFeed.ID {
+		t.Fatalf(`Unexpected public feeds: %v`, publicFeeds)
+	}
+	if publicFeeds[0].LastAccessedAt == nil {
+		t.Fatal(`The last access date should be set after fetching the public feed`)
+	}
+
+	if err := regularUserClient.DeletePublicFeed(publicFeed.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	response, err = http.Get(publicFeedURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	response.Body.Close()
+
+	if response.StatusCode != http.StatusNotFound {
+		t.Fatalf(`Revoked public feeds should return a 404 status code, got %d`, response.StatusCode)
+	}
+
+	if err := regularUserClient.DeletePublicFeed(publicFeed.ID); !errors.Is(err, miniflux.ErrNotFound) {
+		t.Fatalf(`Expected "not found" error, got %v`, err)
+	}
+}
+
+func TestNewsletterAddressesEndpoint(t *testing.T) {
+	t.Parallel()
+
+	testConfig := newIntegrationTestConfig()
+	if !testConfig.isConfigured() {
+		t.Skip(skipIntegrationTestsMessage)
+	}
+
+	adminClient := miniflux.NewClient(testConfig.testBaseURL, testConfig.testAdminUsername, testConfig.testAdminPassword)
+	regularTestUser, err := adminClient.CreateUser(testConfig.genRandomUsername(), testConfig.testRegularPassword, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer adminClient.DeleteUser(regularTestUser.ID)
+
+	regularUserClient := miniflux.NewClient(testConfig.testBaseURL, regularTestUser.Username, testConfig.testRegularPassword)
+
+	category, err := regularUserClient.CreateCategory("Newsletters")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	address, err := regularUserClient.CreateNewsletterAddress(&miniflux.NewsletterAddressCreationRequest{
+		CategoryID:     category.ID,
+		Description:    "Newsletters",
+		AllowedSenders: []string{"Example.com"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if address.Token == "" || !strings.HasPrefix(address.Email, address.Token+"@") {
+		t.Fatalf(`Invalid newsletter address, got %q`, address.Email)
+	}
+	if len(address.AllowedSenders) != 1 || address.AllowedSenders[0] != "example.com" {
+		t.Fatalf(`Unexpected allowed senders, got %v`, address.AllowedSenders)
+	}
+
+	// Create a duplicate newsletter address with the same description.
+	if _, err := regularUserClient.CreateNewsletterAddress(&miniflux.NewsletterAddressCreationRequest{CategoryID: category.ID, Description: "Newsletters"}); err == nil {
+		t.Fatal(`Creating a duplicate newsletter address with the same description should raise an error`)
+	}
+
+	// Create a newsletter address with an invalid allowed sender.
+	if _, err := regularUserClient.CreateNewsletterAddress(&miniflux.NewsletterAddressCreationRequest{CategoryID: category.ID, Description: "Other", AllowedSenders: []string{"invalid"}}); err == nil {
+		t.Fatal(`Creating a newsletter address with an invalid allowed sender should raise an error`)
+	}
+
+	addresses, err := regularUserClient.NewsletterAddresses()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(addresses) != 1 || addresses[0].ID != address.ID || addresses[0].Email != address.Email {
+		t.Fatalf(`Unexpected newsletter addresses: %v`, addresses)
+	}
+
+	if err := regularUserClient.DeleteNewsletterAddress(address.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := regularUserClient.DeleteNewsletterAddress(address.ID); !errors.Is(err, miniflux.ErrNotFound) {
+		t.Fatalf(`Expected "not found" error, got %v`, err)
+	}
+}
+
+func TestSavedSearchesEndpoint(t *testing.T) {
+	t.Parallel()
+
+	testConfig := newIntegrationTestConfig()
+	if !testConfig.isConfigured() {
+		t.Skip(skipIntegrationTestsMessage)
+	}
+
+	adminClient := miniflux.NewClient(testConfig.testBaseURL, testConfig.testAdminUsername, testConfig.testAdminPassword)
+	regularTestUser, err := adminClient.CreateUser(testConfig.genRandomUsername(), testConfig.testRegularPassword, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer adminClient.DeleteUser(regularTestUser.ID)
+
+	regularUserClient := miniflux.NewClient(testConfig.testBaseURL, regularTestUser.Username, testConfig.testRegularPassword)
+
+	feedID, err := regularUserClient.CreateFeed(&miniflux.FeedCreationRequest{FeedURL: testConfig.testFeedURL})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	savedSearch, err := regularUserClient.CreateSavedSearch(&miniflux.SavedSearchCreationRequest{
+		Title:    "Unread",
+		Statuses: []string{"unread"},
+		FeedIDs:  []int64{feedID},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if savedSearch.Title != "Unread" || len(savedSearch.FeedIDs) != 1 || savedSearch.FeedIDs[0] != feedID {
+		t.Fatalf(`Unexpected saved search: %+v`, savedSearch)
+	}
+
+	if _, err := regularUserClient.CreateSavedSearch(&miniflux.SavedSearchCreationRequest{Title: "unread"}); err == nil {
+		t.Fatal(`Creating a duplicate saved search with the same title should raise an error`)
+	}
+
+	if _, err := regularUserClient.CreateSavedSearch(&miniflux.SavedSearchCreationRequest{Title: "Invalid", Statuses: []string{"removed"}}); err == nil {
+		t.Fatal(`Creating a saved search with an invalid status should raise an error`)
+	}
+
+	result, err := regularUserClient.SavedSearchEntries(savedSearch.ID, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Total == 0 {
+		t.Fatal(`The saved search should match the unread entries of the feed`)
+	}
+
+	savedSearches, err := regularUserClient.SavedSearchesWithCounters()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(savedSearches) != 1 || savedSearches[0].TotalUnread == nil || *savedSearches[0].TotalUnread != result.Total {
+		t.Fatalf(`Unexpected saved searches: %+v`, savedSearches)
+	}
+
+	if err := regularUserClient.MarkSavedSearchAsRead(savedSearch.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	result, err = regularUserClient.SavedSearchEntries(savedSearch.ID, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Total != 0 {
+		t.Fatalf(`The saved search should not match any entry after marking it as read, got %d`, result.Total)
+	}
+
+	updatedSavedSearch, err := regularUserClient.UpdateSavedSearch(savedSearch.ID, &miniflux.SavedSearchModificationRequest{
+		Statuses: new([]string{"read"}),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updatedSavedSearch.Title != "Unread" || len(updatedSavedSearch.Statuses) != 1 || updatedSavedSearch.Statuses[0] != "read" {
+		t.Fatalf(`Unexpected saved search after update: %+v`, updatedSavedSearch)
+	}
+
+	if err := regularUserClient.DeleteSavedSearch(savedSearch.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := regularUserClient.SavedSearch(savedSearch.ID); !errors.Is(err, miniflux.ErrNotFound) {
+		t.Fatalf(`Expected "not found" error, got %v`, err)
+	}
+}
+
 func TestMarkUserAsReadEndpoint(t *testing.T) {
 	t.Parallel()
 
@@ -1952,6 +2208,57 @@ func TestRefreshFeedEndpoint(t *testing.T) {
 	}
 }
 
+func TestGetFeedHistoryEndpoint(t *testing.T) {
+	t.Parallel()
+
+	testConfig := newIntegrationTestConfig()
+	if !testConfig.isConfigured() {
+		t.Skip(skipIntegrationTestsMessage)
+	}
+
+	adminClient := miniflux.NewClient(testConfig.testBaseURL, testConfig.testAdminUsername, testConfig.testAdminPassword)
+
+	regularTestUser, err := adminClient.CreateUser(testConfig.genRandomUsername(), testConfig.testRegularPassword, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer adminClient.DeleteUser(regularTestUser.ID)
+
+	regularUserClient := miniflux.NewClient(testConfig.testBaseURL, regularTestUser.Username, testConfig.testRegularPassword)
+
+	feedID, err := regularUserClient.CreateFeed(&miniflux.FeedCreationRequest{
+		FeedURL: testConfig.testFeedURL,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := regularUserClient.RefreshFeed(feedID); err != nil {
+		t.Fatal(err)
+	}
+
+	feedFetches, err := regularUserClient.FeedHistory(feedID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(feedFetches) != 1 {
+		t.Fatalf(`Invalid number of feed fetches, got %d instead of %d`, len(feedFetches), 1)
+	}
+
+	if feedFetches[0].FeedID != feedID {
+		t.Fatalf(`Invalid feed ID, got %d instead of %d`, feedFetches[0].FeedID, feedID)
+	}
+
+	if feedFetches[0].ErrorMsg != "" {
+		t.Fatalf(`The refresh should have succeeded, got %q`, feedFetches[0].ErrorMsg)
+	}
+
+	if _, err := regularUserClient.FeedHistory(123456789); !errors.Is(err, miniflux.ErrNotFound) {
+		t.Fatalf(`Fetching the history of an unknown feed should raise a not found error`)
+	}
+}
+
 func TestGetFeedEndpoint(t *testing.T) {
 	t.Parallel()
 
@@ -201,3 +201,16 @@ func TestNewHandlerSupportsBasePathStripping(t *testing.T) {
 		})
 	}
 }
+
+func TestGetFeedHistoryHandlerRejectsZeroLimit(t *testing.T) {
+	h := &handler{}
+	r := httptest.NewRequest(http.MethodGet, "/v1/feeds/1/history?limit=0", nil)
+	r.SetPathValue("feedID", "1")
+	w := httptest.NewRecorder()
+
+	h.getFeedHistoryHandler(w, r)
+
+	if got := w.Code; got != http.StatusBadRequest {
+		t.Fatalf(`Unexpected status code, got %d instead of %d`, got, http.StatusBadRequest)
+	}
+}
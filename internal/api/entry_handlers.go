@@ -25,7 +25,7 @@ import (
 )
 
 func (h *handler) getEntryFromBuilder(w http.ResponseWriter, r *http.Request, b *storage.EntryQueryBuilder) {
-	entry, err := b.GetEntry()
+	entry, err := b.WithEntryDetails().GetEntry()
 	if err != nil {
 		response.JSONServerError(w, r, err)
 		return
@@ -38,6 +38,7 @@ func (h *handler) getEntryFromBuilder(w http.ResponseWriter, r *http.Request, b
 
 	entry.Content = mediaproxy.RewriteDocumentWithAbsoluteProxyURL(entry.Content)
 	entry.Enclosures.ProxifyEnclosureURL(config.Opts.MediaProxyMode(), config.Opts.MediaProxyResourceTypes())
+	entry.ProxifyThumbnailURL(config.Opts.MediaProxyMode(), config.Opts.MediaProxyResourceTypes())
 
 	response.JSON(w, r, entry)
 }
@@ -173,8 +174,27 @@ func (h *handler) findEntries(w http.ResponseWriter, r *http.Request, feedID int
 		}
 	}
 
+	labelID := request.QueryInt64Param(r, "label_id", 0)
+	if labelID > 0 {
+		label, err := h.store.Label(userID, labelID)
+		if err != nil {
+			response.JSONServerError(w, r, err)
+			return
+		}
+		if label == nil {
+			response.JSONBadRequest(w, r, errors.New("invalid label ID"))
+			return
+		}
+	}
+
 	tags := request.QueryStringParamList(r, "tags")
 
+	groupBy := request.QueryStringParam(r, "group_by", "")
+	if groupBy != "" && groupBy != "story" {
+		response.JSONBadRequest(w, r, errors.New(`invalid group_by value, only "story" is supported`))
+		return
+	}
+
 	builder := h.store.NewEntryQueryBuilder(userID).
 		WithFeedID(feedID).
 		WithCategoryID(categoryID).
@@ -183,7 +203,10 @@ func (h *handler) findEntries(w http.ResponseWriter, r *http.Request, feedID int
 		WithOffset(offset).
 		WithLimit(limit).
 		WithTags(tags...).
-		WithEnclosures()
+		WithLabelID(labelID).
+		WithStoryGrouping(groupBy == "story").
+		WithEnclosures().
+		WithLabels()
 
 	if request.HasQueryParam(r, "globally_visible") {
 		globallyVisible := request.QueryBoolParam(r, "globally_visible", true)
@@ -204,6 +227,7 @@ func (h *handler) findEntries(w http.ResponseWriter, r *http.Request, feedID int
 	for i := range entries {
 		entries[i].Content = mediaproxy.RewriteDocumentWithAbsoluteProxyURL(entries[i].Content)
 		entries[i].Enclosures.ProxifyEnclosureURL(config.Opts.MediaProxyMode(), config.Opts.MediaProxyResourceTypes())
+		entries[i].ProxifyThumbnailURL(config.Opts.MediaProxyMode(), config.Opts.MediaProxyResourceTypes())
 	}
 
 	response.JSON(w, r, &entriesResponse{Total: count, Entries: entries})
@@ -267,6 +291,7 @@ func (h *handler) saveEntryHandler(w http.ResponseWriter, r *http.Request) {
 
 	entry, err := h.store.NewEntryQueryBuilder(request.UserID(r)).
 		WithEntryIDs(entryID).
+		WithHighlights().
 		GetEntry()
 	if err != nil {
 		response.JSONServerError(w, r, err)
@@ -557,6 +582,10 @@ func (h *handler) getEntryIDsHandler(w http.ResponseWriter, r *http.Request) {
 		builder.WithStatuses(request.QueryStringParam(r, "status", ""))
 	}
 
+	if labelID := request.QueryInt64Param(r, "label_id", 0); labelID > 0 {
+		builder.WithLabelID(labelID)
+	}
+
 	entryIDs, total, err := builder.GetEntryIDsWithCount()
 	if err != nil {
 		response.JSONServerError(w, r, err)
@@ -617,7 +646,12 @@ func configureFilters(builder *storage.EntryQueryBuilder, r *http.Request) *stor
 	}
 
 	if searchQuery := request.QueryStringParam(r, "search", ""); searchQuery != "" {
-		builder = builder.WithSearchQuery(searchQuery)
+		if request.QueryStringParam(r, "search_mode", "") == "fuzzy" {
+			builder = builder.WithFuzzySearchQuery(searchQuery)
+		} else {
+			builder = builder.WithSearchQuery(searchQuery)
+		}
+		builder = builder.WithSearchSnippets()
 	}
 
 	return builder
@@ -15,6 +15,7 @@ import (
 	"miniflux.app/v2/internal/http/response"
 	"miniflux.app/v2/internal/model"
 	feedHandler "miniflux.app/v2/internal/reader/handler"
+	"miniflux.app/v2/internal/reader/processor"
 	"miniflux.app/v2/internal/validator"
 )
 
@@ -247,6 +248,83 @@ func (h *handler) getFeedHandler(w http.ResponseWriter, r *http.Request) {
 	response.JSON(w, r, feed)
 }
 
+func (h *handler) getFeedHistoryHandler(w http.ResponseWriter, r *http.Request) {
+	feedID := request.RouteInt64Param(r, "feedID")
+	if feedID == 0 {
+		response.JSONBadRequest(w, r, errors.New("invalid feed ID"))
+		return
+	}
+
+	limit := min(request.QueryIntParam(r, "limit", 100), 1000)
+	if limit < 1 {
+		response.JSONBadRequest(w, r, errors.New("limit value should be >= 1"))
+		return
+	}
+
+	userID := request.UserID(r)
+	exists, err := h.store.FeedExists(userID, feedID)
+	if err != nil {
+		response.JSONServerError(w, r, err)
+		return
+	}
+	if !exists {
+		response.JSONNotFound(w, r)
+		return
+	}
+
+	feedFetches, err := h.store.FeedFetches(userID, feedID, limit)
+	if err != nil {
+		response.JSONServerError(w, r, err)
+		return
+	}
+
+	response.JSON(w, r, feedFetches)
+}
+
+func (h *handler) previewFeedHandler(w http.ResponseWriter, r *http.Request) {
+	feedID := request.RouteInt64Param(r, "feedID")
+	if feedID == 0 {
+		response.JSONBadRequest(w, r, errors.New("invalid feed ID"))
+		return
+	}
+
+	var feedPreviewRequest model.FeedPreviewRequest
+	if err := json_parser.NewDecoder(r.Body).Decode(&feedPreviewRequest); err != nil {
+		response.JSONBadRequest(w, r, err)
+		return
+	}
+
+	userID := request.UserID(r)
+	user, err := h.store.UserByID(userID)
+	if err != nil {
+		response.JSONServerError(w, r, err)
+		return
+	}
+
+	feed, err := h.store.FeedByID(userID, feedID)
+	if err != nil {
+		response.JSONServerError(w, r, err)
+		return
+	}
+
+	if feed == nil {
+		response.JSONNotFound(w, r)
+		return
+	}
+
+	// The rules are applied on the feed loaded from the database, the candidate rules are never saved.
+	feedPreviewRequest.Patch(feed)
+
+	// The feed is fetched again, the stored entries have already been rewritten and sanitized.
+	entries, localizedError := feedHandler.FetchFeedEntries(feed, feedPreviewRequest.EntryLimit())
+	if localizedError != nil {
+		response.JSONServerError(w, r, localizedError.Error())
+		return
+	}
+
+	response.JSON(w, r, processor.PreviewFeedEntries(feed, entries, user))
+}
+
 func (h *handler) removeFeedHandler(w http.ResponseWriter, r *http.Request) {
 	feedID := request.RouteInt64Param(r, "feedID")
 	if feedID == 0 {
@@ -0,0 +1,138 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package api // import "miniflux.app/v2/internal/api"
+
+import (
+	json_parser "encoding/json"
+	"errors"
+	"net/http"
+
+	"miniflux.app/v2/internal/http/request"
+	"miniflux.app/v2/internal/http/response"
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/reader/filter"
+	"miniflux.app/v2/internal/reader/processor"
+	"miniflux.app/v2/internal/validator"
+)
+
+func (h *handler) simulateFiltersHandler(w http.ResponseWriter, r *http.Request) {
+	var simulationRequest model.FilterSimulationRequest
+	if err := json_parser.NewDecoder(r.Body).Decode(&simulationRequest); err != nil {
+		response.JSONBadRequest(w, r, err)
+		return
+	}
+
+	userID := request.UserID(r)
+	user, err := h.store.UserByID(userID)
+	if err != nil {
+		response.JSONServerError(w, r, err)
+		return
+	}
+
+	builder := h.store.NewEntryQueryBuilder(userID).
+		WithSorting("published_at", "desc").
+		WithLimitAndMaximum(simulationRequest.EntryLimit(), model.MaxFilterSimulationLimit)
+
+	var simulator *filter.Simulator
+	if simulationRequest.FeedID > 0 {
+		feed, err := h.store.FeedByID(userID, simulationRequest.FeedID)
+		if err != nil {
+			response.JSONServerError(w, r, err)
+			return
+		}
+
+		if feed == nil {
+			response.JSONNotFound(w, r)
+			return
+		}
+
+		simulator = filter.NewSimulator(
+			user.BlockFilterEntryRules,
+			simulationRequest.BlockRules(feed.BlockFilterEntryRules),
+			user.KeepFilterEntryRules,
+			simulationRequest.KeepRules(feed.KeepFilterEntryRules),
+		)
+		builder.WithFeedID(feed.ID)
+	} else {
+		// Feed rules differ from one feed to another, only the user rules are evaluated on the whole account.
+		simulator = filter.NewSimulator(
+			simulationRequest.BlockRules(user.BlockFilterEntryRules),
+			"",
+			simulationRequest.KeepRules(user.KeepFilterEntryRules),
+			"",
+		)
+	}
+
+	entries, err := builder.GetEntries()
+	if err != nil {
+		response.JSONServerError(w, r, err)
+		return
+	}
+
+	for _, entry := range entries {
+		simulator.Evaluate(entry)
+	}
+
+	response.JSON(w, r, simulator.Result())
+}
+
+func (h *handler) applyFiltersHandler(w http.ResponseWriter, r *http.Request) {
+	var filterApplyRequest model.FilterApplyRequest
+	if err := json_parser.NewDecoder(r.Body).Decode(&filterApplyRequest); err != nil {
+		response.JSONBadRequest(w, r, err)
+		return
+	}
+
+	userID := request.UserID(r)
+	if validationErr := validator.ValidateFilterApplyRequest(h.store, userID, &filterApplyRequest); validationErr != nil {
+		response.JSONBadRequest(w, r, validationErr.Error())
+		return
+	}
+
+	user, err := h.store.UserByID(userID)
+	if err != nil {
+		response.JSONServerError(w, r, err)
+		return
+	}
+
+	// The entries are processed in the background, the job reports the progress.
+	job, err := processor.StartFilterRulesJob(h.store, user, &filterApplyRequest)
+	if err != nil {
+		response.JSONServerError(w, r, err)
+		return
+	}
+
+	response.JSONCreated(w, r, job)
+}
+
+func (h *handler) filterApplyJobHandler(w http.ResponseWriter, r *http.Request) {
+	jobID := request.RouteInt64Param(r, "jobID")
+	if jobID == 0 {
+		response.JSONBadRequest(w, r, errors.New("invalid job ID"))
+		return
+	}
+
+	job, err := h.store.FilterApplyJobByID(request.UserID(r), jobID)
+	if err != nil {
+		response.JSONServerError(w, r, err)
+		return
+	}
+
+	if job == nil {
+		response.JSONNotFound(w, r)
+		return
+	}
+
+	response.JSON(w, r, job)
+}
+
+func (h *handler) filterRuleStatsHandler(w http.ResponseWriter, r *http.Request) {
+	stats, err := h.store.FilterRuleStats(request.UserID(r))
+	if err != nil {
+		response.JSONServerError(w, r, err)
+		return
+	}
+
+	response.JSON(w, r, stats)
+}
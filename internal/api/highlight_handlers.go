@@ -0,0 +1,181 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package api // import "miniflux.app/v2/internal/api"
+
+import (
+	json_parser "encoding/json"
+	"errors"
+	"net/http"
+
+	"miniflux.app/v2/internal/http/request"
+	"miniflux.app/v2/internal/http/response"
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/validator"
+)
+
+func (h *handler) getHighlightsHandler(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+
+	limit := request.QueryIntParam(r, "limit", 100)
+	offset := request.QueryIntParam(r, "offset", 0)
+	if limit < 0 || offset < 0 {
+		response.JSONBadRequest(w, r, errors.New("limit and offset must be positive"))
+		return
+	}
+
+	highlights, err := h.store.Highlights(userID, limit, offset)
+	if err != nil {
+		response.JSONServerError(w, r, err)
+		return
+	}
+
+	count, err := h.store.CountHighlights(userID)
+	if err != nil {
+		response.JSONServerError(w, r, err)
+		return
+	}
+
+	response.JSON(w, r, &model.HighlightsResponse{Total: count, Highlights: highlights})
+}
+
+func (h *handler) exportHighlightsHandler(w http.ResponseWriter, r *http.Request) {
+	highlights, err := h.store.Highlights(request.UserID(r), 0, 0)
+	if err != nil {
+		response.JSONServerError(w, r, err)
+		return
+	}
+
+	response.MarkdownAttachment(w, r, "highlights.md", highlights.ExportMarkdown())
+}
+
+func (h *handler) getEntryHighlightsHandler(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+
+	entryID, found := h.findEntryForHighlights(w, r)
+	if !found {
+		return
+	}
+
+	highlights, err := h.store.EntryHighlights(userID, entryID)
+	if err != nil {
+		response.JSONServerError(w, r, err)
+		return
+	}
+
+	response.JSON(w, r, highlights)
+}
+
+func (h *handler) createHighlightHandler(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+
+	entryID, found := h.findEntryForHighlights(w, r)
+	if !found {
+		return
+	}
+
+	var highlightCreationRequest model.HighlightCreationRequest
+	if err := json_parser.NewDecoder(r.Body).Decode(&highlightCreationRequest); err != nil {
+		response.JSONBadRequest(w, r, err)
+		return
+	}
+
+	if validationErr := validator.ValidateHighlightCreation(&highlightCreationRequest); validationErr != nil {
+		response.JSONBadRequest(w, r, validationErr.Error())
+		return
+	}
+
+	highlight, err := h.store.CreateHighlight(userID, entryID, &highlightCreationRequest)
+	if err != nil {
+		response.JSONServerError(w, r, err)
+		return
+	}
+
+	response.JSONCreated(w, r, highlight)
+}
+
+func (h *handler) updateHighlightHandler(w http.ResponseWriter, r *http.Request) {
+	highlight, found := h.findEntryHighlight(w, r)
+	if !found {
+		return
+	}
+
+	var highlightModificationRequest model.HighlightModificationRequest
+	if err := json_parser.NewDecoder(r.Body).Decode(&highlightModificationRequest); err != nil {
+		response.JSONBadRequest(w, r, err)
+		return
+	}
+
+	highlightModificationRequest.Patch(highlight)
+
+	if err := h.store.UpdateHighlight(highlight); err != nil {
+		response.JSONServerError(w, r, err)
+		return
+	}
+
+	response.JSONCreated(w, r, highlight)
+}
+
+func (h *handler) removeHighlightHandler(w http.ResponseWriter, r *http.Request) {
+	highlight, found := h.findEntryHighlight(w, r)
+	if !found {
+		return
+	}
+
+	if err := h.store.RemoveHighlight(highlight.UserID, highlight.ID); err != nil {
+		response.JSONServerError(w, r, err)
+		return
+	}
+
+	response.NoContent(w, r)
+}
+
+// findEntryForHighlights returns the entry ID of the route, the response is written when the entry is not found.
+func (h *handler) findEntryForHighlights(w http.ResponseWriter, r *http.Request) (int64, bool) {
+	entryID := request.RouteInt64Param(r, "entryID")
+	if entryID == 0 {
+		response.JSONBadRequest(w, r, errors.New("invalid entry ID"))
+		return 0, false
+	}
+
+	entryCount, err := h.store.NewEntryQueryBuilder(request.UserID(r)).WithEntryIDs(entryID).CountEntries()
+	if err != nil {
+		response.JSONServerError(w, r, err)
+		return 0, false
+	}
+
+	if entryCount == 0 {
+		response.JSONNotFound(w, r)
+		return 0, false
+	}
+
+	return entryID, true
+}
+
+// findEntryHighlight returns the highlight of the route, the response is written when the highlight is not found.
+func (h *handler) findEntryHighlight(w http.ResponseWriter, r *http.Request) (*model.Highlight, bool) {
+	entryID := request.RouteInt64Param(r, "entryID")
+	if entryID == 0 {
+		response.JSONBadRequest(w, r, errors.New("invalid entry ID"))
+		return nil, false
+	}
+
+	highlightID := request.RouteInt64Param(r, "highlightID")
+	if highlightID == 0 {
+		response.JSONBadRequest(w, r, errors.New("invalid highlight ID"))
+		return nil, false
+	}
+
+	highlight, err := h.store.Highlight(request.UserID(r), highlightID)
+	if err != nil {
+		response.JSONServerError(w, r, err)
+		return nil, false
+	}
+
+	if highlight == nil || highlight.EntryID != entryID {
+		response.JSONNotFound(w, r)
+		return nil, false
+	}
+
+	return highlight, true
+}
@@ -0,0 +1,93 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package api // import "miniflux.app/v2/internal/api"
+
+import (
+	json_parser "encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"miniflux.app/v2/internal/config"
+	"miniflux.app/v2/internal/http/request"
+	"miniflux.app/v2/internal/http/response"
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/storage"
+	"miniflux.app/v2/internal/validator"
+)
+
+// newsletterAddressResponse adds the email address, which depends on the configured domain, to the newsletter address.
+type newsletterAddressResponse struct {
+	*model.NewsletterAddress
+	Email string `json:"email"`
+}
+
+func newNewsletterAddressResponse(address *model.NewsletterAddress) *newsletterAddressResponse {
+	return &newsletterAddressResponse{
+		NewsletterAddress: address,
+		Email:             address.Address(config.Opts.NewsletterDomain()),
+	}
+}
+
+func (h *handler) createNewsletterAddressHandler(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+
+	var addressCreationRequest model.NewsletterAddressCreationRequest
+	if err := json_parser.NewDecoder(r.Body).Decode(&addressCreationRequest); err != nil {
+		response.JSONBadRequest(w, r, err)
+		return
+	}
+
+	addressCreationRequest.Description = strings.TrimSpace(addressCreationRequest.Description)
+	for i, allowedSender := range addressCreationRequest.AllowedSenders {
+		addressCreationRequest.AllowedSenders[i] = strings.ToLower(strings.TrimSpace(allowedSender))
+	}
+
+	if validationErr := validator.ValidateNewsletterAddressCreation(h.store, userID, &addressCreationRequest); validationErr != nil {
+		response.JSONBadRequest(w, r, validationErr.Error())
+		return
+	}
+
+	address, err := h.store.CreateNewsletterAddress(userID, &addressCreationRequest)
+	if err != nil {
+		response.JSONServerError(w, r, err)
+		return
+	}
+
+	response.JSONCreated(w, r, newNewsletterAddressResponse(address))
+}
+
+func (h *handler) getNewsletterAddressesHandler(w http.ResponseWriter, r *http.Request) {
+	addresses, err := h.store.NewsletterAddresses(request.UserID(r))
+	if err != nil {
+		response.JSONServerError(w, r, err)
+		return
+	}
+
+	addressResponses := make([]*newsletterAddressResponse, 0, len(addresses))
+	for _, address := range addresses {
+		addressResponses = append(addressResponses, newNewsletterAddressResponse(address))
+	}
+
+	response.JSON(w, r, addressResponses)
+}
+
+func (h *handler) removeNewsletterAddressHandler(w http.ResponseWriter, r *http.Request) {
+	addressID := request.RouteInt64Param(r, "addressID")
+	if addressID == 0 {
+		response.JSONBadRequest(w, r, errors.New("invalid newsletter address ID"))
+		return
+	}
+
+	if err := h.store.RemoveNewsletterAddress(request.UserID(r), addressID); err != nil {
+		if errors.Is(err, storage.ErrNewsletterAddressNotFound) {
+			response.JSONNotFound(w, r)
+			return
+		}
+		response.JSONServerError(w, r, err)
+		return
+	}
+
+	response.NoContent(w, r)
+}
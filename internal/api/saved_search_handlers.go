@@ -0,0 +1,202 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package api // import "miniflux.app/v2/internal/api"
+
+import (
+	json_parser "encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"miniflux.app/v2/internal/config"
+	"miniflux.app/v2/internal/http/request"
+	"miniflux.app/v2/internal/http/response"
+	"miniflux.app/v2/internal/mediaproxy"
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/storage"
+	"miniflux.app/v2/internal/validator"
+)
+
+func (h *handler) createSavedSearchHandler(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+
+	var savedSearchCreationRequest model.SavedSearchCreationRequest
+	if err := json_parser.NewDecoder(r.Body).Decode(&savedSearchCreationRequest); err != nil {
+		response.JSONBadRequest(w, r, err)
+		return
+	}
+
+	if validationErr := validator.ValidateSavedSearchCreation(h.store, userID, &savedSearchCreationRequest); validationErr != nil {
+		response.JSONBadRequest(w, r, validationErr.Error())
+		return
+	}
+
+	savedSearch, err := h.store.CreateSavedSearch(userID, &savedSearchCreationRequest)
+	if err != nil {
+		response.JSONServerError(w, r, err)
+		return
+	}
+
+	response.JSONCreated(w, r, savedSearch)
+}
+
+func (h *handler) updateSavedSearchHandler(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+
+	savedSearch, found := h.fetchSavedSearch(w, r)
+	if !found {
+		return
+	}
+
+	var savedSearchModificationRequest model.SavedSearchModificationRequest
+	if err := json_parser.NewDecoder(r.Body).Decode(&savedSearchModificationRequest); err != nil {
+		response.JSONBadRequest(w, r, err)
+		return
+	}
+
+	if validationErr := validator.ValidateSavedSearchModification(h.store, userID, savedSearch, &savedSearchModificationRequest); validationErr != nil {
+		response.JSONBadRequest(w, r, validationErr.Error())
+		return
+	}
+
+	savedSearchModificationRequest.Patch(savedSearch)
+
+	if err := h.store.UpdateSavedSearch(savedSearch); err != nil {
+		response.JSONServerError(w, r, err)
+		return
+	}
+
+	response.JSONCreated(w, r, savedSearch)
+}
+
+func (h *handler) getSavedSearchesHandler(w http.ResponseWriter, r *http.Request) {
+	var savedSearches model.SavedSearches
+	var err error
+
+	if request.QueryBoolParam(r, "counts", false) {
+		savedSearches, err = h.store.SavedSearchesWithUnreadCount(request.UserID(r))
+	} else {
+		savedSearches, err = h.store.SavedSearches(request.UserID(r))
+	}
+
+	if err != nil {
+		response.JSONServerError(w, r, err)
+		return
+	}
+	response.JSON(w, r, savedSearches)
+}
+
+func (h *handler) getSavedSearchHandler(w http.ResponseWriter, r *http.Request) {
+	savedSearch, found := h.fetchSavedSearch(w, r)
+	if !found {
+		return
+	}
+
+	response.JSON(w, r, savedSearch)
+}
+
+func (h *handler) removeSavedSearchHandler(w http.ResponseWriter, r *http.Request) {
+	savedSearchID := request.RouteInt64Param(r, "savedSearchID")
+	if savedSearchID == 0 {
+		response.JSONBadRequest(w, r, errors.New("invalid saved search ID"))
+		return
+	}
+
+	if err := h.store.RemoveSavedSearch(request.UserID(r), savedSearchID); err != nil {
+		if errors.Is(err, storage.ErrSavedSearchNotFound) {
+			response.JSONNotFound(w, r)
+			return
+		}
+		response.JSONServerError(w, r, err)
+		return
+	}
+
+	response.NoContent(w, r)
+}
+
+func (h *handler) getSavedSearchEntriesHandler(w http.ResponseWriter, r *http.Request) {
+	savedSearch, found := h.fetchSavedSearch(w, r)
+	if !found {
+		return
+	}
+
+	order := request.QueryStringParam(r, "order", model.DefaultSortingOrder)
+	if err := validator.ValidateEntryOrder(order); err != nil {
+		response.JSONBadRequest(w, r, err)
+		return
+	}
+
+	direction := request.QueryStringParam(r, "direction", model.DefaultSortingDirection)
+	if err := validator.ValidateDirection(direction); err != nil {
+		response.JSONBadRequest(w, r, err)
+		return
+	}
+
+	limit := request.QueryIntParam(r, "limit", 100)
+	offset := request.QueryIntParam(r, "offset", 0)
+	if err := validator.ValidateRange(offset, limit); err != nil {
+		response.JSONBadRequest(w, r, err)
+		return
+	}
+
+	builder := h.store.NewEntryQueryBuilder(savedSearch.UserID).
+		WithSavedSearch(savedSearch).
+		WithSorting(order, direction).
+		WithOffset(offset).
+		WithLimit(limit).
+		WithEnclosures().
+		WithLabels()
+
+	builder = configureFilters(builder, r)
+
+	entries, count, err := builder.GetEntriesWithCount()
+	if err != nil {
+		response.JSONServerError(w, r, err)
+		return
+	}
+
+	for i := range entries {
+		entries[i].Content = mediaproxy.RewriteDocumentWithAbsoluteProxyURL(entries[i].Content)
+		entries[i].Enclosures.ProxifyEnclosureURL(config.Opts.MediaProxyMode(), config.Opts.MediaProxyResourceTypes())
+		entries[i].ProxifyThumbnailURL(config.Opts.MediaProxyMode(), config.Opts.MediaProxyResourceTypes())
+	}
+
+	response.JSON(w, r, &entriesResponse{Total: count, Entries: entries})
+}
+
+func (h *handler) markSavedSearchAsReadHandler(w http.ResponseWriter, r *http.Request) {
+	savedSearch, found := h.fetchSavedSearch(w, r)
+	if !found {
+		return
+	}
+
+	if err := h.store.MarkSavedSearchAsRead(savedSearch.UserID, savedSearch, time.Now()); err != nil {
+		response.JSONServerError(w, r, err)
+		return
+	}
+
+	response.NoContent(w, r)
+}
+
+// fetchSavedSearch returns the saved search of the route, the error response is sent when it is not found.
+func (h *handler) fetchSavedSearch(w http.ResponseWriter, r *http.Request) (*model.SavedSearch, bool) {
+	savedSearchID := request.RouteInt64Param(r, "savedSearchID")
+	if savedSearchID == 0 {
+		response.JSONBadRequest(w, r, errors.New("invalid saved search ID"))
+		return nil, false
+	}
+
+	savedSearch, err := h.store.SavedSearch(request.UserID(r), savedSearchID)
+	if err != nil {
+		response.JSONServerError(w, r, err)
+		return nil, false
+	}
+
+	if savedSearch == nil {
+		response.JSONNotFound(w, r)
+		return nil, false
+	}
+
+	return savedSearch, true
+}
@@ -0,0 +1,37 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli // import "miniflux.app/v2/internal/cli"
+
+import (
+	"fmt"
+
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/reader/processor"
+	"miniflux.app/v2/internal/storage"
+	"miniflux.app/v2/internal/validator"
+)
+
+func applyFilterRules(store *storage.Storage, username, outcome string) {
+	user, err := store.UserByUsername(username)
+	if err != nil {
+		printfAndExit("unable to find user: %w", err)
+	}
+
+	if user == nil {
+		printfAndExit("user %q not found", username)
+	}
+
+	filterApplyRequest := &model.FilterApplyRequest{Outcome: outcome}
+	if validationErr := validator.ValidateFilterApplyRequest(store, user.ID, filterApplyRequest); validationErr != nil {
+		printErrorAndExit(validationErr.Error())
+	}
+
+	result, err := processor.ApplyFilterRules(store, user, filterApplyRequest)
+	if err != nil {
+		printfAndExit("unable to apply filter rules: %w", err)
+	}
+	flushFilterRuleStats(store)
+
+	fmt.Printf("%d entries evaluated, %d entries blocked (%s)\n", result.EntriesCount, result.BlockedCount, outcome)
+}
@@ -22,6 +22,14 @@ func runCleanupTasks(store *storage.Storage) {
 		)
 	}
 
+	if nbFeedFetches, err := store.CleanOldFeedFetches(config.Opts.CleanupRemoveFeedHistoryInterval()); err != nil {
+		slog.Error("Unable to clean old feed fetch history", slog.Any("error", err))
+	} else {
+		slog.Info("Feed fetch history cleanup completed",
+			slog.Int64("feed_fetches_removed", nbFeedFetches),
+		)
+	}
+
 	startTime := time.Now()
 	if rowsAffected, err := store.ArchiveEntries(model.EntryStatusRead, config.Opts.CleanupArchiveReadInterval(), config.Opts.CleanupArchiveBatchSize()); err != nil {
 		slog.Error("Unable to archive read entries", slog.Any("error", err))
@@ -34,6 +34,10 @@ const (
 	flagRunCleanupTasksHelp  = "Run cleanup tasks (delete old sessions and archive old entries)"
 	flagExportUserFeedsHelp  = "Export user feeds (provide the username as argument)"
 	flagResetNextCheckAtHelp = "Reset the next check time for all feeds"
+	flagApplyFilterRulesHelp = "Apply the filter rules to the stored entries of a user (provide the username as argument)"
+	flagFilterOutcomeHelp    = `Outcome for the entries blocked by -apply-filter-rules: "read", "remove" or "delete"`
+	flagRebuildSearchHelp    = "Rebuild the full-text search vectors of all entries with the text search configuration of their language"
+	flagRebuildBatchHelp     = "Number of entries updated per batch by -rebuild-search-vectors"
 )
 
 // Parse parses command line arguments.
@@ -55,6 +59,10 @@ func Parse() {
 		flagRefreshFeeds         bool
 		flagRunCleanupTasks      bool
 		flagExportUserFeeds      string
+		flagApplyFilterRules     string
+		flagFilterOutcome        string
+		flagRebuildSearch        bool
+		flagRebuildBatchSize     int
 	)
 
 	flag.BoolVar(&flagInfo, "info", false, flagInfoHelp)
@@ -75,6 +83,10 @@ func Parse() {
 	flag.BoolVar(&flagRefreshFeeds, "refresh-feeds", false, flagRefreshFeedsHelp)
 	flag.BoolVar(&flagRunCleanupTasks, "run-cleanup-tasks", false, flagRunCleanupTasksHelp)
 	flag.StringVar(&flagExportUserFeeds, "export-user-feeds", "", flagExportUserFeedsHelp)
+	flag.StringVar(&flagApplyFilterRules, "apply-filter-rules", "", flagApplyFilterRulesHelp)
+	flag.StringVar(&flagFilterOutcome, "filter-outcome", "read", flagFilterOutcomeHelp)
+	flag.BoolVar(&flagRebuildSearch, "rebuild-search-vectors", false, flagRebuildSearchHelp)
+	flag.IntVar(&flagRebuildBatchSize, "rebuild-search-vectors-batch-size", 1000, flagRebuildBatchHelp)
 	flag.Parse()
 
 	cfg := config.NewConfigParser()
@@ -197,6 +209,16 @@ func Parse() {
 		return
 	}
 
+	if flagApplyFilterRules != "" {
+		applyFilterRules(store, flagApplyFilterRules, flagFilterOutcome)
+		return
+	}
+
+	if flagRebuildSearch {
+		rebuildSearchVectors(store, flagRebuildBatchSize)
+		return
+	}
+
 	if flagFlushSessions {
 		flushSessions(store)
 		return
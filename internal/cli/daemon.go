@@ -15,6 +15,7 @@ import (
 	"miniflux.app/v2/internal/config"
 	"miniflux.app/v2/internal/http/server"
 	"miniflux.app/v2/internal/metric"
+	"miniflux.app/v2/internal/newsletter"
 	"miniflux.app/v2/internal/storage"
 	"miniflux.app/v2/internal/systemd"
 	"miniflux.app/v2/internal/worker"
@@ -30,6 +31,11 @@ func startDaemon(store *storage.Storage) {
 	reload := make(chan os.Signal, 1)
 	signal.Notify(reload, syscall.SIGHUP)
 
+	// Filter rules are applied by goroutines that do not survive a restart.
+	if err := store.InterruptFilterApplyJobs(); err != nil {
+		slog.Error("Unable to interrupt the filter rule jobs", slog.Any("error", err))
+	}
+
 	pool := worker.NewPool(store, config.Opts.WorkerPoolSize())
 
 	if config.Opts.HasSchedulerService() && !config.Opts.HasMaintenanceMode() {
@@ -42,6 +48,15 @@ func startDaemon(store *storage.Storage) {
 		httpServers, certReloadFn = server.StartWebServer(store, pool)
 	}
 
+	var newsletterServer *newsletter.Server
+	if config.Opts.HasNewsletterReceiver() && !config.Opts.HasMaintenanceMode() {
+		var err error
+		newsletterServer, err = newsletter.StartServer(store)
+		if err != nil {
+			slog.Error("Unable to start the newsletter receiver", slog.Any("error", err))
+		}
+	}
+
 	metricsCtx, cancelMetrics := context.WithCancel(context.Background())
 	if config.Opts.HasMetricsCollector() {
 		collector := metric.NewCollector(store, config.Opts.MetricsRefreshInterval())
@@ -100,10 +115,19 @@ func startDaemon(store *storage.Storage) {
 				slog.Debug("No HTTP servers to shut down.")
 			}
 
+			if newsletterServer != nil {
+				slog.Debug("Shutting down newsletter receiver...")
+				if err := newsletterServer.Shutdown(ctx); err != nil {
+					slog.Error("Newsletter receiver shutdown error", slog.Any("error", err))
+				}
+			}
+
 			slog.Debug("Shutting down worker pool...")
 			pool.Shutdown()
 			slog.Debug("Worker pool shut down.")
 
+			flushFilterRuleStats(store)
+
 			slog.Debug("Process gracefully stopped")
 			return
 
@@ -0,0 +1,37 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli // import "miniflux.app/v2/internal/cli"
+
+import (
+	"fmt"
+
+	"miniflux.app/v2/internal/storage"
+)
+
+func rebuildSearchVectors(store *storage.Storage, batchSize int) {
+	if batchSize <= 0 {
+		printfAndExit("the batch size must be greater than 0")
+	}
+
+	fmt.Println("Rebuilding the full-text search vectors of all entries")
+
+	var lastEntryID int64
+	var batches int
+	for {
+		nextEntryID, err := store.RebuildEntrySearchVectors(lastEntryID, batchSize)
+		if err != nil {
+			printErrorAndExit(err)
+		}
+
+		if nextEntryID == 0 {
+			break
+		}
+
+		lastEntryID = nextEntryID
+		batches++
+		fmt.Printf("Batch %d done, last entry ID: %d\n", batches, lastEntryID)
+	}
+
+	fmt.Println("Done")
+}
@@ -69,6 +69,7 @@ func refreshFeeds(store *storage.Storage) {
 	close(jobQueue)
 
 	wg.Wait()
+	flushFilterRuleStats(store)
 
 	slog.Info("Refreshed a batch of feeds",
 		slog.Int("nb_feeds", nbJobs),
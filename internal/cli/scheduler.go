@@ -8,6 +8,8 @@ import (
 	"time"
 
 	"miniflux.app/v2/internal/config"
+	"miniflux.app/v2/internal/reader/filter"
+	"miniflux.app/v2/internal/reader/websub"
 	"miniflux.app/v2/internal/storage"
 	"miniflux.app/v2/internal/worker"
 )
@@ -28,6 +30,18 @@ func runScheduler(store *storage.Storage, pool *worker.Pool) {
 		store,
 		config.Opts.CleanupFrequency(),
 	)
+
+	go filterRuleStatsScheduler(
+		store,
+		config.Opts.FilterRuleStatsFlushInterval(),
+	)
+
+	if config.Opts.WebSub() {
+		go webSubScheduler(
+			store,
+			config.Opts.PollingFrequency(),
+		)
+	}
 }
 
 func feedScheduler(store *storage.Storage, pool *worker.Pool, frequency time.Duration, batchSize, errorLimit, limitPerHost int) {
@@ -55,3 +69,46 @@ func cleanupScheduler(store *storage.Storage, frequency time.Duration) {
 		runCleanupTasks(store)
 	}
 }
+
+func filterRuleStatsScheduler(store *storage.Storage, frequency time.Duration) {
+	for range time.Tick(frequency) {
+		flushFilterRuleStats(store)
+	}
+}
+
+// flushFilterRuleStats writes the filter rule matches counted in memory to the database.
+func flushFilterRuleStats(store *storage.Storage) {
+	stats := filter.FlushRuleHits()
+	if len(stats) == 0 {
+		return
+	}
+
+	if err := store.UpdateFilterRuleStats(stats); err != nil {
+		slog.Error("Unable to store filter rule statistics", slog.Any("error", err))
+		return
+	}
+
+	slog.Debug("Stored filter rule statistics", slog.Int("nb_rules", len(stats)))
+}
+
+func webSubScheduler(store *storage.Storage, frequency time.Duration) {
+	for range time.Tick(frequency) {
+		// Renew the leases that would expire before the next two ticks.
+		subscriptions, err := store.WebSubSubscriptionsToRenew(time.Now().Add(2 * frequency))
+		if err != nil {
+			slog.Error("Unable to fetch WebSub subscriptions to renew", slog.Any("error", err))
+			continue
+		}
+
+		for _, subscription := range subscriptions {
+			if err := websub.Subscribe(subscription, config.Opts.WebSubLeaseDuration()); err != nil {
+				slog.Warn("Unable to renew WebSub subscription",
+					slog.Int64("user_id", subscription.UserID),
+					slog.Int64("feed_id", subscription.FeedID),
+					slog.String("hub_url", subscription.HubURL),
+					slog.Any("error", err),
+				)
+			}
+		}
+	}
+}
@@ -149,6 +149,14 @@ func NewConfigOptions() *configOptions {
 					return validateGreaterOrEqualThan(rawValue, 1)
 				},
 			},
+			"CLEANUP_REMOVE_FEED_HISTORY_DAYS": {
+				parsedDuration: time.Hour * 24 * 30,
+				rawValue:       "30",
+				valueType:      dayType,
+				validator: func(rawValue string) error {
+					return validateGreaterOrEqualThan(rawValue, 0)
+				},
+			},
 			"CLEANUP_REMOVE_SESSIONS_DAYS": {
 				parsedDuration: time.Hour * 24 * 30,
 				rawValue:       "30",
@@ -220,6 +228,14 @@ func NewConfigOptions() *configOptions {
 				rawValue:        "0",
 				valueType:       boolType,
 			},
+			"ENTRY_REVISIONS_LIMIT": {
+				parsedIntValue: 10,
+				rawValue:       "10",
+				valueType:      intType,
+				validator: func(rawValue string) error {
+					return validateGreaterOrEqualThan(rawValue, 0)
+				},
+			},
 			"FETCHER_ALLOW_PRIVATE_NETWORKS": {
 				parsedBoolValue: false,
 				rawValue:        "0",
@@ -240,11 +256,24 @@ func NewConfigOptions() *configOptions {
 				rawValue:        "0",
 				valueType:       boolType,
 			},
+			"FETCH_PODCAST_RESOURCES": {
+				parsedBoolValue: true,
+				rawValue:        "1",
+				valueType:       boolType,
+			},
 			"FETCH_YOUTUBE_WATCH_TIME": {
 				parsedBoolValue: false,
 				rawValue:        "0",
 				valueType:       boolType,
 			},
+			"FILTER_RULE_STATS_FLUSH_INTERVAL": {
+				parsedDuration: 5 * time.Minute,
+				rawValue:       "5",
+				valueType:      minuteType,
+				validator: func(rawValue string) error {
+					return validateGreaterOrEqualThan(rawValue, 1)
+				},
+			},
 			"FORCE_REFRESH_INTERVAL": {
 				parsedDuration: 30 * time.Minute,
 				rawValue:       "30",
@@ -432,6 +461,32 @@ func NewConfigOptions() *configOptions {
 				valueType:         secretFileType,
 				targetKey:         "METRICS_USERNAME",
 			},
+			"NEWSLETTER_DOMAIN": {
+				parsedStringValue: "",
+				rawValue:          "",
+				valueType:         stringType,
+			},
+			"NEWSLETTER_LISTEN_ADDR": {
+				parsedStringValue: "",
+				rawValue:          "",
+				valueType:         stringType,
+			},
+			"NEWSLETTER_MAX_MESSAGE_SIZE": {
+				parsedInt64Value: 10,
+				rawValue:         "10",
+				valueType:        int64Type,
+				validator: func(rawValue string) error {
+					return validateGreaterOrEqualThan(rawValue, 1)
+				},
+			},
+			"NEWSLETTER_PROTOCOL": {
+				parsedStringValue: "smtp",
+				rawValue:          "smtp",
+				valueType:         stringType,
+				validator: func(rawValue string) error {
+					return validateChoices(rawValue, []string{"smtp", "lmtp"})
+				},
+			},
 			"OAUTH2_CLIENT_ID": {
 				parsedStringValue: "",
 				rawValue:          "",
@@ -508,6 +563,14 @@ func NewConfigOptions() *configOptions {
 					return validateGreaterOrEqualThan(rawValue, 0)
 				},
 			},
+			"POLLING_PERMANENT_REDIRECT_LIMIT": {
+				parsedIntValue: 3,
+				rawValue:       "3",
+				valueType:      intType,
+				validator: func(rawValue string) error {
+					return validateGreaterOrEqualThan(rawValue, 0)
+				},
+			},
 			"POLLING_SCHEDULER": {
 				parsedStringValue: "round_robin",
 				rawValue:          "round_robin",
@@ -597,6 +660,27 @@ func NewConfigOptions() *configOptions {
 				rawValue:        "0",
 				valueType:       boolType,
 			},
+			"WEBSUB": {
+				parsedBoolValue: false,
+				rawValue:        "0",
+				valueType:       boolType,
+			},
+			"WEBSUB_LEASE_DAYS": {
+				parsedDuration: time.Hour * 24 * 7,
+				rawValue:       "7",
+				valueType:      dayType,
+				validator: func(rawValue string) error {
+					return validateGreaterOrEqualThan(rawValue, 1)
+				},
+			},
+			"WEBSUB_POLLING_INTERVAL": {
+				parsedDuration: 1440 * time.Minute,
+				rawValue:       "1440",
+				valueType:      minuteType,
+				validator: func(rawValue string) error {
+					return validateGreaterOrEqualThan(rawValue, 1)
+				},
+			},
 			"WORKER_POOL_SIZE": {
 				parsedIntValue: 16,
 				rawValue:       "16",
@@ -676,6 +760,10 @@ func (c *configOptions) CleanupFrequency() time.Duration {
 	return c.options["CLEANUP_FREQUENCY_HOURS"].parsedDuration
 }
 
+func (c *configOptions) CleanupRemoveFeedHistoryInterval() time.Duration {
+	return c.options["CLEANUP_REMOVE_FEED_HISTORY_DAYS"].parsedDuration
+}
+
 func (c *configOptions) CleanupRemoveSessionsInterval() time.Duration {
 	return c.options["CLEANUP_REMOVE_SESSIONS_DAYS"].parsedDuration
 }
@@ -716,6 +804,10 @@ func (c *configOptions) DisableSchedulerService() bool {
 	return c.options["DISABLE_SCHEDULER_SERVICE"].parsedBoolValue
 }
 
+func (c *configOptions) EntryRevisionsLimit() int {
+	return c.options["ENTRY_REVISIONS_LIMIT"].parsedIntValue
+}
+
 func (c *configOptions) FetchBilibiliWatchTime() bool {
 	return c.options["FETCH_BILIBILI_WATCH_TIME"].parsedBoolValue
 }
@@ -728,10 +820,18 @@ func (c *configOptions) FetchOdyseeWatchTime() bool {
 	return c.options["FETCH_ODYSEE_WATCH_TIME"].parsedBoolValue
 }
 
+func (c *configOptions) FetchPodcastResources() bool {
+	return c.options["FETCH_PODCAST_RESOURCES"].parsedBoolValue
+}
+
 func (c *configOptions) FetchYouTubeWatchTime() bool {
 	return c.options["FETCH_YOUTUBE_WATCH_TIME"].parsedBoolValue
 }
 
+func (c *configOptions) FilterRuleStatsFlushInterval() time.Duration {
+	return c.options["FILTER_RULE_STATS_FLUSH_INTERVAL"].parsedDuration
+}
+
 func (c *configOptions) ForceRefreshInterval() time.Duration {
 	return c.options["FORCE_REFRESH_INTERVAL"].parsedDuration
 }
@@ -902,6 +1002,35 @@ func (c *configOptions) MetricsUsername() string {
 	return c.options["METRICS_USERNAME"].parsedStringValue
 }
 
+// NewsletterDomain returns the domain of the inbound newsletter addresses, defaulting to the host name of the base URL.
+func (c *configOptions) NewsletterDomain() string {
+	if domain := c.options["NEWSLETTER_DOMAIN"].parsedStringValue; domain != "" {
+		return domain
+	}
+
+	if parsedURL, err := url.Parse(c.rootURL); err == nil {
+		return parsedURL.Hostname()
+	}
+
+	return ""
+}
+
+func (c *configOptions) NewsletterListenAddr() string {
+	return c.options["NEWSLETTER_LISTEN_ADDR"].parsedStringValue
+}
+
+func (c *configOptions) NewsletterMaxMessageSize() int64 {
+	return c.options["NEWSLETTER_MAX_MESSAGE_SIZE"].parsedInt64Value * 1024 * 1024
+}
+
+func (c *configOptions) NewsletterProtocol() string {
+	return c.options["NEWSLETTER_PROTOCOL"].parsedStringValue
+}
+
+func (c *configOptions) HasNewsletterReceiver() bool {
+	return c.options["NEWSLETTER_LISTEN_ADDR"].parsedStringValue != ""
+}
+
 func (c *configOptions) OAuth2ClientID() string {
 	return c.options["OAUTH2_CLIENT_ID"].parsedStringValue
 }
@@ -942,6 +1071,10 @@ func (c *configOptions) PollingParsingErrorLimit() int {
 	return c.options["POLLING_PARSING_ERROR_LIMIT"].parsedIntValue
 }
 
+func (c *configOptions) PollingPermanentRedirectLimit() int {
+	return c.options["POLLING_PERMANENT_REDIRECT_LIMIT"].parsedIntValue
+}
+
 func (c *configOptions) PollingScheduler() string {
 	return c.options["POLLING_SCHEDULER"].parsedStringValue
 }
@@ -1000,6 +1133,18 @@ func (c *configOptions) WebAuthn() bool {
 	return c.options["WEBAUTHN"].parsedBoolValue
 }
 
+func (c *configOptions) WebSub() bool {
+	return c.options["WEBSUB"].parsedBoolValue
+}
+
+func (c *configOptions) WebSubLeaseDuration() time.Duration {
+	return c.options["WEBSUB_LEASE_DAYS"].parsedDuration
+}
+
+func (c *configOptions) WebSubPollingInterval() time.Duration {
+	return c.options["WEBSUB_POLLING_INTERVAL"].parsedDuration
+}
+
 func (c *configOptions) WorkerPoolSize() int {
 	return c.options["WORKER_POOL_SIZE"].parsedIntValue
 }
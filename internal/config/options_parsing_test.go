@@ -6,6 +6,7 @@ package config // import "miniflux.app/v2/internal/config"
 import (
 	"slices"
 	"testing"
+	"time"
 )
 
 func TestBaseURLOptionParsing(t *testing.T) {
@@ -112,6 +113,42 @@ func TestWebAuthnOptionParsing(t *testing.T) {
 	}
 }
 
+func TestWebSubOptionParsing(t *testing.T) {
+	configParser := NewConfigParser()
+
+	if configParser.options.WebSub() {
+		t.Fatalf("Expected WEBSUB to be disabled by default")
+	}
+
+	if configParser.options.WebSubLeaseDuration() != 7*24*time.Hour {
+		t.Fatalf("Expected WEBSUB_LEASE_DAYS to be 7 days by default")
+	}
+
+	if configParser.options.WebSubPollingInterval() != 24*time.Hour {
+		t.Fatalf("Expected WEBSUB_POLLING_INTERVAL to be 1440 minutes by default")
+	}
+
+	if err := configParser.parseLines([]string{"WEBSUB=1", "WEBSUB_LEASE_DAYS=2", "WEBSUB_POLLING_INTERVAL=360"}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if !configParser.options.WebSub() {
+		t.Fatalf("Expected WEBSUB to be enabled")
+	}
+
+	if configParser.options.WebSubLeaseDuration() != 2*24*time.Hour {
+		t.Fatalf("Expected WEBSUB_LEASE_DAYS to be 2 days")
+	}
+
+	if configParser.options.WebSubPollingInterval() != 6*time.Hour {
+		t.Fatalf("Expected WEBSUB_POLLING_INTERVAL to be 360 minutes")
+	}
+
+	if err := configParser.parseLines([]string{"WEBSUB_LEASE_DAYS=0"}); err == nil {
+		t.Fatal("Expected an error when WEBSUB_LEASE_DAYS is lower than 1")
+	}
+}
+
 func TestWorkerPoolSizeOptionParsing(t *testing.T) {
 	configParser := NewConfigParser()
 
@@ -576,6 +613,22 @@ func TestFetchOdyseeWatchTimeOptionParsing(t *testing.T) {
 	}
 }
 
+func TestFetchPodcastResourcesOptionParsing(t *testing.T) {
+	configParser := NewConfigParser()
+
+	if !configParser.options.FetchPodcastResources() {
+		t.Fatalf("Expected FETCH_PODCAST_RESOURCES to be enabled by default")
+	}
+
+	if err := configParser.parseLines([]string{"FETCH_PODCAST_RESOURCES=0"}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if configParser.options.FetchPodcastResources() {
+		t.Fatalf("Expected FETCH_PODCAST_RESOURCES to be disabled")
+	}
+}
+
 func TestFetchYouTubeWatchTimeOptionParsing(t *testing.T) {
 	configParser := NewConfigParser()
 
@@ -898,6 +951,90 @@ func TestMetricsUsernameOptionParsing(t *testing.T) {
 	}
 }
 
+func TestNewsletterDomainOptionParsing(t *testing.T) {
+	configParser := NewConfigParser()
+
+	if configParser.options.NewsletterDomain() != "localhost" {
+		t.Fatalf("Expected NEWSLETTER_DOMAIN to default to the base URL host name, got %q", configParser.options.NewsletterDomain())
+	}
+
+	if err := configParser.parseLines([]string{"BASE_URL=https://reader.example.org:8443/app"}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if configParser.options.NewsletterDomain() != "reader.example.org" {
+		t.Fatalf("Expected NEWSLETTER_DOMAIN to be 'reader.example.org', got %q", configParser.options.NewsletterDomain())
+	}
+
+	if err := configParser.parseLines([]string{"NEWSLETTER_DOMAIN=newsletters.example.org"}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if configParser.options.NewsletterDomain() != "newsletters.example.org" {
+		t.Fatalf("Expected NEWSLETTER_DOMAIN to be 'newsletters.example.org', got %q", configParser.options.NewsletterDomain())
+	}
+}
+
+func TestNewsletterListenAddrOptionParsing(t *testing.T) {
+	configParser := NewConfigParser()
+
+	if configParser.options.HasNewsletterReceiver() {
+		t.Fatalf("Expected the newsletter receiver to be disabled by default")
+	}
+
+	if err := configParser.parseLines([]string{"NEWSLETTER_LISTEN_ADDR=127.0.0.1:2525"}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if !configParser.options.HasNewsletterReceiver() {
+		t.Fatalf("Expected the newsletter receiver to be enabled")
+	}
+
+	if configParser.options.NewsletterListenAddr() != "127.0.0.1:2525" {
+		t.Fatalf("Expected NEWSLETTER_LISTEN_ADDR to be '127.0.0.1:2525'")
+	}
+}
+
+func TestNewsletterMaxMessageSizeOptionParsing(t *testing.T) {
+	configParser := NewConfigParser()
+
+	if configParser.options.NewsletterMaxMessageSize() != 10*1024*1024 {
+		t.Fatalf("Expected NEWSLETTER_MAX_MESSAGE_SIZE to be 10 MiB by default")
+	}
+
+	if err := configParser.parseLines([]string{"NEWSLETTER_MAX_MESSAGE_SIZE=25"}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if configParser.options.NewsletterMaxMessageSize() != 25*1024*1024 {
+		t.Fatalf("Expected NEWSLETTER_MAX_MESSAGE_SIZE to be 25 MiB")
+	}
+
+	if err := configParser.parseLines([]string{"NEWSLETTER_MAX_MESSAGE_SIZE=0"}); err == nil {
+		t.Fatalf("Expected an error for a NEWSLETTER_MAX_MESSAGE_SIZE lower than 1")
+	}
+}
+
+func TestNewsletterProtocolOptionParsing(t *testing.T) {
+	configParser := NewConfigParser()
+
+	if configParser.options.NewsletterProtocol() != "smtp" {
+		t.Fatalf("Expected NEWSLETTER_PROTOCOL to be 'smtp' by default")
+	}
+
+	if err := configParser.parseLines([]string{"NEWSLETTER_PROTOCOL=lmtp"}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if configParser.options.NewsletterProtocol() != "lmtp" {
+		t.Fatalf("Expected NEWSLETTER_PROTOCOL to be 'lmtp'")
+	}
+
+	if err := configParser.parseLines([]string{"NEWSLETTER_PROTOCOL=imap"}); err == nil {
+		t.Fatalf("Expected an error for an invalid NEWSLETTER_PROTOCOL")
+	}
+}
+
 func TestOAuth2ClientIDOptionParsing(t *testing.T) {
 	configParser := NewConfigParser()
 
@@ -1074,6 +1211,46 @@ func TestPollingParsingErrorLimitOptionParsing(t *testing.T) {
 	}
 }
 
+func TestEntryRevisionsLimitOptionParsing(t *testing.T) {
+	configParser := NewConfigParser()
+
+	if configParser.options.EntryRevisionsLimit() != 10 {
+		t.Fatalf("Expected ENTRY_REVISIONS_LIMIT to be 10 by default")
+	}
+
+	if err := configParser.parseLines([]string{"ENTRY_REVISIONS_LIMIT=0"}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if configParser.options.EntryRevisionsLimit() != 0 {
+		t.Fatalf("Expected ENTRY_REVISIONS_LIMIT to be 0")
+	}
+
+	if err := configParser.parseLines([]string{"ENTRY_REVISIONS_LIMIT=-1"}); err == nil {
+		t.Fatalf("Expected an error for a negative ENTRY_REVISIONS_LIMIT")
+	}
+}
+
+func TestPollingPermanentRedirectLimitOptionParsing(t *testing.T) {
+	configParser := NewConfigParser()
+
+	if configParser.options.PollingPermanentRedirectLimit() != 3 {
+		t.Fatalf("Expected POLLING_PERMANENT_REDIRECT_LIMIT to be 3 by default")
+	}
+
+	if err := configParser.parseLines([]string{"POLLING_PERMANENT_REDIRECT_LIMIT=0"}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if configParser.options.PollingPermanentRedirectLimit() != 0 {
+		t.Fatalf("Expected POLLING_PERMANENT_REDIRECT_LIMIT to be 0")
+	}
+
+	if err := configParser.parseLines([]string{"POLLING_PERMANENT_REDIRECT_LIMIT=-1"}); err == nil {
+		t.Fatalf("Expected an error for a negative POLLING_PERMANENT_REDIRECT_LIMIT")
+	}
+}
+
 func TestPollingSchedulerOptionParsing(t *testing.T) {
 	configParser := NewConfigParser()
 
@@ -1249,6 +1426,26 @@ func TestCleanupFrequencyOptionParsing(t *testing.T) {
 	}
 }
 
+func TestCleanupRemoveFeedHistoryIntervalOptionParsing(t *testing.T) {
+	configParser := NewConfigParser()
+
+	if configParser.options.CleanupRemoveFeedHistoryInterval().Hours() != 24*30 {
+		t.Fatalf("Expected CLEANUP_REMOVE_FEED_HISTORY_DAYS to be 30 days by default")
+	}
+
+	if err := configParser.parseLines([]string{"CLEANUP_REMOVE_FEED_HISTORY_DAYS=7"}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if configParser.options.CleanupRemoveFeedHistoryInterval().Hours() != 24*7 {
+		t.Fatalf("Expected CLEANUP_REMOVE_FEED_HISTORY_DAYS to be 7 days")
+	}
+
+	if err := configParser.parseLines([]string{"CLEANUP_REMOVE_FEED_HISTORY_DAYS=-1"}); err == nil {
+		t.Fatal("Expected error for negative CLEANUP_REMOVE_FEED_HISTORY_DAYS value")
+	}
+}
+
 func TestCleanupRemoveSessionsIntervalOptionParsing(t *testing.T) {
 	configParser := NewConfigParser()
 
@@ -2088,3 +2285,23 @@ func TestValidateSchedulerEntryFrequencyMinLessThanMax(t *testing.T) {
 		t.Fatalf("Unexpected error: %v", err)
 	}
 }
+
+func TestFilterRuleStatsFlushIntervalOptionParsing(t *testing.T) {
+	configParser := NewConfigParser()
+
+	if configParser.options.FilterRuleStatsFlushInterval() != 5*time.Minute {
+		t.Fatalf("Expected FILTER_RULE_STATS_FLUSH_INTERVAL to be 5 minutes by default")
+	}
+
+	if err := configParser.parseLines([]string{"FILTER_RULE_STATS_FLUSH_INTERVAL=15"}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if configParser.options.FilterRuleStatsFlushInterval() != 15*time.Minute {
+		t.Fatalf("Expected FILTER_RULE_STATS_FLUSH_INTERVAL to be 15 minutes")
+	}
+
+	if err := configParser.parseLines([]string{"FILTER_RULE_STATS_FLUSH_INTERVAL=0"}); err == nil {
+		t.Fatal("Expected an error when FILTER_RULE_STATS_FLUSH_INTERVAL is lower than 1")
+	}
+}
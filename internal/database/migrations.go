@@ -6,6 +6,7 @@ package database // import "miniflux.app/v2/internal/database"
 import (
 	"database/sql"
 	"errors"
+	"log/slog"
 
 	"miniflux.app/v2/internal/crypto"
 )
@@ -1557,4 +1558,324 @@ var migrations = [...]func(tx *sql.Tx) error{
 		`)
 		return err
 	},
+	func(tx *sql.Tx) (err error) {
+		_, err = tx.Exec(`
+			CREATE TABLE websub_subscriptions (
+				feed_id bigint not null references feeds(id) on delete cascade,
+				user_id int not null references users(id) on delete cascade,
+				hub_url text not null,
+				topic_url text not null,
+				secret text not null,
+				callback_token text not null unique,
+				state text not null default 'pending',
+				lease_expires_at timestamp with time zone,
+				created_at timestamp with time zone not null default now(),
+				updated_at timestamp with time zone not null default now(),
+				primary key(feed_id)
+			);
+
+			CREATE INDEX websub_subscriptions_lease_expires_at_idx ON websub_subscriptions(lease_expires_at);
+		`)
+		return err
+	},
+	func(tx *sql.Tx) (err error) {
+		// update_period is stored in minutes.
+		_, err = tx.Exec(`
+			ALTER TABLE feeds
+				ADD COLUMN skip_hours int[] not null default '{}',
+				ADD COLUMN skip_days int[] not null default '{}',
+				ADD COLUMN update_period int not null default 0;
+		`)
+		return err
+	},
+	func(tx *sql.Tx) (err error) {
+		// duration is stored in milliseconds.
+		_, err = tx.Exec(`
+			CREATE TABLE feed_fetches (
+				id bigserial not null,
+				user_id int not null references users(id) on delete cascade,
+				feed_id bigint not null references feeds(id) on delete cascade,
+				fetched_at timestamp with time zone not null default now(),
+				status_code int not null default 0,
+				duration int not null default 0,
+				response_size bigint not null default 0,
+				cache_hit bool not null default 'f',
+				new_entries_count int not null default 0,
+				updated_entries_count int not null default 0,
+				error_msg text not null default '',
+				primary key(id)
+			);
+
+			CREATE INDEX feed_fetches_feed_id_fetched_at_idx ON feed_fetches(feed_id, fetched_at);
+			CREATE INDEX feed_fetches_fetched_at_idx ON feed_fetches(fetched_at);
+		`)
+		return err
+	},
+	func(tx *sql.Tx) (err error) {
+		_, err = tx.Exec(`
+			ALTER TABLE feeds
+				ADD COLUMN permanent_redirect_url text not null default '',
+				ADD COLUMN permanent_redirect_count int not null default 0;
+
+			ALTER TABLE feed_fetches
+				ADD COLUMN redirect_url text not null default '',
+				ADD COLUMN feed_url_updated bool not null default 'f';
+		`)
+		return err
+	},
+	func(tx *sql.Tx) (err error) {
+		_, err = tx.Exec(`
+			ALTER TABLE feeds
+				ADD COLUMN page_item_selector text not null default '',
+				ADD COLUMN page_title_selector text not null default '',
+				ADD COLUMN page_link_selector text not null default '',
+				ADD COLUMN page_date_selector text not null default '',
+				ADD COLUMN page_content_selector text not null default '';
+		`)
+		return err
+	},
+	func(tx *sql.Tx) (err error) {
+		_, err = tx.Exec(`
+			CREATE TABLE public_feeds (
+				id bigserial not null,
+				user_id int not null references users(id) on delete cascade,
+				token text not null unique,
+				title text not null,
+				starred bool not null default 'f',
+				category_id int references categories(id) on delete cascade,
+				tag text not null default '',
+				search_query text not null default '',
+				last_accessed_at timestamp with time zone,
+				created_at timestamp with time zone not null default now(),
+				primary key(id),
+				unique (user_id, title)
+			);
+		`)
+		return err
+	},
+	func(tx *sql.Tx) (err error) {
+		_, err = tx.Exec(`
+			CREATE TABLE newsletter_addresses (
+				id bigserial not null,
+				user_id int not null references users(id) on delete cascade,
+				category_id int not null references categories(id) on delete cascade,
+				token text not null unique,
+				description text not null,
+				allowed_senders text[] not null default '{}',
+				last_received_at timestamp with time zone,
+				created_at timestamp with time zone not null default now(),
+				primary key(id),
+				unique (user_id, description)
+			);
+
+			ALTER TABLE feeds ADD COLUMN unsubscribe_url text not null default '';
+
+			CREATE TABLE newsletter_images (
+				id bigserial not null,
+				entry_id bigint not null references entries(id) on delete cascade,
+				external_id text not null,
+				mime_type text not null,
+				content bytea not null,
+				primary key(id),
+				unique(external_id)
+			);
+			CREATE INDEX newsletter_images_entry_id_idx ON newsletter_images(entry_id);
+		`)
+		return err
+	},
+	func(tx *sql.Tx) (err error) {
+		_, err = tx.Exec(`
+			ALTER TABLE entries ADD COLUMN podcast jsonb;
+			ALTER TABLE entries ADD COLUMN transcript text not null default '';
+			ALTER TABLE enclosures ADD COLUMN title text not null default '';
+			ALTER TABLE enclosures ADD COLUMN alternate bool not null default 'f';
+		`)
+		return err
+	},
+	func(tx *sql.Tx) (err error) {
+		_, err = tx.Exec(`
+			ALTER TABLE entries ADD COLUMN thumbnail_url text not null default '';
+			ALTER TABLE entries ADD COLUMN thumbnail_width int not null default 0;
+			ALTER TABLE entries ADD COLUMN thumbnail_height int not null default 0;
+			ALTER TABLE users ADD COLUMN entry_list_layout text not null default 'list';
+		`)
+		return err
+	},
+	func(tx *sql.Tx) (err error) {
+		_, err = tx.Exec(`
+			ALTER TABLE entries ADD COLUMN normalized_url text not null default '';
+			ALTER TABLE entries ADD COLUMN duplicate_of_id bigint references entries(id) on delete set null;
+			CREATE INDEX entries_user_id_normalized_url_idx ON entries(user_id, normalized_url) WHERE normalized_url <> '';
+			CREATE INDEX entries_duplicate_of_id_idx ON entries(duplicate_of_id) WHERE duplicate_of_id IS NOT NULL;
+			ALTER TABLE users ADD COLUMN mark_duplicate_entries_as_read bool not null default 'f';
+		`)
+		return err
+	},
+	func(tx *sql.Tx) (err error) {
+		_, err = tx.Exec(`
+			ALTER TABLE entries ADD COLUMN fingerprint bigint not null default 0;
+			ALTER TABLE entries ADD COLUMN story_id bigint references entries(id) on delete set null;
+			CREATE INDEX entries_user_id_created_at_fingerprint_idx ON entries(user_id, created_at) WHERE fingerprint <> 0;
+			CREATE INDEX entries_story_id_idx ON entries(story_id) WHERE story_id IS NOT NULL;
+			ALTER TABLE users ADD COLUMN group_entries_by_story bool not null default 'f';
+		`)
+		return err
+	},
+	func(tx *sql.Tx) (err error) {
+		_, err = tx.Exec(`
+			ALTER TABLE users ADD COLUMN action_entry_rules text not null default '';
+			ALTER TABLE feeds ADD COLUMN action_entry_rules text not null default '';
+		`)
+		return err
+	},
+	func(tx *sql.Tx) (err error) {
+		_, err = tx.Exec(`
+			CREATE TABLE filter_rule_stats (
+				user_id bigint not null references users(id) on delete cascade,
+				feed_id bigint references feeds(id) on delete cascade,
+				rule text not null,
+				hit_count bigint not null default 0,
+				last_matched_at timestamp with time zone not null default now()
+			);
+			CREATE UNIQUE INDEX filter_rule_stats_user_id_feed_id_rule_idx ON filter_rule_stats(user_id, (coalesce(feed_id, 0)), rule);
+			CREATE INDEX filter_rule_stats_feed_id_idx ON filter_rule_stats(feed_id) WHERE feed_id IS NOT NULL;
+		`)
+		return err
+	},
+	func(tx *sql.Tx) (err error) {
+		_, err = tx.Exec(`
+			CREATE TABLE labels (
+				id bigserial not null,
+				user_id bigint not null references users(id) on delete cascade,
+				title text not null,
+				created_at timestamp with time zone not null default now(),
+				primary key(id)
+			);
+			CREATE UNIQUE INDEX labels_user_id_lower_title_idx ON labels(user_id, lower(title));
+
+			CREATE TABLE entry_labels (
+				entry_id bigint not null references entries(id) on delete cascade,
+				label_id bigint not null references labels(id) on delete cascade,
+				created_at timestamp with time zone not null default now(),
+				primary key(entry_id, label_id)
+			);
+			CREATE INDEX entry_labels_label_id_idx ON entry_labels(label_id);
+		`)
+		return err
+	},
+	func(tx *sql.Tx) (err error) {
+		_, err = tx.Exec(`
+			CREATE TABLE saved_searches (
+				id bigserial not null,
+				user_id bigint not null references users(id) on delete cascade,
+				title text not null,
+				search_query text not null default '',
+				statuses text[] not null default '{}',
+				starred bool not null default 'f',
+				category_ids bigint[] not null default '{}',
+				feed_ids bigint[] not null default '{}',
+				tags text[] not null default '{}',
+				max_age_days int not null default 0,
+				created_at timestamp with time zone not null default now(),
+				primary key(id)
+			);
+			CREATE UNIQUE INDEX saved_searches_user_id_lower_title_idx ON saved_searches(user_id, lower(title));
+		`)
+		return err
+	},
+	func(tx *sql.Tx) (err error) {
+		// NULL means the document vectors were built with the default text search configuration.
+		_, err = tx.Exec(`ALTER TABLE entries ADD COLUMN search_config text;`)
+		return err
+	},
+	func(tx *sql.Tx) (err error) {
+		// The trigram indexes of the fuzzy search are optional:
+		// creating the pg_trgm extension requires privileges that the database user might not have.
+		if _, err := tx.Exec(`SAVEPOINT pg_trgm`); err != nil {
+			return err
+		}
+
+		if _, err := tx.Exec(`CREATE EXTENSION IF NOT EXISTS pg_trgm`); err != nil {
+			slog.Warn("Unable to create the pg_trgm extension, the fuzzy search is disabled",
+				slog.Any("error", err),
+			)
+			_, err = tx.Exec(`ROLLBACK TO SAVEPOINT pg_trgm`)
+			return err
+		}
+
+		_, err = tx.Exec(`
+			CREATE INDEX entries_title_trgm_idx ON entries USING gin(title gin_trgm_ops);
+			CREATE INDEX feeds_title_trgm_idx ON feeds USING gin(title gin_trgm_ops);
+		`)
+		return err
+	},
+	func(tx *sql.Tx) (err error) {
+		_, err = tx.Exec(`
+			CREATE TABLE highlights (
+				id bigserial not null,
+				user_id bigint not null references users(id) on delete cascade,
+				entry_id bigint not null references entries(id) on delete cascade,
+				quote text not null,
+				prefix text not null default '',
+				suffix text not null default '',
+				start_offset int not null default 0,
+				end_offset int not null default 0,
+				note text not null default '',
+				created_at timestamp with time zone not null default now(),
+				changed_at timestamp with time zone not null default now(),
+				primary key(id)
+			);
+			CREATE INDEX highlights_user_id_created_at_idx ON highlights(user_id, created_at);
+			CREATE INDEX highlights_entry_id_idx ON highlights(entry_id);
+		`)
+		return err
+	},
+	func(tx *sql.Tx) (err error) {
+		_, err = tx.Exec(`
+			CREATE TABLE entry_revisions (
+				id bigserial not null,
+				entry_id bigint not null references entries(id) on delete cascade,
+				title text not null,
+				content text not null default '',
+				created_at timestamp with time zone not null default now(),
+				primary key(id)
+			);
+			CREATE INDEX entry_revisions_entry_id_created_at_idx ON entry_revisions(entry_id, created_at);
+			ALTER TABLE feeds ADD COLUMN mark_unread_on_change_threshold int not null default 0;
+		`)
+		return err
+	},
+	func(tx *sql.Tx) (err error) {
+		// The transcripts were indexed with the weight C, only the title and content lexemes are kept before indexing them again.
+		_, err = tx.Exec(`
+			UPDATE
+				entries
+			SET
+				document_vectors = ts_filter(document_vectors, '{a,b}') ||
+					setweight(to_tsvector(coalesce(search_config::regconfig, get_current_ts_config()), left(transcript, 100000)), 'D')
+			WHERE
+				transcript <> ''
+		`)
+		return err
+	},
+	func(tx *sql.Tx) (err error) {
+		_, err = tx.Exec(`
+			CREATE TABLE filter_apply_jobs (
+				id bigserial not null,
+				user_id bigint not null references users(id) on delete cascade,
+				feed_id bigint not null default 0,
+				category_id bigint not null default 0,
+				outcome text not null,
+				status text not null,
+				entries_count int not null default 0,
+				blocked_count int not null default 0,
+				error_msg text not null default '',
+				created_at timestamp with time zone not null default now(),
+				finished_at timestamp with time zone,
+				primary key(id)
+			);
+			CREATE INDEX filter_apply_jobs_user_id_idx ON filter_apply_jobs(user_id, feed_id, category_id);
+		`)
+		return err
+	},
 }
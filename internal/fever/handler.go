@@ -411,6 +411,7 @@ func (h *feverHandler) handleWriteItems(w http.ResponseWriter, r *http.Request)
 
 	entry, err := h.store.NewEntryQueryBuilder(userID).
 		WithEntryIDs(entryID).
+		WithHighlights().
 		GetEntry()
 	if err != nil {
 		response.JSONServerError(w, r, err)
@@ -9,6 +9,7 @@ import (
 	"log/slog"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
 	"miniflux.app/v2/internal/config"
@@ -215,6 +216,9 @@ func (h *greaderHandler) editTagHandler(w http.ResponseWriter, r *http.Request)
 		response.JSONServerError(w, r, err)
 		return
 	}
+	addTags, addLabels := splitLabelStreams(addTags)
+	removeTags, removeLabels := splitLabelStreams(removeTags)
+	addLabels = h.withoutSavedSearches(userID, addLabels)
 	tags, err := checkAndSimplifyTags(addTags, removeTags)
 	if err != nil {
 		response.JSONServerError(w, r, err)
@@ -234,10 +238,27 @@ func (h *greaderHandler) editTagHandler(w http.ResponseWriter, r *http.Request)
 		slog.Int64("user_id", userID),
 		slog.Any("item_ids", itemIDs),
 		slog.Any("tags", tags),
+		slog.Any("add_labels", addLabels),
+		slog.Any("remove_labels", removeLabels),
 	)
 
+	if len(addLabels) > 0 {
+		if err := h.store.AttachLabelsByTitle(userID, itemIDs, addLabels); err != nil {
+			response.JSONServerError(w, r, err)
+			return
+		}
+	}
+
+	if len(removeLabels) > 0 {
+		if err := h.store.DetachLabelsByTitle(userID, itemIDs, removeLabels); err != nil {
+			response.JSONServerError(w, r, err)
+			return
+		}
+	}
+
 	entries, err := h.store.NewEntryQueryBuilder(userID).
 		WithEntryIDs(itemIDs...).
+		WithHighlights().
 		GetEntries()
 	if err != nil {
 		response.JSONServerError(w, r, err)
@@ -651,6 +672,7 @@ func (h *greaderHandler) streamItemContentsHandler(w http.ResponseWriter, r *htt
 
 	entries, err := h.store.NewEntryQueryBuilder(userID).
 		WithEnclosures().
+		WithLabels().
 		WithEntryIDs(itemIDs...).
 		WithSorting(model.DefaultSortingOrder, requestModifiers.SortDirection).
 		GetEntries()
@@ -682,6 +704,9 @@ func (h *greaderHandler) streamItemContentsHandler(w http.ResponseWriter, r *htt
 		if entry.Feed.Category.Title != "" {
 			categories = append(categories, labelPrefix+entry.Feed.Category.Title)
 		}
+		for _, label := range entry.Labels {
+			categories = append(categories, labelPrefix+label.Title)
+		}
 		if entry.Status == model.EntryStatusRead {
 			categories = append(categories, userRead)
 		}
@@ -756,19 +781,33 @@ func (h *greaderHandler) disableTagHandler(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
-	titles := make([]string, len(streams))
-	for i, stream := range streams {
+	titles := make([]string, 0, len(streams))
+	for _, stream := range streams {
 		if stream.Type != LabelStream {
 			response.JSONBadRequest(w, r, errors.New("googlereader: only labels are supported"))
 			return
 		}
-		titles[i] = stream.ID
+
+		// Categories take precedence, like in the tag list, the other names refer to user labels.
+		label, err := h.store.LabelByTitle(userID, stream.ID)
+		if err != nil {
+			response.JSONServerError(w, r, err)
+			return
+		}
+
+		if label == nil || h.store.CategoryTitleExists(userID, stream.ID) {
+			titles = append(titles, stream.ID)
+		} else if err := h.store.RemoveLabel(userID, label.ID); err != nil {
+			response.JSONServerError(w, r, err)
+			return
+		}
 	}
 
-	err = h.store.RemoveAndReplaceCategoriesByName(userID, titles)
-	if err != nil {
-		response.JSONServerError(w, r, err)
-		return
+	if len(titles) > 0 {
+		if err := h.store.RemoveAndReplaceCategoriesByName(userID, titles); err != nil {
+			response.JSONServerError(w, r, err)
+			return
+		}
 	}
 
 	response.Text(w, r, "OK")
@@ -818,7 +857,7 @@ func (h *greaderHandler) renameTagHandler(w http.ResponseWriter, r *http.Request
 		return
 	}
 	if category == nil {
-		response.JSONNotFound(w, r)
+		h.renameLabel(w, r, userID, source.ID, destination.ID)
 		return
 	}
 
@@ -841,6 +880,36 @@ func (h *greaderHandler) renameTagHandler(w http.ResponseWriter, r *http.Request
 	response.Text(w, r, "OK")
 }
 
+func (h *greaderHandler) renameLabel(w http.ResponseWriter, r *http.Request, userID int64, source, destination string) {
+	label, err := h.store.LabelByTitle(userID, source)
+	if err != nil {
+		response.JSONServerError(w, r, err)
+		return
+	}
+	if label == nil {
+		response.JSONNotFound(w, r)
+		return
+	}
+
+	labelModificationRequest := model.LabelModificationRequest{
+		Title: new(destination),
+	}
+
+	if validationError := validator.ValidateLabelModification(h.store, userID, label.ID, &labelModificationRequest); validationError != nil {
+		response.JSONBadRequest(w, r, validationError.Error())
+		return
+	}
+
+	labelModificationRequest.Patch(label)
+
+	if err := h.store.UpdateLabel(label); err != nil {
+		response.JSONServerError(w, r, err)
+		return
+	}
+
+	response.Text(w, r, "OK")
+}
+
 func (h *greaderHandler) tagListHandler(w http.ResponseWriter, r *http.Request) {
 	userID := request.UserID(r)
 	clientIP := request.ClientIP(r)
@@ -862,18 +931,53 @@ func (h *greaderHandler) tagListHandler(w http.ResponseWriter, r *http.Request)
 		response.JSONServerError(w, r, err)
 		return
 	}
-	result.Tags = make([]subscriptionCategoryResponse, 0, 1+len(categories))
+	labels, err := h.store.Labels(userID)
+	if err != nil {
+		response.JSONServerError(w, r, err)
+		return
+	}
+	savedSearches, err := h.store.SavedSearches(userID)
+	if err != nil {
+		response.JSONServerError(w, r, err)
+		return
+	}
+	result.Tags = make([]subscriptionCategoryResponse, 0, 1+len(categories)+len(labels)+len(savedSearches))
 	result.Tags = append(result.Tags, subscriptionCategoryResponse{
 		ID: fmt.Sprintf(userStreamPrefix, userID) + starredStreamSuffix,
 	})
 	labelPrefix := fmt.Sprintf(userLabelPrefix, userID)
+	streamNames := make(map[string]bool, len(categories)+len(labels))
 	for _, category := range categories {
+		streamNames[strings.ToLower(category.Title)] = true
 		result.Tags = append(result.Tags, subscriptionCategoryResponse{
 			ID:    labelPrefix + category.Title,
 			Label: category.Title,
 			Type:  "folder",
 		})
 	}
+	// A label with the same name as a category would have the same stream ID.
+	for _, label := range labels {
+		if streamNames[strings.ToLower(label.Title)] {
+			continue
+		}
+		streamNames[strings.ToLower(label.Title)] = true
+		result.Tags = append(result.Tags, subscriptionCategoryResponse{
+			ID:    labelPrefix + label.Title,
+			Label: label.Title,
+			Type:  "tag",
+		})
+	}
+	// Saved searches come last, they are only listed when the name is still free.
+	for _, savedSearch := range savedSearches {
+		if streamNames[strings.ToLower(savedSearch.Title)] {
+			continue
+		}
+		result.Tags = append(result.Tags, subscriptionCategoryResponse{
+			ID:    labelPrefix + savedSearch.Title,
+			Label: savedSearch.Title,
+			Type:  "tag",
+		})
+	}
 	response.JSON(w, r, result)
 }
 
@@ -991,6 +1095,8 @@ func (h *greaderHandler) streamItemIDsHandler(w http.ResponseWriter, r *http.Req
 		h.handleReadStreamHandler(w, r, rm)
 	case FeedStream:
 		h.handleFeedStreamHandler(w, r, rm)
+	case LabelStream:
+		h.handleLabelStreamHandler(w, r, rm)
 	default:
 		slog.Warn("[GoogleReader] Unknown Stream",
 			slog.String("handler", "streamItemIDsHandler"),
@@ -1095,6 +1201,9 @@ func (h *greaderHandler) handleReadStreamHandler(w http.ResponseWriter, r *http.
 }
 
 func getItemRefsAndContinuation(builder storage.EntryQueryBuilder, rm requestModifiers) ([]itemRef, int, error) {
+	// The search query narrows down any stream.
+	builder.WithSearchQuery(rm.SearchQuery)
+
 	rawEntryIDs, err := builder.GetEntryIDs()
 	if err != nil {
 		return nil, 0, err
@@ -1152,6 +1261,70 @@ func (h *greaderHandler) handleFeedStreamHandler(w http.ResponseWriter, r *http.
 	response.JSON(w, r, streamIDResponse{itemRefs, continuation})
 }
 
+// handleLabelStreamHandler lists the items of a category, or of the user label or saved search
+// with this name since Google Reader clients use the same stream IDs for folders and tags.
+func (h *greaderHandler) handleLabelStreamHandler(w http.ResponseWriter, r *http.Request, rm requestModifiers) {
+	builder := h.store.NewEntryQueryBuilder(rm.UserID).
+		WithLimitAndMaximum(rm.Count, model.MaxEntryIDsLimit).
+		WithOffset(rm.Offset).
+		WithSorting(model.DefaultSortingOrder, rm.SortDirection)
+
+	category, err := h.store.CategoryByTitle(rm.UserID, rm.Streams[0].ID)
+	if err != nil {
+		response.JSONServerError(w, r, err)
+		return
+	}
+
+	if category != nil {
+		builder = builder.WithCategoryID(category.ID)
+	} else {
+		label, err := h.store.LabelByTitle(rm.UserID, rm.Streams[0].ID)
+		if err != nil {
+			response.JSONServerError(w, r, err)
+			return
+		}
+
+		if label != nil {
+			builder = builder.WithLabelID(label.ID)
+		} else {
+			savedSearch, err := h.store.SavedSearchByTitle(rm.UserID, rm.Streams[0].ID)
+			if err != nil {
+				response.JSONServerError(w, r, err)
+				return
+			}
+
+			if savedSearch == nil {
+				response.JSONNotFound(w, r)
+				return
+			}
+
+			builder = builder.WithSavedSearch(savedSearch)
+		}
+	}
+
+	if rm.StartTime > 0 {
+		builder = builder.AfterPublishedDate(time.Unix(rm.StartTime, 0))
+	}
+
+	if rm.StopTime > 0 {
+		builder = builder.BeforePublishedDate(time.Unix(rm.StopTime, 0))
+	}
+
+	for _, s := range rm.ExcludeTargets {
+		if s.Type == ReadStream {
+			builder = builder.WithoutStatus(model.EntryStatusRead)
+		}
+	}
+
+	itemRefs, continuation, err := getItemRefsAndContinuation(*builder, rm)
+	if err != nil {
+		response.JSONServerError(w, r, err)
+		return
+	}
+
+	response.JSON(w, r, streamIDResponse{itemRefs, continuation})
+}
+
 func (h *greaderHandler) markAllAsReadHandler(w http.ResponseWriter, r *http.Request) {
 	userID := request.UserID(r)
 	clientIP := request.ClientIP(r)
@@ -1214,7 +1387,7 @@ func (h *greaderHandler) markAllAsReadHandler(w http.ResponseWriter, r *http.Req
 			return
 		}
 		if category == nil {
-			response.JSONNotFound(w, r)
+			h.markLabelAsRead(w, r, userID, stream.ID, before)
 			return
 		}
 		if err := h.store.MarkCategoryAsRead(userID, category.ID, before); err != nil {
@@ -1231,6 +1404,71 @@ func (h *greaderHandler) markAllAsReadHandler(w http.ResponseWriter, r *http.Req
 	response.Text(w, r, "OK")
 }
 
+func (h *greaderHandler) markLabelAsRead(w http.ResponseWriter, r *http.Request, userID int64, title string, before time.Time) {
+	label, err := h.store.LabelByTitle(userID, title)
+	if err != nil {
+		response.JSONServerError(w, r, err)
+		return
+	}
+	if label == nil {
+		h.markSavedSearchAsRead(w, r, userID, title, before)
+		return
+	}
+	if err := h.store.MarkLabelAsRead(userID, label.ID, before); err != nil {
+		response.JSONServerError(w, r, err)
+		return
+	}
+
+	response.Text(w, r, "OK")
+}
+
+func (h *greaderHandler) markSavedSearchAsRead(w http.ResponseWriter, r *http.Request, userID int64, title string, before time.Time) {
+	savedSearch, err := h.store.SavedSearchByTitle(userID, title)
+	if err != nil {
+		response.JSONServerError(w, r, err)
+		return
+	}
+	if savedSearch == nil {
+		response.JSONNotFound(w, r)
+		return
+	}
+	if err := h.store.MarkSavedSearchAsRead(userID, savedSearch, before); err != nil {
+		response.JSONServerError(w, r, err)
+		return
+	}
+
+	response.Text(w, r, "OK")
+}
+
+// withoutSavedSearches removes the saved search names from the labels to attach,
+// saved searches are read-only streams and must not be shadowed by a new label.
+func (h *greaderHandler) withoutSavedSearches(userID int64, labels []string) []string {
+	filtered := labels[:0]
+	for _, label := range labels {
+		if !h.store.LabelTitleExists(userID, label) && h.store.SavedSearchTitleExists(userID, label) {
+			continue
+		}
+		filtered = append(filtered, label)
+	}
+	return filtered
+}
+
+// splitLabelStreams separates the user labels from the state streams, labels are attached to items with edit-tag.
+func splitLabelStreams(streams []Stream) ([]Stream, []string) {
+	var labels []string
+	states := make([]Stream, 0, len(streams))
+	for _, stream := range streams {
+		if stream.Type == LabelStream {
+			if stream.ID != "" {
+				labels = append(labels, stream.ID)
+			}
+		} else {
+			states = append(states, stream)
+		}
+	}
+	return states, labels
+}
+
 func checkAndSimplifyTags(addTags []Stream, removeTags []Stream) (map[StreamType]bool, error) {
 	tags := make(map[StreamType]bool)
 	for _, s := range addTags {
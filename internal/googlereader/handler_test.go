@@ -0,0 +1,43 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package googlereader // import "miniflux.app/v2/internal/googlereader"
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSplitLabelStreams(t *testing.T) {
+	streams := []Stream{
+		{Type: ReadStream},
+		{Type: LabelStream, ID: "to-review"},
+		{Type: StarredStream},
+		{Type: LabelStream, ID: ""},
+		{Type: LabelStream, ID: "Tech"},
+	}
+
+	states, labels := splitLabelStreams(streams)
+
+	expectedStates := []Stream{{Type: ReadStream}, {Type: StarredStream}}
+	if !reflect.DeepEqual(states, expectedStates) {
+		t.Errorf("Expected states %v, got %v", expectedStates, states)
+	}
+
+	expectedLabels := []string{"to-review", "Tech"}
+	if !reflect.DeepEqual(labels, expectedLabels) {
+		t.Errorf("Expected labels %v, got %v", expectedLabels, labels)
+	}
+}
+
+func TestSplitLabelStreamsWithoutLabels(t *testing.T) {
+	states, labels := splitLabelStreams([]Stream{{Type: ReadStream}})
+
+	if len(states) != 1 || states[0].Type != ReadStream {
+		t.Errorf("Expected the read stream to be kept, got %v", states)
+	}
+
+	if labels != nil {
+		t.Errorf("Expected no labels, got %v", labels)
+	}
+}
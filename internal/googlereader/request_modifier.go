@@ -21,6 +21,7 @@ type requestModifiers struct {
 	StartTime         int64
 	StopTime          int64
 	ContinuationToken string
+	SearchQuery       string
 	UserID            int64
 }
 
@@ -53,6 +54,7 @@ func (r requestModifiers) String() string {
 	results = append(results, "Continuation Token: "+r.ContinuationToken)
 	results = append(results, fmt.Sprintf("Start Time: %d", r.StartTime))
 	results = append(results, fmt.Sprintf("Stop Time: %d", r.StopTime))
+	results = append(results, "Search Query: "+r.SearchQuery)
 
 	return strings.Join(results, "; ")
 }
@@ -87,5 +89,6 @@ func parseStreamFilterFromRequest(r *http.Request) (requestModifiers, error) {
 	result.Offset = request.QueryIntParam(r, paramContinuation, 0)
 	result.StartTime = request.QueryInt64Param(r, paramStreamStartTime, int64(0))
 	result.StopTime = request.QueryInt64Param(r, paramStreamStopTime, int64(0))
+	result.SearchQuery = request.QueryStringParam(r, paramSearchQuery, "")
 	return result, nil
 }
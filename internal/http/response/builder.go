@@ -126,6 +126,26 @@ func (b *Builder) WithCaching(etag string, duration time.Duration, callback func
 	}
 }
 
+// WithConditionalCaching adds validators to the response and answers conditional requests with a 304 status.
+// Unlike WithCaching, clients must revalidate the response each time they want to use it.
+func (b *Builder) WithConditionalCaching(etag string, lastModified time.Time, callback func(*Builder)) {
+	etag = normalizeETag(etag)
+	b.headers.Set("ETag", etag)
+	b.headers.Set("Cache-Control", "no-cache")
+
+	if !lastModified.IsZero() {
+		b.headers.Set("Last-Modified", lastModified.UTC().Format(http.TimeFormat))
+	}
+
+	if isNotModified(b.r, etag, lastModified) {
+		b.statusCode = http.StatusNotModified
+		b.body = nil
+		b.Write()
+	} else {
+		callback(b)
+	}
+}
+
 // Write generates the HTTP response.
 func (b *Builder) Write() {
 	if b.body == nil {
@@ -229,6 +249,26 @@ func ifNoneMatch(headerValue, etag string) bool {
 	return strings.Contains(headerValue, strings.TrimPrefix(etag, `W/`))
 }
 
+// isNotModified evaluates the conditional request headers.
+// If-Modified-Since is ignored when the request contains If-None-Match, as required by RFC 9110.
+func isNotModified(r *http.Request, etag string, lastModified time.Time) bool {
+	if headerValue := r.Header.Get("If-None-Match"); headerValue != "" {
+		return ifNoneMatch(headerValue, etag)
+	}
+
+	if lastModified.IsZero() {
+		return false
+	}
+
+	ifModifiedSince, err := http.ParseTime(r.Header.Get("If-Modified-Since"))
+	if err != nil {
+		return false
+	}
+
+	// HTTP dates have a one-second resolution.
+	return !lastModified.Truncate(time.Second).After(ifModifiedSince)
+}
+
 func formatContentDisposition(dispositionType, filename string) string {
 	if filename == "" {
 		return dispositionType
@@ -308,6 +308,76 @@ func TestBuildResponseWithCachingAndIfNoneMatch(t *testing.T) {
 	}
 }
 
+func TestBuildResponseWithConditionalCaching(t *testing.T) {
+	lastModified := time.Date(2024, time.March, 1, 10, 0, 0, 500, time.UTC)
+
+	tests := []struct {
+		name            string
+		ifNoneMatch     string
+		ifModifiedSince string
+		expectedStatus  int
+		expectedBody    string
+	}{
+		{"no conditional headers", "", "", http.StatusOK, "feed body"},
+		{"matching etag", `"etag"`, "", http.StatusNotModified, ""},
+		{"non-matching etag", `"other"`, "", http.StatusOK, "feed body"},
+		{"same modification date", "", "Fri, 01 Mar 2024 10:00:00 GMT", http.StatusNotModified, ""},
+		{"later modification date", "", "Fri, 01 Mar 2024 11:00:00 GMT", http.StatusNotModified, ""},
+		{"earlier modification date", "", "Fri, 01 Mar 2024 09:00:00 GMT", http.StatusOK, "feed body"},
+		{"invalid modification date", "", "yesterday", http.StatusOK, "feed body"},
+		{"etag takes precedence over date", `"other"`, "Fri, 01 Mar 2024 11:00:00 GMT", http.StatusOK, "feed body"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := http.NewRequest("GET", "/", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if tt.ifNoneMatch != "" {
+				r.Header.Set("If-None-Match", tt.ifNoneMatch)
+			}
+
+			if tt.ifModifiedSince != "" {
+				r.Header.Set("If-Modified-Since", tt.ifModifiedSince)
+			}
+
+			w := httptest.NewRecorder()
+
+			handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				NewBuilder(w, r).WithConditionalCaching("etag", lastModified, func(b *Builder) {
+					b.WithBodyAsString("feed body")
+					b.Write()
+				})
+			})
+
+			handler.ServeHTTP(w, r)
+			resp := w.Result()
+
+			if resp.StatusCode != tt.expectedStatus {
+				t.Fatalf(`Unexpected status code, got %d instead of %d`, resp.StatusCode, tt.expectedStatus)
+			}
+
+			if actual := w.Body.String(); actual != tt.expectedBody {
+				t.Fatalf(`Unexpected body, got %q instead of %q`, actual, tt.expectedBody)
+			}
+
+			if actual := resp.Header.Get("ETag"); actual != `"etag"` {
+				t.Fatalf(`Unexpected etag header, got %q`, actual)
+			}
+
+			if actual := resp.Header.Get("Last-Modified"); actual != "Fri, 01 Mar 2024 10:00:00 GMT" {
+				t.Fatalf(`Unexpected Last-Modified header, got %q`, actual)
+			}
+
+			if actual := resp.Header.Get("Cache-Control"); actual != "no-cache" {
+				t.Fatalf(`Unexpected Cache-Control header, got %q`, actual)
+			}
+		})
+	}
+}
+
 func TestNormalizeETag(t *testing.T) {
 	tests := []struct {
 		input    string
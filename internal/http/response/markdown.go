@@ -0,0 +1,15 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package response // import "miniflux.app/v2/internal/http/response"
+
+import "net/http"
+
+// MarkdownAttachment forces the Markdown document to be downloaded by the web browser.
+func MarkdownAttachment(w http.ResponseWriter, r *http.Request, filename string, body string) {
+	NewBuilder(w, r).
+		WithHeader("Content-Type", "text/markdown; charset=utf-8").
+		WithAttachment(filename).
+		WithBodyAsString(body).
+		Write()
+}
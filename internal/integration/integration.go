@@ -5,6 +5,7 @@ package integration // import "miniflux.app/v2/internal/integration"
 
 import (
 	"log/slog"
+	"slices"
 
 	"miniflux.app/v2/internal/integration/apprise"
 	"miniflux.app/v2/internal/integration/archiveorg"
@@ -39,471 +40,533 @@ import (
 
 // SendEntry sends the entry to third-party providers when the user click on "Save".
 func SendEntry(entry *model.Entry, userIntegrations *model.Integration) {
-	if userIntegrations.BetulaEnabled {
-		slog.Debug("Sending entry to Betula",
-			slog.Int64("user_id", userIntegrations.UserID),
-			slog.Int64("entry_id", entry.ID),
-			slog.String("entry_url", entry.URL),
-		)
-
-		client := betula.NewClient(userIntegrations.BetulaURL, userIntegrations.BetulaToken)
-		err := client.CreateBookmark(
-			entry.URL,
-			entry.Title,
-			entry.Tags,
-		)
+	for _, provider := range entryIntegrations {
+		if provider.enabled(userIntegrations) {
+			provider.send(entry, userIntegrations)
+		}
+	}
+}
 
-		if err != nil {
-			slog.Error("Unable to send entry to Betula",
-				slog.Int64("user_id", userIntegrations.UserID),
-				slog.Int64("entry_id", entry.ID),
-				slog.String("entry_url", entry.URL),
-				slog.Any("error", err),
-			)
+// SendEntryTo sends the entry to a single third-party provider used by SendEntry.
+// It returns false when the provider is unknown or not enabled by the user.
+func SendEntryTo(name string, entry *model.Entry, userIntegrations *model.Integration) bool {
+	for _, provider := range entryIntegrations {
+		if provider.name == name {
+			if !provider.enabled(userIntegrations) {
+				return false
+			}
+			provider.send(entry, userIntegrations)
+			return true
 		}
 	}
+	return false
+}
+
+// EntryIntegrationNames returns the names of the providers accepted by SendEntryTo.
+func EntryIntegrationNames() []string {
+	names := make([]string, 0, len(entryIntegrations))
+	for _, provider := range entryIntegrations {
+		names = append(names, provider.name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// entryIntegration is a third-party provider used by SendEntry, it can also be selected by name in action rules.
+type entryIntegration struct {
+	name    string
+	enabled func(userIntegrations *model.Integration) bool
+	send    func(entry *model.Entry, userIntegrations *model.Integration)
+}
 
-	if userIntegrations.PinboardEnabled {
-		slog.Debug("Sending entry to Pinboard",
+var entryIntegrations = []entryIntegration{
+	{"betula", func(i *model.Integration) bool { return i.BetulaEnabled }, sendEntryToBetula},
+	{"pinboard", func(i *model.Integration) bool { return i.PinboardEnabled }, sendEntryToPinboard},
+	{"instapaper", func(i *model.Integration) bool { return i.InstapaperEnabled }, sendEntryToInstapaper},
+	{"wallabag", func(i *model.Integration) bool { return i.WallabagEnabled }, sendEntryToWallabag},
+	{"notion", func(i *model.Integration) bool { return i.NotionEnabled }, sendEntryToNotion},
+	{"nunuxkeeper", func(i *model.Integration) bool { return i.NunuxKeeperEnabled }, sendEntryToNunuxKeeper},
+	{"espial", func(i *model.Integration) bool { return i.EspialEnabled }, sendEntryToEspial},
+	{"linkace", func(i *model.Integration) bool { return i.LinkAceEnabled }, sendEntryToLinkAce},
+	{"linkding", func(i *model.Integration) bool { return i.LinkdingEnabled }, sendEntryToLinkding},
+	{"linktaco", func(i *model.Integration) bool { return i.LinktacoEnabled }, sendEntryToLinktaco},
+	{"linkwarden", func(i *model.Integration) bool { return i.LinkwardenEnabled }, sendEntryToLinkwarden},
+	{"readeck", func(i *model.Integration) bool { return i.ReadeckEnabled }, sendEntryToReadeck},
+	{"readwise", func(i *model.Integration) bool { return i.ReadwiseEnabled }, sendEntryToReadwise},
+	{"cubox", func(i *model.Integration) bool { return i.CuboxEnabled }, sendEntryToCubox},
+	{"shiori", func(i *model.Integration) bool { return i.ShioriEnabled }, sendEntryToShiori},
+	{"shaarli", func(i *model.Integration) bool { return i.ShaarliEnabled }, sendEntryToShaarli},
+	{"archiveorg", func(i *model.Integration) bool { return i.ArchiveorgEnabled }, sendEntryToArchiveorg},
+	{"webhook", func(i *model.Integration) bool { return i.WebhookEnabled }, sendEntryToWebhook},
+	{"omnivore", func(i *model.Integration) bool { return i.OmnivoreEnabled }, sendEntryToOmnivore},
+	{"karakeep", func(i *model.Integration) bool { return i.KarakeepEnabled }, sendEntryToKarakeep},
+	{"raindrop", func(i *model.Integration) bool { return i.RaindropEnabled }, sendEntryToRaindrop},
+}
+
+func sendEntryToBetula(entry *model.Entry, userIntegrations *model.Integration) {
+	slog.Debug("Sending entry to Betula",
+		slog.Int64("user_id", userIntegrations.UserID),
+		slog.Int64("entry_id", entry.ID),
+		slog.String("entry_url", entry.URL),
+	)
+
+	client := betula.NewClient(userIntegrations.BetulaURL, userIntegrations.BetulaToken)
+	err := client.CreateBookmark(
+		entry.URL,
+		entry.Title,
+		entry.Tags,
+	)
+
+	if err != nil {
+		slog.Error("Unable to send entry to Betula",
 			slog.Int64("user_id", userIntegrations.UserID),
 			slog.Int64("entry_id", entry.ID),
 			slog.String("entry_url", entry.URL),
+			slog.Any("error", err),
 		)
-
-		client := pinboard.NewClient(userIntegrations.PinboardToken)
-		err := client.CreateBookmark(
-			entry.URL,
-			entry.Title,
-			userIntegrations.PinboardTags,
-			userIntegrations.PinboardMarkAsUnread,
-		)
-
-		if err != nil {
-			slog.Error("Unable to send entry to Pinboard",
-				slog.Int64("user_id", userIntegrations.UserID),
-				slog.Int64("entry_id", entry.ID),
-				slog.String("entry_url", entry.URL),
-				slog.Any("error", err),
-			)
-		}
 	}
+}
 
-	if userIntegrations.InstapaperEnabled {
-		slog.Debug("Sending entry to Instapaper",
+func sendEntryToPinboard(entry *model.Entry, userIntegrations *model.Integration) {
+	slog.Debug("Sending entry to Pinboard",
+		slog.Int64("user_id", userIntegrations.UserID),
+		slog.Int64("entry_id", entry.ID),
+		slog.String("entry_url", entry.URL),
+	)
+
+	client := pinboard.NewClient(userIntegrations.PinboardToken)
+	err := client.CreateBookmark(
+		entry.URL,
+		entry.Title,
+		userIntegrations.PinboardTags,
+		userIntegrations.PinboardMarkAsUnread,
+	)
+
+	if err != nil {
+		slog.Error("Unable to send entry to Pinboard",
 			slog.Int64("user_id", userIntegrations.UserID),
 			slog.Int64("entry_id", entry.ID),
 			slog.String("entry_url", entry.URL),
+			slog.Any("error", err),
 		)
-
-		client := instapaper.NewClient(userIntegrations.InstapaperUsername, userIntegrations.InstapaperPassword)
-		if err := client.AddURL(entry.URL, entry.Title); err != nil {
-			slog.Error("Unable to send entry to Instapaper",
-				slog.Int64("user_id", userIntegrations.UserID),
-				slog.Int64("entry_id", entry.ID),
-				slog.String("entry_url", entry.URL),
-				slog.Any("error", err),
-			)
-		}
 	}
+}
 
-	if userIntegrations.WallabagEnabled {
-		slog.Debug("Sending entry to Wallabag",
+func sendEntryToInstapaper(entry *model.Entry, userIntegrations *model.Integration) {
+	slog.Debug("Sending entry to Instapaper",
+		slog.Int64("user_id", userIntegrations.UserID),
+		slog.Int64("entry_id", entry.ID),
+		slog.String("entry_url", entry.URL),
+	)
+
+	client := instapaper.NewClient(userIntegrations.InstapaperUsername, userIntegrations.InstapaperPassword)
+	if err := client.AddURL(entry.URL, entry.Title); err != nil {
+		slog.Error("Unable to send entry to Instapaper",
 			slog.Int64("user_id", userIntegrations.UserID),
-			slog.String("user_tags", userIntegrations.WallabagTags),
 			slog.Int64("entry_id", entry.ID),
 			slog.String("entry_url", entry.URL),
+			slog.Any("error", err),
 		)
-
-		client := wallabag.NewClient(
-			userIntegrations.WallabagURL,
-			userIntegrations.WallabagClientID,
-			userIntegrations.WallabagClientSecret,
-			userIntegrations.WallabagUsername,
-			userIntegrations.WallabagPassword,
-			userIntegrations.WallabagTags,
-			userIntegrations.WallabagOnlyURL,
-		)
-
-		if err := client.CreateEntry(entry.URL, entry.Title, entry.Content); err != nil {
-			slog.Error("Unable to send entry to Wallabag",
-				slog.Int64("user_id", userIntegrations.UserID),
-				slog.String("user_tags", userIntegrations.WallabagTags),
-				slog.Int64("entry_id", entry.ID),
-				slog.String("entry_url", entry.URL),
-				slog.Any("error", err),
-			)
-		}
 	}
+}
 
-	if userIntegrations.NotionEnabled {
-		slog.Debug("Sending entry to Notion",
+func sendEntryToWallabag(entry *model.Entry, userIntegrations *model.Integration) {
+	slog.Debug("Sending entry to Wallabag",
+		slog.Int64("user_id", userIntegrations.UserID),
+		slog.String("user_tags", userIntegrations.WallabagTags),
+		slog.Int64("entry_id", entry.ID),
+		slog.String("entry_url", entry.URL),
+	)
+
+	client := wallabag.NewClient(
+		userIntegrations.WallabagURL,
+		userIntegrations.WallabagClientID,
+		userIntegrations.WallabagClientSecret,
+		userIntegrations.WallabagUsername,
+		userIntegrations.WallabagPassword,
+		userIntegrations.WallabagTags,
+		userIntegrations.WallabagOnlyURL,
+	)
+
+	if err := client.CreateEntry(entry.URL, entry.Title, entry.Content); err != nil {
+		slog.Error("Unable to send entry to Wallabag",
 			slog.Int64("user_id", userIntegrations.UserID),
+			slog.String("user_tags", userIntegrations.WallabagTags),
 			slog.Int64("entry_id", entry.ID),
 			slog.String("entry_url", entry.URL),
+			slog.Any("error", err),
 		)
-
-		client := notion.NewClient(
-			userIntegrations.NotionToken,
-			userIntegrations.NotionPageID,
-		)
-		if err := client.UpdateDocument(entry.URL, entry.Title); err != nil {
-			slog.Error("Unable to send entry to Notion",
-				slog.Int64("user_id", userIntegrations.UserID),
-				slog.Int64("entry_id", entry.ID),
-				slog.String("entry_url", entry.URL),
-				slog.Any("error", err),
-			)
-		}
 	}
+}
 
-	if userIntegrations.NunuxKeeperEnabled {
-		slog.Debug("Sending entry to NunuxKeeper",
+func sendEntryToNotion(entry *model.Entry, userIntegrations *model.Integration) {
+	slog.Debug("Sending entry to Notion",
+		slog.Int64("user_id", userIntegrations.UserID),
+		slog.Int64("entry_id", entry.ID),
+		slog.String("entry_url", entry.URL),
+	)
+
+	client := notion.NewClient(
+		userIntegrations.NotionToken,
+		userIntegrations.NotionPageID,
+	)
+	if err := client.UpdateDocument(entry.URL, entry.Title); err != nil {
+		slog.Error("Unable to send entry to Notion",
 			slog.Int64("user_id", userIntegrations.UserID),
 			slog.Int64("entry_id", entry.ID),
 			slog.String("entry_url", entry.URL),
+			slog.Any("error", err),
 		)
+	}
+}
 
-		client := nunuxkeeper.NewClient(
-			userIntegrations.NunuxKeeperURL,
-			userIntegrations.NunuxKeeperAPIKey,
-		)
+func sendEntryToNunuxKeeper(entry *model.Entry, userIntegrations *model.Integration) {
+	slog.Debug("Sending entry to NunuxKeeper",
+		slog.Int64("user_id", userIntegrations.UserID),
+		slog.Int64("entry_id", entry.ID),
+		slog.String("entry_url", entry.URL),
+	)
 
-		if err := client.AddEntry(entry.URL, entry.Title, entry.Content); err != nil {
-			slog.Error("Unable to send entry to NunuxKeeper",
-				slog.Int64("user_id", userIntegrations.UserID),
-				slog.Int64("entry_id", entry.ID),
-				slog.String("entry_url", entry.URL),
-				slog.Any("error", err),
-			)
-		}
-	}
+	client := nunuxkeeper.NewClient(
+		userIntegrations.NunuxKeeperURL,
+		userIntegrations.NunuxKeeperAPIKey,
+	)
 
-	if userIntegrations.EspialEnabled {
-		slog.Debug("Sending entry to Espial",
+	if err := client.AddEntry(entry.URL, entry.Title, entry.Content); err != nil {
+		slog.Error("Unable to send entry to NunuxKeeper",
 			slog.Int64("user_id", userIntegrations.UserID),
 			slog.Int64("entry_id", entry.ID),
 			slog.String("entry_url", entry.URL),
+			slog.Any("error", err),
 		)
+	}
+}
 
-		client := espial.NewClient(
-			userIntegrations.EspialURL,
-			userIntegrations.EspialAPIKey,
-		)
+func sendEntryToEspial(entry *model.Entry, userIntegrations *model.Integration) {
+	slog.Debug("Sending entry to Espial",
+		slog.Int64("user_id", userIntegrations.UserID),
+		slog.Int64("entry_id", entry.ID),
+		slog.String("entry_url", entry.URL),
+	)
 
-		if err := client.CreateLink(entry.URL, entry.Title, userIntegrations.EspialTags); err != nil {
-			slog.Error("Unable to send entry to Espial",
-				slog.Int64("user_id", userIntegrations.UserID),
-				slog.Int64("entry_id", entry.ID),
-				slog.String("entry_url", entry.URL),
-				slog.Any("error", err),
-			)
-		}
-	}
+	client := espial.NewClient(
+		userIntegrations.EspialURL,
+		userIntegrations.EspialAPIKey,
+	)
 
-	if userIntegrations.LinkAceEnabled {
-		slog.Debug("Sending entry to LinkAce",
+	if err := client.CreateLink(entry.URL, entry.Title, userIntegrations.EspialTags); err != nil {
+		slog.Error("Unable to send entry to Espial",
 			slog.Int64("user_id", userIntegrations.UserID),
 			slog.Int64("entry_id", entry.ID),
 			slog.String("entry_url", entry.URL),
+			slog.Any("error", err),
 		)
-
-		client := linkace.NewClient(
-			userIntegrations.LinkAceURL,
-			userIntegrations.LinkAceAPIKey,
-			userIntegrations.LinkAceTags,
-			userIntegrations.LinkAcePrivate,
-			userIntegrations.LinkAceCheckDisabled,
-		)
-		if err := client.AddURL(entry.URL, entry.Title); err != nil {
-			slog.Error("Unable to send entry to LinkAce",
-				slog.Int64("user_id", userIntegrations.UserID),
-				slog.Int64("entry_id", entry.ID),
-				slog.String("entry_url", entry.URL),
-				slog.Any("error", err),
-			)
-		}
 	}
+}
 
-	if userIntegrations.LinkdingEnabled {
-		slog.Debug("Sending entry to Linkding",
+func sendEntryToLinkAce(entry *model.Entry, userIntegrations *model.Integration) {
+	slog.Debug("Sending entry to LinkAce",
+		slog.Int64("user_id", userIntegrations.UserID),
+		slog.Int64("entry_id", entry.ID),
+		slog.String("entry_url", entry.URL),
+	)
+
+	client := linkace.NewClient(
+		userIntegrations.LinkAceURL,
+		userIntegrations.LinkAceAPIKey,
+		userIntegrations.LinkAceTags,
+		userIntegrations.LinkAcePrivate,
+		userIntegrations.LinkAceCheckDisabled,
+	)
+	if err := client.AddURL(entry.URL, entry.Title); err != nil {
+		slog.Error("Unable to send entry to LinkAce",
 			slog.Int64("user_id", userIntegrations.UserID),
 			slog.Int64("entry_id", entry.ID),
 			slog.String("entry_url", entry.URL),
+			slog.Any("error", err),
 		)
-
-		client := linkding.NewClient(
-			userIntegrations.LinkdingURL,
-			userIntegrations.LinkdingAPIKey,
-			userIntegrations.LinkdingTags,
-			userIntegrations.LinkdingMarkAsUnread,
-		)
-		if err := client.CreateBookmark(entry.URL, entry.Title); err != nil {
-			slog.Error("Unable to send entry to Linkding",
-				slog.Int64("user_id", userIntegrations.UserID),
-				slog.Int64("entry_id", entry.ID),
-				slog.String("entry_url", entry.URL),
-				slog.Any("error", err),
-			)
-		}
 	}
+}
 
-	if userIntegrations.LinktacoEnabled {
-		slog.Debug("Sending entry to LinkTaco",
+func sendEntryToLinkding(entry *model.Entry, userIntegrations *model.Integration) {
+	slog.Debug("Sending entry to Linkding",
+		slog.Int64("user_id", userIntegrations.UserID),
+		slog.Int64("entry_id", entry.ID),
+		slog.String("entry_url", entry.URL),
+	)
+
+	client := linkding.NewClient(
+		userIntegrations.LinkdingURL,
+		userIntegrations.LinkdingAPIKey,
+		userIntegrations.LinkdingTags,
+		userIntegrations.LinkdingMarkAsUnread,
+	)
+	if err := client.CreateBookmark(entry.URL, entry.Title); err != nil {
+		slog.Error("Unable to send entry to Linkding",
 			slog.Int64("user_id", userIntegrations.UserID),
 			slog.Int64("entry_id", entry.ID),
 			slog.String("entry_url", entry.URL),
+			slog.Any("error", err),
 		)
-
-		client := linktaco.NewClient(
-			userIntegrations.LinktacoAPIToken,
-			userIntegrations.LinktacoOrgSlug,
-			userIntegrations.LinktacoTags,
-			userIntegrations.LinktacoVisibility,
-		)
-		if err := client.CreateBookmark(entry.URL, entry.Title, entry.Content); err != nil {
-			slog.Error("Unable to send entry to LinkTaco",
-				slog.Int64("user_id", userIntegrations.UserID),
-				slog.Int64("entry_id", entry.ID),
-				slog.String("entry_url", entry.URL),
-				slog.Any("error", err),
-			)
-		}
 	}
+}
 
-	if userIntegrations.LinkwardenEnabled {
-		attrs := []any{
+func sendEntryToLinktaco(entry *model.Entry, userIntegrations *model.Integration) {
+	slog.Debug("Sending entry to LinkTaco",
+		slog.Int64("user_id", userIntegrations.UserID),
+		slog.Int64("entry_id", entry.ID),
+		slog.String("entry_url", entry.URL),
+	)
+
+	client := linktaco.NewClient(
+		userIntegrations.LinktacoAPIToken,
+		userIntegrations.LinktacoOrgSlug,
+		userIntegrations.LinktacoTags,
+		userIntegrations.LinktacoVisibility,
+	)
+	if err := client.CreateBookmark(entry.URL, entry.Title, entry.Content); err != nil {
+		slog.Error("Unable to send entry to LinkTaco",
 			slog.Int64("user_id", userIntegrations.UserID),
 			slog.Int64("entry_id", entry.ID),
 			slog.String("entry_url", entry.URL),
-		}
+			slog.Any("error", err),
+		)
+	}
+}
 
-		if userIntegrations.LinkwardenCollectionID != nil {
-			attrs = append(attrs, slog.Int64("collection_id", *userIntegrations.LinkwardenCollectionID))
-		}
+func sendEntryToLinkwarden(entry *model.Entry, userIntegrations *model.Integration) {
+	attrs := []any{
+		slog.Int64("user_id", userIntegrations.UserID),
+		slog.Int64("entry_id", entry.ID),
+		slog.String("entry_url", entry.URL),
+	}
+
+	if userIntegrations.LinkwardenCollectionID != nil {
+		attrs = append(attrs, slog.Int64("collection_id", *userIntegrations.LinkwardenCollectionID))
+	}
 
-		slog.Debug("Sending entry to linkwarden", attrs...)
+	slog.Debug("Sending entry to linkwarden", attrs...)
 
-		client := linkwarden.NewClient(
-			userIntegrations.LinkwardenURL,
-			userIntegrations.LinkwardenAPIKey,
-			userIntegrations.LinkwardenCollectionID,
-		)
-		if err := client.CreateBookmark(entry.URL, entry.Title); err != nil {
-			attrs = append(attrs, slog.Any("error", err))
-			slog.Error("Unable to send entry to Linkwarden", attrs...)
-		}
+	client := linkwarden.NewClient(
+		userIntegrations.LinkwardenURL,
+		userIntegrations.LinkwardenAPIKey,
+		userIntegrations.LinkwardenCollectionID,
+	)
+	if err := client.CreateBookmark(entry.URL, entry.Title); err != nil {
+		attrs = append(attrs, slog.Any("error", err))
+		slog.Error("Unable to send entry to Linkwarden", attrs...)
 	}
+}
 
-	if userIntegrations.ReadeckEnabled {
-		slog.Debug("Sending entry to Readeck",
+func sendEntryToReadeck(entry *model.Entry, userIntegrations *model.Integration) {
+	slog.Debug("Sending entry to Readeck",
+		slog.Int64("user_id", userIntegrations.UserID),
+		slog.Int64("entry_id", entry.ID),
+		slog.String("entry_url", entry.URL),
+	)
+
+	client := readeck.NewClient(
+		userIntegrations.ReadeckURL,
+		userIntegrations.ReadeckAPIKey,
+		userIntegrations.ReadeckLabels,
+		userIntegrations.ReadeckOnlyURL,
+	)
+	if err := client.CreateBookmark(entry.URL, entry.Title, entry.Content); err != nil {
+		slog.Error("Unable to send entry to Readeck",
 			slog.Int64("user_id", userIntegrations.UserID),
 			slog.Int64("entry_id", entry.ID),
 			slog.String("entry_url", entry.URL),
+			slog.Any("error", err),
 		)
-
-		client := readeck.NewClient(
-			userIntegrations.ReadeckURL,
-			userIntegrations.ReadeckAPIKey,
-			userIntegrations.ReadeckLabels,
-			userIntegrations.ReadeckOnlyURL,
-		)
-		if err := client.CreateBookmark(entry.URL, entry.Title, entry.Content); err != nil {
-			slog.Error("Unable to send entry to Readeck",
-				slog.Int64("user_id", userIntegrations.UserID),
-				slog.Int64("entry_id", entry.ID),
-				slog.String("entry_url", entry.URL),
-				slog.Any("error", err),
-			)
-		}
 	}
+}
+
+func sendEntryToReadwise(entry *model.Entry, userIntegrations *model.Integration) {
+	slog.Debug("Sending entry to Readwise",
+		slog.Int64("user_id", userIntegrations.UserID),
+		slog.Int64("entry_id", entry.ID),
+		slog.String("entry_url", entry.URL),
+	)
+
+	client := readwise.NewClient(
+		userIntegrations.ReadwiseAPIKey,
+	)
 
-	if userIntegrations.ReadwiseEnabled {
-		slog.Debug("Sending entry to Readwise",
+	if err := client.CreateDocument(entry.URL); err != nil {
+		slog.Error("Unable to send entry to Readwise",
 			slog.Int64("user_id", userIntegrations.UserID),
 			slog.Int64("entry_id", entry.ID),
 			slog.String("entry_url", entry.URL),
+			slog.Any("error", err),
 		)
+	}
+}
 
-		client := readwise.NewClient(
-			userIntegrations.ReadwiseAPIKey,
-		)
+func sendEntryToCubox(entry *model.Entry, userIntegrations *model.Integration) {
+	slog.Debug("Sending entry to Cubox",
+		slog.Int64("user_id", userIntegrations.UserID),
+		slog.Int64("entry_id", entry.ID),
+		slog.String("entry_url", entry.URL),
+	)
 
-		if err := client.CreateDocument(entry.URL); err != nil {
-			slog.Error("Unable to send entry to Readwise",
-				slog.Int64("user_id", userIntegrations.UserID),
-				slog.Int64("entry_id", entry.ID),
-				slog.String("entry_url", entry.URL),
-				slog.Any("error", err),
-			)
-		}
-	}
+	client := cubox.NewClient(userIntegrations.CuboxAPILink)
 
-	if userIntegrations.CuboxEnabled {
-		slog.Debug("Sending entry to Cubox",
+	if err := client.SaveLink(entry.URL); err != nil {
+		slog.Error("Unable to send entry to Cubox",
 			slog.Int64("user_id", userIntegrations.UserID),
 			slog.Int64("entry_id", entry.ID),
 			slog.String("entry_url", entry.URL),
+			slog.Any("error", err),
 		)
-
-		client := cubox.NewClient(userIntegrations.CuboxAPILink)
-
-		if err := client.SaveLink(entry.URL); err != nil {
-			slog.Error("Unable to send entry to Cubox",
-				slog.Int64("user_id", userIntegrations.UserID),
-				slog.Int64("entry_id", entry.ID),
-				slog.String("entry_url", entry.URL),
-				slog.Any("error", err),
-			)
-		}
 	}
+}
 
-	if userIntegrations.ShioriEnabled {
-		slog.Debug("Sending entry to Shiori",
+func sendEntryToShiori(entry *model.Entry, userIntegrations *model.Integration) {
+	slog.Debug("Sending entry to Shiori",
+		slog.Int64("user_id", userIntegrations.UserID),
+		slog.Int64("entry_id", entry.ID),
+		slog.String("entry_url", entry.URL),
+	)
+
+	client := shiori.NewClient(
+		userIntegrations.ShioriURL,
+		userIntegrations.ShioriUsername,
+		userIntegrations.ShioriPassword,
+	)
+
+	if err := client.CreateBookmark(entry.URL, entry.Title); err != nil {
+		slog.Error("Unable to send entry to Shiori",
 			slog.Int64("user_id", userIntegrations.UserID),
 			slog.Int64("entry_id", entry.ID),
 			slog.String("entry_url", entry.URL),
+			slog.Any("error", err),
 		)
+	}
+}
 
-		client := shiori.NewClient(
-			userIntegrations.ShioriURL,
-			userIntegrations.ShioriUsername,
-			userIntegrations.ShioriPassword,
-		)
+func sendEntryToShaarli(entry *model.Entry, userIntegrations *model.Integration) {
+	slog.Debug("Sending entry to Shaarli",
+		slog.Int64("user_id", userIntegrations.UserID),
+		slog.Int64("entry_id", entry.ID),
+		slog.String("entry_url", entry.URL),
+	)
 
-		if err := client.CreateBookmark(entry.URL, entry.Title); err != nil {
-			slog.Error("Unable to send entry to Shiori",
-				slog.Int64("user_id", userIntegrations.UserID),
-				slog.Int64("entry_id", entry.ID),
-				slog.String("entry_url", entry.URL),
-				slog.Any("error", err),
-			)
-		}
-	}
+	client := shaarli.NewClient(
+		userIntegrations.ShaarliURL,
+		userIntegrations.ShaarliAPISecret,
+	)
 
-	if userIntegrations.ShaarliEnabled {
-		slog.Debug("Sending entry to Shaarli",
+	if err := client.CreateLink(entry.URL, entry.Title); err != nil {
+		slog.Error("Unable to send entry to Shaarli",
 			slog.Int64("user_id", userIntegrations.UserID),
 			slog.Int64("entry_id", entry.ID),
 			slog.String("entry_url", entry.URL),
+			slog.Any("error", err),
 		)
-
-		client := shaarli.NewClient(
-			userIntegrations.ShaarliURL,
-			userIntegrations.ShaarliAPISecret,
-		)
-
-		if err := client.CreateLink(entry.URL, entry.Title); err != nil {
-			slog.Error("Unable to send entry to Shaarli",
-				slog.Int64("user_id", userIntegrations.UserID),
-				slog.Int64("entry_id", entry.ID),
-				slog.String("entry_url", entry.URL),
-				slog.Any("error", err),
-			)
-		}
 	}
+}
+
+func sendEntryToArchiveorg(entry *model.Entry, userIntegrations *model.Integration) {
+	slog.Debug("Sending entry to archive.org",
+		slog.Int64("user_id", userIntegrations.UserID),
+		slog.Int64("entry_id", entry.ID),
+		slog.String("entry_url", entry.URL),
+	)
 
-	if userIntegrations.ArchiveorgEnabled {
-		slog.Debug("Sending entry to archive.org",
+	if err := archiveorg.NewClient().SendURL(entry.URL); err != nil {
+		slog.Error("Unable to send entry to Archive.org",
 			slog.Int64("user_id", userIntegrations.UserID),
 			slog.Int64("entry_id", entry.ID),
 			slog.String("entry_url", entry.URL),
+			slog.Any("error", err),
 		)
+	}
+}
 
-		if err := archiveorg.NewClient().SendURL(entry.URL); err != nil {
-			slog.Error("Unable to send entry to Archive.org",
-				slog.Int64("user_id", userIntegrations.UserID),
-				slog.Int64("entry_id", entry.ID),
-				slog.String("entry_url", entry.URL),
-				slog.Any("error", err),
-			)
-		}
+func sendEntryToWebhook(entry *model.Entry, userIntegrations *model.Integration) {
+	var webhookURL string
+	if entry.Feed != nil && entry.Feed.WebhookURL != "" {
+		webhookURL = entry.Feed.WebhookURL
+	} else {
+		webhookURL = userIntegrations.WebhookURL
 	}
 
-	if userIntegrations.WebhookEnabled {
-		var webhookURL string
-		if entry.Feed != nil && entry.Feed.WebhookURL != "" {
-			webhookURL = entry.Feed.WebhookURL
-		} else {
-			webhookURL = userIntegrations.WebhookURL
-		}
+	slog.Debug("Sending entry to Webhook",
+		slog.Int64("user_id", userIntegrations.UserID),
+		slog.Int64("entry_id", entry.ID),
+		slog.String("entry_url", entry.URL),
+		slog.String("webhook_url", webhookURL),
+	)
 
-		slog.Debug("Sending entry to Webhook",
+	webhookClient := webhook.NewClient(webhookURL, userIntegrations.WebhookSecret)
+	if err := webhookClient.SendSaveEntryWebhookEvent(entry); err != nil {
+		slog.Error("Unable to send entry to Webhook",
 			slog.Int64("user_id", userIntegrations.UserID),
 			slog.Int64("entry_id", entry.ID),
 			slog.String("entry_url", entry.URL),
 			slog.String("webhook_url", webhookURL),
+			slog.Any("error", err),
 		)
-
-		webhookClient := webhook.NewClient(webhookURL, userIntegrations.WebhookSecret)
-		if err := webhookClient.SendSaveEntryWebhookEvent(entry); err != nil {
-			slog.Error("Unable to send entry to Webhook",
-				slog.Int64("user_id", userIntegrations.UserID),
-				slog.Int64("entry_id", entry.ID),
-				slog.String("entry_url", entry.URL),
-				slog.String("webhook_url", webhookURL),
-				slog.Any("error", err),
-			)
-		}
 	}
+}
 
-	if userIntegrations.OmnivoreEnabled {
-		slog.Debug("Sending entry to Omnivore",
+func sendEntryToOmnivore(entry *model.Entry, userIntegrations *model.Integration) {
+	slog.Debug("Sending entry to Omnivore",
+		slog.Int64("user_id", userIntegrations.UserID),
+		slog.Int64("entry_id", entry.ID),
+		slog.String("entry_url", entry.URL),
+	)
+
+	client := omnivore.NewClient(userIntegrations.OmnivoreAPIKey, userIntegrations.OmnivoreURL)
+	if err := client.SaveURL(entry.URL); err != nil {
+		slog.Error("Unable to send entry to Omnivore",
 			slog.Int64("user_id", userIntegrations.UserID),
 			slog.Int64("entry_id", entry.ID),
 			slog.String("entry_url", entry.URL),
+			slog.Any("error", err),
 		)
-
-		client := omnivore.NewClient(userIntegrations.OmnivoreAPIKey, userIntegrations.OmnivoreURL)
-		if err := client.SaveURL(entry.URL); err != nil {
-			slog.Error("Unable to send entry to Omnivore",
-				slog.Int64("user_id", userIntegrations.UserID),
-				slog.Int64("entry_id", entry.ID),
-				slog.String("entry_url", entry.URL),
-				slog.Any("error", err),
-			)
-		}
 	}
+}
 
-	if userIntegrations.KarakeepEnabled {
-		slog.Debug("Sending entry to Karakeep",
+func sendEntryToKarakeep(entry *model.Entry, userIntegrations *model.Integration) {
+	slog.Debug("Sending entry to Karakeep",
+		slog.Int64("user_id", userIntegrations.UserID),
+		slog.String("user_tags", userIntegrations.KarakeepTags),
+		slog.Int64("entry_id", entry.ID),
+		slog.String("entry_url", entry.URL),
+	)
+
+	client := karakeep.NewClient(
+		userIntegrations.KarakeepAPIKey,
+		userIntegrations.KarakeepURL,
+		userIntegrations.KarakeepTags,
+	)
+	if err := client.SaveURL(entry.URL); err != nil {
+		slog.Error("Unable to send entry to Karakeep",
 			slog.Int64("user_id", userIntegrations.UserID),
 			slog.String("user_tags", userIntegrations.KarakeepTags),
 			slog.Int64("entry_id", entry.ID),
 			slog.String("entry_url", entry.URL),
+			slog.Any("error", err),
 		)
-
-		client := karakeep.NewClient(
-			userIntegrations.KarakeepAPIKey,
-			userIntegrations.KarakeepURL,
-			userIntegrations.KarakeepTags,
-		)
-		if err := client.SaveURL(entry.URL); err != nil {
-			slog.Error("Unable to send entry to Karakeep",
-				slog.Int64("user_id", userIntegrations.UserID),
-				slog.String("user_tags", userIntegrations.KarakeepTags),
-				slog.Int64("entry_id", entry.ID),
-				slog.String("entry_url", entry.URL),
-				slog.Any("error", err),
-			)
-		}
 	}
+}
+
+func sendEntryToRaindrop(entry *model.Entry, userIntegrations *model.Integration) {
+	slog.Debug("Sending entry to Raindrop",
+		slog.Int64("user_id", userIntegrations.UserID),
+		slog.Int64("entry_id", entry.ID),
+		slog.String("entry_url", entry.URL),
+	)
 
-	if userIntegrations.RaindropEnabled {
-		slog.Debug("Sending entry to Raindrop",
+	client := raindrop.NewClient(userIntegrations.RaindropToken, userIntegrations.RaindropCollectionID, userIntegrations.RaindropTags)
+	if err := client.CreateRaindrop(entry.URL, entry.Title); err != nil {
+		slog.Error("Unable to send entry to Raindrop",
 			slog.Int64("user_id", userIntegrations.UserID),
 			slog.Int64("entry_id", entry.ID),
 			slog.String("entry_url", entry.URL),
+			slog.Any("error", err),
 		)
-
-		client := raindrop.NewClient(userIntegrations.RaindropToken, userIntegrations.RaindropCollectionID, userIntegrations.RaindropTags)
-		if err := client.CreateRaindrop(entry.URL, entry.Title); err != nil {
-			slog.Error("Unable to send entry to Raindrop",
-				slog.Int64("user_id", userIntegrations.UserID),
-				slog.Int64("entry_id", entry.ID),
-				slog.String("entry_url", entry.URL),
-				slog.Any("error", err),
-			)
-		}
 	}
 }
 
@@ -561,31 +624,7 @@ func PushEntries(feed *model.Feed, entries model.Entries, userIntegrations *mode
 	}
 
 	if userIntegrations.NtfyEnabled && feed.NtfyEnabled {
-		ntfyTopic := feed.NtfyTopic
-		if ntfyTopic == "" {
-			ntfyTopic = userIntegrations.NtfyTopic
-		}
-		slog.Debug("Sending new entries to Ntfy",
-			slog.Int64("user_id", userIntegrations.UserID),
-			slog.Int("nb_entries", len(entries)),
-			slog.Int64("feed_id", feed.ID),
-			slog.String("topic", ntfyTopic),
-		)
-
-		client := ntfy.NewClient(
-			userIntegrations.NtfyURL,
-			ntfyTopic,
-			userIntegrations.NtfyAPIToken,
-			userIntegrations.NtfyUsername,
-			userIntegrations.NtfyPassword,
-			userIntegrations.NtfyIconURL,
-			userIntegrations.NtfyInternalLinks,
-			feed.NtfyPriority,
-		)
-
-		if err := client.SendMessages(feed, entries); err != nil {
-			slog.Warn("Unable to send new entries to Ntfy", slog.Any("error", err))
-		}
+		sendNtfyNotification(feed, entries, userIntegrations)
 	}
 
 	if userIntegrations.AppriseEnabled {
@@ -643,23 +682,7 @@ func PushEntries(feed *model.Feed, entries model.Entries, userIntegrations *mode
 	}
 
 	if userIntegrations.PushoverEnabled && feed.PushoverEnabled {
-		slog.Debug("Sending new entries to Pushover",
-			slog.Int64("user_id", userIntegrations.UserID),
-			slog.Int("nb_entries", len(entries)),
-			slog.Int64("feed_id", feed.ID),
-		)
-
-		client := pushover.NewClient(
-			userIntegrations.PushoverUser,
-			userIntegrations.PushoverToken,
-			feed.PushoverPriority,
-			userIntegrations.PushoverDevice,
-			userIntegrations.PushoverPrefix,
-		)
-
-		if err := client.SendMessages(feed, entries); err != nil {
-			slog.Warn("Unable to send new entries to Pushover", slog.Any("error", err))
-		}
+		sendPushoverNotification(feed, entries, userIntegrations)
 	}
 
 	// Integrations that only support sending individual entries
@@ -717,3 +740,91 @@ func PushEntries(feed *model.Feed, entries model.Entries, userIntegrations *mode
 		}
 	}
 }
+
+// PushEntryActions runs the integrations and the notifications requested by action rules for newly stored entries.
+func PushEntryActions(feed *model.Feed, entries model.Entries, userIntegrations *model.Integration) {
+	var notifiedEntries model.Entries
+	for _, entry := range entries {
+		if entry.Actions == nil {
+			continue
+		}
+
+		for _, name := range entry.Actions.Integrations {
+			if !SendEntryTo(name, entry, userIntegrations) {
+				slog.Debug("Skipping action rule integration that is not enabled",
+					slog.Int64("user_id", userIntegrations.UserID),
+					slog.Int64("entry_id", entry.ID),
+					slog.String("integration", name),
+				)
+			}
+		}
+
+		if entry.Actions.Notify {
+			notifiedEntries = append(notifiedEntries, entry)
+		}
+	}
+
+	if len(notifiedEntries) > 0 {
+		NotifyEntries(feed, notifiedEntries, userIntegrations)
+	}
+}
+
+// NotifyEntries sends the entries to the notification providers enabled by the user (Ntfy and Pushover),
+// even when notifications are disabled for the feed. Feeds with notifications enabled are already handled by PushEntries.
+func NotifyEntries(feed *model.Feed, entries model.Entries, userIntegrations *model.Integration) {
+	if userIntegrations.NtfyEnabled && !feed.NtfyEnabled {
+		sendNtfyNotification(feed, entries, userIntegrations)
+	}
+
+	if userIntegrations.PushoverEnabled && !feed.PushoverEnabled {
+		sendPushoverNotification(feed, entries, userIntegrations)
+	}
+}
+
+func sendNtfyNotification(feed *model.Feed, entries model.Entries, userIntegrations *model.Integration) {
+	ntfyTopic := feed.NtfyTopic
+	if ntfyTopic == "" {
+		ntfyTopic = userIntegrations.NtfyTopic
+	}
+	slog.Debug("Sending new entries to Ntfy",
+		slog.Int64("user_id", userIntegrations.UserID),
+		slog.Int("nb_entries", len(entries)),
+		slog.Int64("feed_id", feed.ID),
+		slog.String("topic", ntfyTopic),
+	)
+
+	client := ntfy.NewClient(
+		userIntegrations.NtfyURL,
+		ntfyTopic,
+		userIntegrations.NtfyAPIToken,
+		userIntegrations.NtfyUsername,
+		userIntegrations.NtfyPassword,
+		userIntegrations.NtfyIconURL,
+		userIntegrations.NtfyInternalLinks,
+		feed.NtfyPriority,
+	)
+
+	if err := client.SendMessages(feed, entries); err != nil {
+		slog.Warn("Unable to send new entries to Ntfy", slog.Any("error", err))
+	}
+}
+
+func sendPushoverNotification(feed *model.Feed, entries model.Entries, userIntegrations *model.Integration) {
+	slog.Debug("Sending new entries to Pushover",
+		slog.Int64("user_id", userIntegrations.UserID),
+		slog.Int("nb_entries", len(entries)),
+		slog.Int64("feed_id", feed.ID),
+	)
+
+	client := pushover.NewClient(
+		userIntegrations.PushoverUser,
+		userIntegrations.PushoverToken,
+		feed.PushoverPriority,
+		userIntegrations.PushoverDevice,
+		userIntegrations.PushoverPrefix,
+	)
+
+	if err := client.SendMessages(feed, entries); err != nil {
+		slog.Warn("Unable to send new entries to Pushover", slog.Any("error", err))
+	}
+}
@@ -61,3 +61,39 @@ func TestSendEntryLogsLinkwardenWithoutCollectionID(t *testing.T) {
 		t.Fatalf("did not expect collection_id in logs; got: %s", out)
 	}
 }
+
+func TestSendEntryToSingleIntegration(t *testing.T) {
+	var buf bytes.Buffer
+	handler := slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug})
+	prev := slog.Default()
+	slog.SetDefault(slog.New(handler))
+	defer slog.SetDefault(prev)
+
+	entry := &model.Entry{ID: 52, URL: "https://example.org/test.html", Title: "Test"}
+	userIntegrations := &model.Integration{
+		UserID:            1,
+		BetulaEnabled:     true,
+		LinkwardenEnabled: true,
+	}
+
+	if !SendEntryTo("linkwarden", entry, userIntegrations) {
+		t.Fatal(`Expected the entry to be sent to Linkwarden`)
+	}
+
+	out := buf.String()
+	if !strings.Contains(out, "Linkwarden") || strings.Contains(out, "Betula") {
+		t.Fatalf("expected the entry to be sent only to Linkwarden; got: %s", out)
+	}
+
+	if !userIntegrations.BetulaEnabled {
+		t.Error(`The user integrations must not be modified`)
+	}
+
+	if SendEntryTo("pinboard", entry, userIntegrations) {
+		t.Error(`Expected disabled integrations to be skipped`)
+	}
+
+	if SendEntryTo("unknown", entry, userIntegrations) {
+		t.Error(`Expected unknown integrations to be skipped`)
+	}
+}
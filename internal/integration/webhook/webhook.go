@@ -51,6 +51,7 @@ func (c *Client) SendSaveEntryWebhookEvent(entry *model.Entry) error {
 			ReadingTime: entry.ReadingTime,
 			Enclosures:  entry.Enclosures,
 			Tags:        entry.Tags,
+			Highlights:  entry.Highlights,
 			Feed: &WebhookFeed{
 				ID:         entry.Feed.ID,
 				UserID:     entry.Feed.UserID,
@@ -172,6 +173,7 @@ type WebhookEntry struct {
 	ReadingTime int                 `json:"reading_time"`
 	Enclosures  model.EnclosureList `json:"enclosures"`
 	Tags        []string            `json:"tags"`
+	Highlights  model.Highlights    `json:"highlights,omitempty"`
 	Feed        *WebhookFeed        `json:"feed,omitempty"`
 }
 
@@ -18,6 +18,8 @@ type Enclosure struct {
 	MimeType         string `json:"mime_type"`
 	Size             int64  `json:"size"`
 	MediaProgression int64  `json:"media_progression"`
+	Title            string `json:"title,omitempty"`
+	Alternate        bool   `json:"alternate"`
 }
 
 type EnclosureUpdateRequest struct {
@@ -60,9 +62,10 @@ func (e *Enclosure) ProxifyEnclosureURL(mediaProxyOption string, mediaProxyResou
 type EnclosureList []*Enclosure
 
 // FindMediaPlayerEnclosure returns the first enclosure that can be played by a media player.
+// Alternate enclosures are skipped because they are other versions of the main media file.
 func (el EnclosureList) FindMediaPlayerEnclosure() *Enclosure {
 	for _, enclosure := range el {
-		if enclosure.URL != "" {
+		if enclosure.URL != "" && !enclosure.Alternate {
 			if enclosure.IsAudio() || enclosure.IsVideo() {
 				return enclosure
 			}
@@ -184,6 +184,13 @@ func TestEnclosureList_FindMediaPlayerEnclosure(t *testing.T) {
 			},
 			expectedNil: true,
 		},
+		{
+			name: "Returns nil for alternate enclosures only",
+			enclosures: EnclosureList{
+				&Enclosure{URL: "http://example.com/audio.opus", MimeType: "audio/opus", Alternate: true},
+			},
+			expectedNil: true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -5,6 +5,9 @@ package model // import "miniflux.app/v2/internal/model"
 
 import (
 	"time"
+
+	"miniflux.app/v2/internal/mediaproxy"
+	"miniflux.app/v2/internal/urllib"
 )
 
 // Entry statuses and default sorting order.
@@ -25,26 +28,66 @@ const MaxEntryIDsLimit = 10000
 
 // Entry represents a feed item in the system.
 type Entry struct {
-	ID          int64         `json:"id"`
-	UserID      int64         `json:"user_id"`
-	FeedID      int64         `json:"feed_id"`
-	Status      string        `json:"status"`
-	Hash        string        `json:"hash"`
-	Title       string        `json:"title"`
-	URL         string        `json:"url"`
-	CommentsURL string        `json:"comments_url"`
-	Language    string        `json:"language"`
-	Date        time.Time     `json:"published_at"`
-	CreatedAt   time.Time     `json:"created_at"`
-	ChangedAt   time.Time     `json:"changed_at"`
-	Content     string        `json:"content"`
-	Author      string        `json:"author"`
-	ShareCode   string        `json:"share_code"`
-	Starred     bool          `json:"starred"`
-	ReadingTime int           `json:"reading_time"`
-	Enclosures  EnclosureList `json:"enclosures"`
-	Feed        *Feed         `json:"feed,omitempty"`
-	Tags        []string      `json:"tags"`
+	ID              int64             `json:"id"`
+	UserID          int64             `json:"user_id"`
+	FeedID          int64             `json:"feed_id"`
+	Status          string            `json:"status"`
+	Hash            string            `json:"hash"`
+	Title           string            `json:"title"`
+	URL             string            `json:"url"`
+	CommentsURL     string            `json:"comments_url"`
+	Language        string            `json:"language"`
+	Date            time.Time         `json:"published_at"`
+	CreatedAt       time.Time         `json:"created_at"`
+	ChangedAt       time.Time         `json:"changed_at"`
+	Content         string            `json:"content"`
+	Author          string            `json:"author"`
+	ShareCode       string            `json:"share_code"`
+	Starred         bool              `json:"starred"`
+	ReadingTime     int               `json:"reading_time"`
+	Enclosures      EnclosureList     `json:"enclosures"`
+	Feed            *Feed             `json:"feed,omitempty"`
+	Tags            []string          `json:"tags"`
+	Labels          Labels            `json:"labels,omitempty"`
+	Highlights      Highlights        `json:"highlights,omitempty"`
+	Revisions       EntryRevisions    `json:"revisions,omitempty"`
+	Podcast         *PodcastMetadata  `json:"podcast,omitempty"`
+	Transcript      string            `json:"transcript,omitempty"`
+	ThumbnailURL    string            `json:"thumbnail_url"`
+	ThumbnailWidth  int               `json:"thumbnail_width"`
+	ThumbnailHeight int               `json:"thumbnail_height"`
+	DuplicateOfID   int64             `json:"duplicate_of_id,omitempty"`
+	Duplicates      []*EntryDuplicate `json:"duplicates,omitempty"`
+	StoryID         int64             `json:"story_id,omitempty"`
+	StoryEntries    []*EntryDuplicate `json:"story_entries,omitempty"`
+	SearchSnippet   *SearchSnippet    `json:"search_snippet,omitempty"`
+	Fingerprint     int64             `json:"-"`
+	Actions         *EntryActions     `json:"-"`
+}
+
+// EntryActions lists the changes requested by the action rule matching an entry.
+// Integrations and notifications are handled once the entry is stored.
+type EntryActions struct {
+	Status       string
+	Starred      bool
+	Tags         []string
+	Integrations []string
+	Notify       bool
+}
+
+// SearchSnippet contains the title and the content fragments matching a search query.
+// Both are HTML, the matching terms are wrapped in mark tags.
+type SearchSnippet struct {
+	Title   string `json:"title"`
+	Content string `json:"content"`
+}
+
+// EntryDuplicate is an entry with the same URL or telling the same story in another feed.
+type EntryDuplicate struct {
+	EntryID   int64  `json:"entry_id"`
+	FeedID    int64  `json:"feed_id"`
+	FeedTitle string `json:"feed_title"`
+	Status    string `json:"status"`
 }
 
 func NewEntry() *Entry {
@@ -74,6 +117,25 @@ func (e *Entry) ShouldMarkAsReadOnView(user *User) bool {
 	return user.MarkReadOnView
 }
 
+// SetThumbnail defines the lead image of the entry, only absolute HTTP URLs are accepted.
+func (e *Entry) SetThumbnail(thumbnailURL string, width, height int) bool {
+	if !urllib.IsAbsoluteURL(thumbnailURL) {
+		return false
+	}
+
+	e.ThumbnailURL = thumbnailURL
+	e.ThumbnailWidth = max(width, 0)
+	e.ThumbnailHeight = max(height, 0)
+	return true
+}
+
+// ProxifyThumbnailURL modifies the thumbnail URL to use the media proxy if necessary.
+func (e *Entry) ProxifyThumbnailURL(mediaProxyOption string, mediaProxyResourceTypes []string) {
+	if mediaproxy.ShouldProxifyURLWithMimeType(e.ThumbnailURL, "image/*", mediaProxyOption, mediaProxyResourceTypes) {
+		e.ThumbnailURL = mediaproxy.ProxifyAbsoluteURL(e.ThumbnailURL)
+	}
+}
+
 // Entries represents a list of entries.
 type Entries []*Entry
 
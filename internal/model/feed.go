@@ -6,9 +6,12 @@ package model // import "miniflux.app/v2/internal/model"
 import (
 	"fmt"
 	"io"
+	"slices"
+	"strings"
 	"time"
 
 	"miniflux.app/v2/internal/config"
+	"miniflux.app/v2/internal/urllib"
 )
 
 // List of supported schedulers.
@@ -41,6 +44,7 @@ type Feed struct {
 	KeeplistRules               string    `json:"keeplist_rules"`
 	BlockFilterEntryRules       string    `json:"block_filter_entry_rules"`
 	KeepFilterEntryRules        string    `json:"keep_filter_entry_rules"`
+	ActionEntryRules            string    `json:"action_entry_rules"`
 	UrlRewriteRules             string    `json:"urlrewrite_rules"`
 	UserAgent                   string    `json:"user_agent"`
 	Cookie                      string    `json:"cookie"`
@@ -57,12 +61,27 @@ type Feed struct {
 	NtfyEnabled                 bool      `json:"ntfy_enabled"`
 	Crawler                     bool      `json:"crawler"`
 	IgnoreEntryUpdates          bool      `json:"ignore_entry_updates"`
+	MarkUnreadOnChangeThreshold int       `json:"mark_unread_on_change_threshold"`
 	AppriseServiceURLs          string    `json:"apprise_service_urls"`
 	WebhookURL                  string    `json:"webhook_url"`
 	NtfyPriority                int       `json:"ntfy_priority"`
 	NtfyTopic                   string    `json:"ntfy_topic"`
 	PushoverPriority            int       `json:"pushover_priority"`
 	ProxyURL                    string    `json:"proxy_url"`
+	UnsubscribeURL              string    `json:"unsubscribe_url,omitempty"`
+
+	// Selectors used to generate the feed from a web page that does not provide one.
+	PageFeedSelectors
+
+	// Scheduling hints declared by the feed (persisted in the database but not exposed in the API).
+	// Hours and days are expressed in UTC, days start at 0 for Sunday like time.Weekday.
+	SkipHours    []int64       `json:"-"`
+	SkipDays     []int64       `json:"-"`
+	UpdatePeriod time.Duration `json:"-"`
+
+	// Permanent redirect observed during the last consecutive refreshes (persisted in the database but not exposed in the API).
+	PermanentRedirectURL   string `json:"-"`
+	PermanentRedirectCount int    `json:"-"`
 
 	// Non-persisted attributes
 	Category *Category `json:"category,omitempty"`
@@ -72,6 +91,8 @@ type Feed struct {
 	// Internal attributes (not exposed in the API and not persisted in the database)
 	TTL                    time.Duration `json:"-"`
 	IconURL                string        `json:"-"`
+	HubURL                 string        `json:"-"`
+	SelfURL                string        `json:"-"`
 	UnreadCount            int           `json:"-"`
 	ReadCount              int           `json:"-"`
 	NumberOfVisibleEntries int           `json:"-"`
@@ -93,6 +114,11 @@ func (f *Feed) String() string {
 	)
 }
 
+// IsNewsletter returns true if the feed receives its entries by email instead of being fetched.
+func (f *Feed) IsNewsletter() bool {
+	return strings.HasPrefix(f.FeedURL, "mailto:")
+}
+
 // WithCategoryID initializes the category attribute of the feed.
 func (f *Feed) WithCategoryID(categoryID int64) {
 	f.Category = &Category{ID: categoryID}
@@ -119,6 +145,46 @@ func (f *Feed) CheckedNow() {
 	}
 }
 
+// TrackPermanentRedirect counts the consecutive refreshes permanently redirected to redirectURL.
+// It returns true once the feed has been redirected to the same URL at least limit times in a row.
+// An empty redirectURL resets the counter, and a zero limit never reports the redirect.
+func (f *Feed) TrackPermanentRedirect(redirectURL string, limit int) bool {
+	if redirectURL == "" || redirectURL == f.FeedURL {
+		f.PermanentRedirectURL = ""
+		f.PermanentRedirectCount = 0
+		return false
+	}
+
+	if f.PermanentRedirectURL == redirectURL {
+		f.PermanentRedirectCount++
+	} else {
+		f.PermanentRedirectURL = redirectURL
+		f.PermanentRedirectCount = 1
+	}
+
+	return limit > 0 && f.PermanentRedirectCount >= limit
+}
+
+// FollowPermanentRedirect replaces the feed URL with the URL the feed has been permanently redirected to.
+// The credentials and the cookie were given for the previous host, they are removed when the host changes.
+// It returns true if the credentials have been removed.
+func (f *Feed) FollowPermanentRedirect(redirectURL string) bool {
+	previousHost := urllib.Domain(f.FeedURL)
+
+	f.FeedURL = redirectURL
+	f.PermanentRedirectURL = ""
+	f.PermanentRedirectCount = 0
+
+	if strings.EqualFold(previousHost, urllib.Domain(redirectURL)) || (f.Username == "" && f.Password == "" && f.Cookie == "") {
+		return false
+	}
+
+	f.Username = ""
+	f.Password = ""
+	f.Cookie = ""
+	return true
+}
+
 // ScheduleNextCheck set "next_check_at" of a feed based on the scheduler selected from the configuration.
 func (f *Feed) ScheduleNextCheck(weeklyCount int, refreshDelay time.Duration) time.Duration {
 	// Default to the global config Polling Frequency.
@@ -137,6 +203,9 @@ func (f *Feed) ScheduleNextCheck(weeklyCount int, refreshDelay time.Duration) ti
 	// Use the RSS TTL field, Retry-After, Cache-Control or Expires HTTP headers if defined.
 	interval = max(interval, refreshDelay)
 
+	// Use the update period declared with the RSS Syndication module if defined.
+	interval = max(interval, f.UpdatePeriod)
+
 	// Limit the max interval value for misconfigured feeds.
 	switch config.Opts.PollingScheduler() {
 	case SchedulerRoundRobin:
@@ -145,8 +214,33 @@ func (f *Feed) ScheduleNextCheck(weeklyCount int, refreshDelay time.Duration) ti
 		interval = min(interval, config.Opts.SchedulerEntryFrequencyMaxInterval())
 	}
 
-	f.NextCheckAt = time.Now().Add(interval)
-	return interval
+	// Postpone the check outside of the RSS skipHours and skipDays.
+	now := time.Now()
+	f.NextCheckAt = f.nextAllowedCheck(now.Add(interval))
+	return f.NextCheckAt.Sub(now)
+}
+
+// nextAllowedCheck returns the given date, or the beginning of the first following hour
+// that is not excluded by the skipHours and skipDays hints. Per the RSS specification, they are expressed in GMT.
+// Hints excluding every hour or every day are ignored.
+func (f *Feed) nextAllowedCheck(nextCheckAt time.Time) time.Time {
+	hasSkipHours := len(f.SkipHours) > 0 && len(f.SkipHours) < 24
+	hasSkipDays := len(f.SkipDays) > 0 && len(f.SkipDays) < 7
+	if !hasSkipHours && !hasSkipDays {
+		return nextCheckAt
+	}
+
+	candidate := nextCheckAt.UTC()
+	for range 7 * 24 {
+		skippedHour := hasSkipHours && slices.Contains(f.SkipHours, int64(candidate.Hour()))
+		skippedDay := hasSkipDays && slices.Contains(f.SkipDays, int64(candidate.Weekday()))
+		if !skippedHour && !skippedDay {
+			return candidate.In(nextCheckAt.Location())
+		}
+		candidate = candidate.Truncate(time.Hour).Add(time.Hour)
+	}
+
+	return nextCheckAt
 }
 
 // FeedCreationRequest represents the request to create a feed.
@@ -174,6 +268,8 @@ type FeedCreationRequest struct {
 	KeepFilterEntryRules        string `json:"keep_filter_entry_rules"`
 	UrlRewriteRules             string `json:"urlrewrite_rules"`
 	ProxyURL                    string `json:"proxy_url"`
+
+	PageFeedSelectors
 }
 
 type FeedCreationRequestFromSubscriptionDiscovery struct {
@@ -197,8 +293,10 @@ type FeedModificationRequest struct {
 	KeeplistRules               *string `json:"keeplist_rules"`
 	BlockFilterEntryRules       *string `json:"block_filter_entry_rules"`
 	KeepFilterEntryRules        *string `json:"keep_filter_entry_rules"`
+	ActionEntryRules            *string `json:"action_entry_rules"`
 	Crawler                     *bool   `json:"crawler"`
 	IgnoreEntryUpdates          *bool   `json:"ignore_entry_updates"`
+	MarkUnreadOnChangeThreshold *int    `json:"mark_unread_on_change_threshold"`
 	UserAgent                   *string `json:"user_agent"`
 	Cookie                      *string `json:"cookie"`
 	Username                    *string `json:"username"`
@@ -212,6 +310,11 @@ type FeedModificationRequest struct {
 	HideGlobally                *bool   `json:"hide_globally"`
 	DisableHTTP2                *bool   `json:"disable_http2"`
 	ProxyURL                    *string `json:"proxy_url"`
+	PageItemSelector            *string `json:"page_item_selector"`
+	PageTitleSelector           *string `json:"page_title_selector"`
+	PageLinkSelector            *string `json:"page_link_selector"`
+	PageDateSelector            *string `json:"page_date_selector"`
+	PageContentSelector         *string `json:"page_content_selector"`
 }
 
 // Patch updates a feed with modified values.
@@ -260,6 +363,10 @@ func (f *FeedModificationRequest) Patch(feed *Feed) {
 		feed.KeepFilterEntryRules = *f.KeepFilterEntryRules
 	}
 
+	if f.ActionEntryRules != nil {
+		feed.ActionEntryRules = *f.ActionEntryRules
+	}
+
 	if f.Crawler != nil {
 		feed.Crawler = *f.Crawler
 	}
@@ -268,6 +375,10 @@ func (f *FeedModificationRequest) Patch(feed *Feed) {
 		feed.IgnoreEntryUpdates = *f.IgnoreEntryUpdates
 	}
 
+	if f.MarkUnreadOnChangeThreshold != nil {
+		feed.MarkUnreadOnChangeThreshold = *f.MarkUnreadOnChangeThreshold
+	}
+
 	if f.UserAgent != nil {
 		feed.UserAgent = *f.UserAgent
 	}
@@ -319,6 +430,42 @@ func (f *FeedModificationRequest) Patch(feed *Feed) {
 	if f.ProxyURL != nil {
 		feed.ProxyURL = *f.ProxyURL
 	}
+
+	if f.PageItemSelector != nil {
+		feed.PageItemSelector = *f.PageItemSelector
+	}
+
+	if f.PageTitleSelector != nil {
+		feed.PageTitleSelector = *f.PageTitleSelector
+	}
+
+	if f.PageLinkSelector != nil {
+		feed.PageLinkSelector = *f.PageLinkSelector
+	}
+
+	if f.PageDateSelector != nil {
+		feed.PageDateSelector = *f.PageDateSelector
+	}
+
+	if f.PageContentSelector != nil {
+		feed.PageContentSelector = *f.PageContentSelector
+	}
+}
+
+// PageFeedSelectors represents the CSS selectors used to generate a feed from a web page.
+// The page is parsed as a feed only when the item selector is defined,
+// the other selectors are evaluated inside each item.
+type PageFeedSelectors struct {
+	PageItemSelector    string `json:"page_item_selector"`
+	PageTitleSelector   string `json:"page_title_selector"`
+	PageLinkSelector    string `json:"page_link_selector"`
+	PageDateSelector    string `json:"page_date_selector"`
+	PageContentSelector string `json:"page_content_selector"`
+}
+
+// IsPageFeed returns true if the feed is generated from a web page.
+func (p *PageFeedSelectors) IsPageFeed() bool {
+	return p.PageItemSelector != ""
 }
 
 // Feeds is a list of feed
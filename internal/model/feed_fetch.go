@@ -0,0 +1,28 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package model // import "miniflux.app/v2/internal/model"
+
+import (
+	"time"
+)
+
+// FeedFetch represents a refresh attempt of a feed.
+type FeedFetch struct {
+	ID                  int64     `json:"id"`
+	UserID              int64     `json:"user_id"`
+	FeedID              int64     `json:"feed_id"`
+	FetchedAt           time.Time `json:"fetched_at"`
+	StatusCode          int       `json:"status_code"`
+	Duration            int64     `json:"duration"` // In milliseconds.
+	ResponseSize        int64     `json:"response_size"`
+	CacheHit            bool      `json:"cache_hit"`
+	NewEntriesCount     int       `json:"new_entries_count"`
+	UpdatedEntriesCount int       `json:"updated_entries_count"`
+	ErrorMsg            string    `json:"error_message"`
+	RedirectURL         string    `json:"redirect_url"`     // Target of a permanent redirect, if any.
+	FeedURLUpdated      bool      `json:"feed_url_updated"` // True if the feed URL has been replaced by RedirectURL.
+}
+
+// FeedFetches represents a list of feed refresh attempts.
+type FeedFetches []*FeedFetch
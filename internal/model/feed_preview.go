@@ -0,0 +1,82 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package model // import "miniflux.app/v2/internal/model"
+
+// Default and maximum number of entries processed by a feed preview.
+const (
+	DefaultFeedPreviewLimit = 3
+	MaxFeedPreviewLimit     = 10
+)
+
+// FeedPreviewRequest represents a request to try candidate rules on the latest entries of a feed.
+// Rules left empty in the request fall back to the rules saved on the feed.
+type FeedPreviewRequest struct {
+	ScraperRules    *string `json:"scraper_rules"`
+	RewriteRules    *string `json:"rewrite_rules"`
+	UrlRewriteRules *string `json:"urlrewrite_rules"`
+	Crawler         *bool   `json:"crawler"`
+	Limit           int     `json:"limit"`
+}
+
+// Patch applies the candidate rules to a copy of the feed.
+func (f *FeedPreviewRequest) Patch(feed *Feed) {
+	if f.ScraperRules != nil {
+		feed.ScraperRules = *f.ScraperRules
+	}
+
+	if f.RewriteRules != nil {
+		feed.RewriteRules = *f.RewriteRules
+	}
+
+	if f.UrlRewriteRules != nil {
+		feed.UrlRewriteRules = *f.UrlRewriteRules
+	}
+
+	if f.Crawler != nil {
+		feed.Crawler = *f.Crawler
+	}
+}
+
+// EntryLimit returns the number of entries to preview.
+func (f *FeedPreviewRequest) EntryLimit() int {
+	switch {
+	case f.Limit <= 0:
+		return DefaultFeedPreviewLimit
+	case f.Limit > MaxFeedPreviewLimit:
+		return MaxFeedPreviewLimit
+	default:
+		return f.Limit
+	}
+}
+
+// FeedPreview is the result of the rules applied to the latest entries of a feed. Nothing is persisted.
+type FeedPreview struct {
+	Errors  []*FeedRuleError `json:"errors"`
+	Entries []*EntryPreview  `json:"entries"`
+}
+
+// HasErrors returns true if at least one rule is invalid.
+func (f *FeedPreview) HasErrors() bool {
+	return len(f.Errors) > 0
+}
+
+// FeedRuleError describes an invalid rule, the field is the name of the feed attribute holding the rule.
+type FeedRuleError struct {
+	Field string `json:"field"`
+	Error string `json:"error"`
+}
+
+// EntryPreview shows an entry before and after applying the rules.
+type EntryPreview struct {
+	Before EntryPreviewContent `json:"before"`
+	After  EntryPreviewContent `json:"after"`
+	Error  string              `json:"error,omitempty"`
+}
+
+// EntryPreviewContent holds the fields modified by the rules.
+type EntryPreviewContent struct {
+	URL     string `json:"url"`
+	Title   string `json:"title"`
+	Content string `json:"content"`
+}
@@ -0,0 +1,42 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package model // import "miniflux.app/v2/internal/model"
+
+import "testing"
+
+func TestFeedPreviewRequestEntryLimit(t *testing.T) {
+	scenarios := map[int]int{
+		-1:  DefaultFeedPreviewLimit,
+		0:   DefaultFeedPreviewLimit,
+		5:   5,
+		100: MaxFeedPreviewLimit,
+	}
+
+	for limit, expected := range scenarios {
+		request := &FeedPreviewRequest{Limit: limit}
+		if result := request.EntryLimit(); result != expected {
+			t.Errorf(`Unexpected limit for %d, got %d instead of %d`, limit, result, expected)
+		}
+	}
+}
+
+func TestFeedPreviewRequestPatch(t *testing.T) {
+	rewriteRules := "nl2br"
+	crawler := true
+	request := &FeedPreviewRequest{RewriteRules: &rewriteRules, Crawler: &crawler}
+	feed := &Feed{ScraperRules: "article", RewriteRules: "add_image_title"}
+	request.Patch(feed)
+
+	if feed.RewriteRules != rewriteRules {
+		t.Errorf(`Unexpected rewrite rules, got %q`, feed.RewriteRules)
+	}
+
+	if feed.ScraperRules != "article" {
+		t.Errorf(`The scraper rules should not be modified, got %q`, feed.ScraperRules)
+	}
+
+	if !feed.Crawler {
+		t.Error(`The crawler should be enabled`)
+	}
+}
@@ -67,6 +67,48 @@ func TestFeedCheckedNow(t *testing.T) {
 	}
 }
 
+func TestFeedTrackPermanentRedirect(t *testing.T) {
+	feed := &Feed{FeedURL: "https://example.org/feed"}
+
+	if feed.TrackPermanentRedirect("https://example.org/new-feed", 2) {
+		t.Error(`The limit should not be reached after the first redirect`)
+	}
+
+	if !feed.TrackPermanentRedirect("https://example.org/new-feed", 2) {
+		t.Error(`The limit should be reached after the second consecutive redirect`)
+	}
+
+	if feed.TrackPermanentRedirect("https://example.com/other-feed", 2) {
+		t.Error(`A redirect to another URL should restart the counter`)
+	}
+
+	if feed.PermanentRedirectURL != "https://example.com/other-feed" || feed.PermanentRedirectCount != 1 {
+		t.Errorf(`Unexpected redirect state: %q (%d)`, feed.PermanentRedirectURL, feed.PermanentRedirectCount)
+	}
+
+	if feed.TrackPermanentRedirect("", 2) {
+		t.Error(`A refresh without redirect should not reach the limit`)
+	}
+
+	if feed.PermanentRedirectURL != "" || feed.PermanentRedirectCount != 0 {
+		t.Errorf(`A refresh without redirect should reset the counter, got %q (%d)`, feed.PermanentRedirectURL, feed.PermanentRedirectCount)
+	}
+}
+
+func TestFeedTrackPermanentRedirectWithoutLimit(t *testing.T) {
+	feed := &Feed{FeedURL: "https://example.org/feed"}
+
+	for range 10 {
+		if feed.TrackPermanentRedirect("https://example.org/new-feed", 0) {
+			t.Fatal(`The limit should never be reached when it is disabled`)
+		}
+	}
+
+	if feed.PermanentRedirectCount != 10 {
+		t.Errorf(`Expected 10 consecutive redirects, got %d`, feed.PermanentRedirectCount)
+	}
+}
+
 func checkTargetInterval(t *testing.T, feed *Feed, targetInterval time.Duration, timeBefore time.Time, message string) {
 	if feed.NextCheckAt.Before(timeBefore.Add(targetInterval)) {
 		t.Errorf(`The next_check_at should be after timeBefore + %s`, message)
@@ -376,3 +418,134 @@ func TestFeedScheduleNextCheckEntryFrequencyLargeNewTTL(t *testing.T) {
 		t.Error(`The next_check_at should be after timeBefore + entry frequency min interval`)
 	}
 }
+
+func TestFeedScheduleNextCheckWithUpdatePeriodAboveMinInterval(t *testing.T) {
+	os.Clearenv()
+
+	var err error
+	parser := config.NewConfigParser()
+	config.Opts, err = parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	timeBefore := time.Now()
+	feed := &Feed{UpdatePeriod: config.Opts.SchedulerRoundRobinMinInterval() + time.Hour}
+	feed.ScheduleNextCheck(0, noRefreshDelay)
+
+	expectedInterval := config.Opts.SchedulerRoundRobinMinInterval() + time.Hour
+	checkTargetInterval(t, feed, expectedInterval, timeBefore, "TestFeedScheduleNextCheckWithUpdatePeriodAboveMinInterval")
+}
+
+func TestFeedScheduleNextCheckWithUpdatePeriodAboveMaxInterval(t *testing.T) {
+	os.Clearenv()
+
+	var err error
+	parser := config.NewConfigParser()
+	config.Opts, err = parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	timeBefore := time.Now()
+	feed := &Feed{UpdatePeriod: config.Opts.SchedulerRoundRobinMaxInterval() + time.Hour}
+	feed.ScheduleNextCheck(0, noRefreshDelay)
+
+	expectedInterval := config.Opts.SchedulerRoundRobinMaxInterval()
+	checkTargetInterval(t, feed, expectedInterval, timeBefore, "TestFeedScheduleNextCheckWithUpdatePeriodAboveMaxInterval")
+}
+
+func TestFeedNextAllowedCheckWithSkipHours(t *testing.T) {
+	feed := &Feed{SkipHours: []int64{22, 23, 0, 1}}
+
+	// Wednesday 22:30 UTC.
+	nextCheckAt := time.Date(2024, time.January, 3, 22, 30, 0, 0, time.UTC)
+	expected := time.Date(2024, time.January, 4, 2, 0, 0, 0, time.UTC)
+
+	if result := feed.nextAllowedCheck(nextCheckAt); !result.Equal(expected) {
+		t.Errorf(`Unexpected next check date: got %v instead of %v`, result, expected)
+	}
+
+	nextCheckAt = time.Date(2024, time.January, 3, 12, 30, 0, 0, time.UTC)
+	if result := feed.nextAllowedCheck(nextCheckAt); !result.Equal(nextCheckAt) {
+		t.Errorf(`The next check date should not be postponed: got %v`, result)
+	}
+}
+
+func TestFeedNextAllowedCheckWithSkipDays(t *testing.T) {
+	feed := &Feed{SkipDays: []int64{int64(time.Saturday), int64(time.Sunday)}}
+
+	// Saturday 10:15 UTC.
+	nextCheckAt := time.Date(2024, time.January, 6, 10, 15, 0, 0, time.UTC)
+	expected := time.Date(2024, time.January, 8, 0, 0, 0, 0, time.UTC)
+
+	if result := feed.nextAllowedCheck(nextCheckAt); !result.Equal(expected) {
+		t.Errorf(`Unexpected next check date: got %v instead of %v`, result, expected)
+	}
+}
+
+func TestFeedNextAllowedCheckUsesUTC(t *testing.T) {
+	feed := &Feed{SkipHours: []int64{10}}
+
+	location := time.FixedZone("UTC+2", 2*60*60)
+	nextCheckAt := time.Date(2024, time.January, 3, 12, 30, 0, 0, location)
+	expected := time.Date(2024, time.January, 3, 13, 0, 0, 0, location)
+
+	result := feed.nextAllowedCheck(nextCheckAt)
+	if !result.Equal(expected) {
+		t.Errorf(`Unexpected next check date: got %v instead of %v`, result, expected)
+	}
+
+	if result.Location() != location {
+		t.Errorf(`The next check date should keep its original location, got %v`, result.Location())
+	}
+}
+
+func TestFeedNextAllowedCheckIgnoresHintsSkippingEverything(t *testing.T) {
+	allHours := make([]int64, 24)
+	for i := range allHours {
+		allHours[i] = int64(i)
+	}
+
+	feed := &Feed{SkipHours: allHours, SkipDays: []int64{0, 1, 2, 3, 4, 5, 6}}
+	nextCheckAt := time.Date(2024, time.January, 3, 12, 30, 0, 0, time.UTC)
+
+	if result := feed.nextAllowedCheck(nextCheckAt); !result.Equal(nextCheckAt) {
+		t.Errorf(`The next check date should not be postponed: got %v`, result)
+	}
+}
+
+func TestFeedFollowPermanentRedirect(t *testing.T) {
+	feed := &Feed{
+		FeedURL:                "https://example.org/feed",
+		Username:               "user",
+		Password:               "secret",
+		Cookie:                 "session=abc",
+		PermanentRedirectURL:   "https://example.org/new-feed",
+		PermanentRedirectCount: 3,
+	}
+
+	if feed.FollowPermanentRedirect("https://EXAMPLE.org/new-feed") {
+		t.Error(`The credentials should be kept when the host does not change`)
+	}
+
+	if feed.FeedURL != "https://EXAMPLE.org/new-feed" || feed.PermanentRedirectURL != "" || feed.PermanentRedirectCount != 0 {
+		t.Errorf(`Unexpected feed after the redirect: %+v`, feed)
+	}
+
+	if feed.Username != "user" || feed.Password != "secret" || feed.Cookie != "session=abc" {
+		t.Error(`The credentials should be kept when the host does not change`)
+	}
+
+	if !feed.FollowPermanentRedirect("https://feeds.example.com/feed") {
+		t.Error(`The credentials should be removed when the host changes`)
+	}
+
+	if feed.FeedURL != "https://feeds.example.com/feed" || feed.Username != "" || feed.Password != "" || feed.Cookie != "" {
+		t.Errorf(`Unexpected feed after the redirect to another host: %+v`, feed)
+	}
+
+	if feed.FollowPermanentRedirect("https://other.example.com/feed") {
+		t.Error(`A feed without credentials should not report their removal`)
+	}
+}
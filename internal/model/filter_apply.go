@@ -0,0 +1,70 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package model // import "miniflux.app/v2/internal/model"
+
+import "time"
+
+// Outcomes applied to stored entries blocked by the filter rules.
+const (
+	// FilterOutcomeRead marks the blocked entries as read.
+	FilterOutcomeRead = "read"
+
+	// FilterOutcomeRemove deletes the blocked entries and records tombstones so they are never fetched again.
+	FilterOutcomeRemove = "remove"
+
+	// FilterOutcomeDelete deletes the blocked entries, they come back if they are still in the feed
+	// and no longer blocked when the feed is refreshed.
+	FilterOutcomeDelete = "delete"
+)
+
+// FilterOutcomes returns the list of outcomes that can be applied to blocked entries.
+func FilterOutcomes() []string {
+	return []string{FilterOutcomeRead, FilterOutcomeRemove, FilterOutcomeDelete}
+}
+
+// FilterApplyRequest represents a request to apply the saved filter rules to stored entries.
+// The scope is a feed, a category, or the whole account when both IDs are zero.
+// Starred entries are never removed nor deleted.
+type FilterApplyRequest struct {
+	FeedID     int64  `json:"feed_id"`
+	CategoryID int64  `json:"category_id"`
+	Outcome    string `json:"outcome"`
+}
+
+// FilterApplyResult is the number of entries evaluated and the number of entries blocked by the filter rules.
+type FilterApplyResult struct {
+	EntriesCount int `json:"entries_count"`
+	BlockedCount int `json:"blocked_count"`
+}
+
+// Status of the jobs applying the filter rules to stored entries.
+const (
+	FilterApplyJobRunning = "running"
+	FilterApplyJobDone    = "done"
+	FilterApplyJobFailed  = "failed"
+)
+
+// FilterApplyJob tracks the filter rules applied in the background, the counters are updated after each batch of entries.
+type FilterApplyJob struct {
+	ID         int64  `json:"id"`
+	UserID     int64  `json:"user_id"`
+	FeedID     int64  `json:"feed_id"`
+	CategoryID int64  `json:"category_id"`
+	Outcome    string `json:"outcome"`
+	Status     string `json:"status"`
+	FilterApplyResult
+	Error      string     `json:"error,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+	FinishedAt *time.Time `json:"finished_at"`
+}
+
+// IsRunning returns true if the filter rules are still being applied.
+func (j *FilterApplyJob) IsRunning() bool {
+	return j.Status == FilterApplyJobRunning
+}
+
+// IsFailed returns true if the job stopped before all the entries were evaluated.
+func (j *FilterApplyJob) IsFailed() bool {
+	return j.Status == FilterApplyJobFailed
+}
@@ -0,0 +1,29 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package model // import "miniflux.app/v2/internal/model"
+
+import "time"
+
+// FilterRuleStat represents the number of entries matched by a filter rule and the time of the last match.
+// FeedID is zero for the rules defined in the user settings.
+type FilterRuleStat struct {
+	UserID        int64     `json:"user_id"`
+	FeedID        int64     `json:"feed_id,omitempty"`
+	Rule          string    `json:"rule"`
+	HitCount      int64     `json:"hit_count"`
+	LastMatchedAt time.Time `json:"last_matched_at"`
+}
+
+// FilterRuleStats represents a list of filter rule statistics.
+type FilterRuleStats []*FilterRuleStat
+
+// Find returns the statistics of the rule for the given feed, or nil if the rule never matched.
+func (s FilterRuleStats) Find(feedID int64, rule string) *FilterRuleStat {
+	for _, stat := range s {
+		if stat.FeedID == feedID && stat.Rule == rule {
+			return stat
+		}
+	}
+	return nil
+}
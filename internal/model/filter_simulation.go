@@ -0,0 +1,93 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package model // import "miniflux.app/v2/internal/model"
+
+// Default and maximum number of stored entries evaluated by a filter simulation.
+const (
+	DefaultFilterSimulationLimit = 1000
+	MaxFilterSimulationLimit     = 10000
+)
+
+// FilterSimulationRequest represents candidate filter rules to evaluate against stored entries.
+// When a feed ID is given, the rules are evaluated as feed rules on the entries of this feed,
+// otherwise they are evaluated as user rules on all the entries of the user.
+type FilterSimulationRequest struct {
+	FeedID                int64   `json:"feed_id"`
+	BlockFilterEntryRules *string `json:"block_filter_entry_rules"`
+	KeepFilterEntryRules  *string `json:"keep_filter_entry_rules"`
+	Limit                 int     `json:"limit"`
+}
+
+// EntryLimit returns the number of entries to evaluate.
+func (f *FilterSimulationRequest) EntryLimit() int {
+	switch {
+	case f.Limit <= 0:
+		return DefaultFilterSimulationLimit
+	case f.Limit > MaxFilterSimulationLimit:
+		return MaxFilterSimulationLimit
+	default:
+		return f.Limit
+	}
+}
+
+// BlockRules returns the candidate block rules, or the saved rules when they are not part of the request.
+func (f *FilterSimulationRequest) BlockRules(savedRules string) string {
+	if f.BlockFilterEntryRules != nil {
+		return *f.BlockFilterEntryRules
+	}
+	return savedRules
+}
+
+// KeepRules returns the candidate keep rules, or the saved rules when they are not part of the request.
+func (f *FilterSimulationRequest) KeepRules(savedRules string) string {
+	if f.KeepFilterEntryRules != nil {
+		return *f.KeepFilterEntryRules
+	}
+	return savedRules
+}
+
+// FilterSimulation is the result of filter rules evaluated against stored entries. Entries are never modified.
+type FilterSimulation struct {
+	EntriesCount   int                      `json:"entries_count"`
+	BlockedCount   int                      `json:"blocked_count"`
+	NotKeptCount   int                      `json:"not_kept_count"`
+	NotKeptEntries []*FilterSimulationEntry `json:"not_kept_entries"`
+	Rules          []*FilterRuleSimulation  `json:"rules"`
+	Errors         []*FilterRuleError       `json:"errors"`
+}
+
+// HasErrors returns true if at least one rule is invalid.
+func (f *FilterSimulation) HasErrors() bool {
+	return len(f.Errors) > 0
+}
+
+// FilterRuleSimulation groups the entries matched by a single rule line.
+// The first match count is the number of entries for which this rule is the one that decided.
+type FilterRuleSimulation struct {
+	Source          string                   `json:"source"`
+	Kind            string                   `json:"kind"`
+	Line            int                      `json:"line"`
+	Rule            string                   `json:"rule"`
+	MatchCount      int                      `json:"match_count"`
+	FirstMatchCount int                      `json:"first_match_count"`
+	Entries         []*FilterSimulationEntry `json:"entries"`
+}
+
+// FilterSimulationEntry is a sample entry matched by a rule.
+type FilterSimulationEntry struct {
+	EntryID    int64  `json:"entry_id"`
+	FeedID     int64  `json:"feed_id"`
+	Title      string `json:"title"`
+	URL        string `json:"url"`
+	FirstMatch bool   `json:"first_match"`
+}
+
+// FilterRuleError describes an invalid filter rule line.
+type FilterRuleError struct {
+	Source string `json:"source"`
+	Kind   string `json:"kind"`
+	Line   int    `json:"line"`
+	Rule   string `json:"rule"`
+	Error  string `json:"error"`
+}
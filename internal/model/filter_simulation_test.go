@@ -0,0 +1,23 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package model // import "miniflux.app/v2/internal/model"
+
+import "testing"
+
+func TestFilterSimulationRequestRules(t *testing.T) {
+	candidateRules := ""
+	request := &FilterSimulationRequest{BlockFilterEntryRules: &candidateRules}
+
+	if rules := request.BlockRules("EntryTitle=saved"); rules != "" {
+		t.Errorf(`The candidate rules should be used even when empty, got %q`, rules)
+	}
+
+	if rules := request.KeepRules("EntryTitle=saved"); rules != "EntryTitle=saved" {
+		t.Errorf(`The saved rules should be used when no candidate rules are given, got %q`, rules)
+	}
+
+	if limit := request.EntryLimit(); limit != DefaultFilterSimulationLimit {
+		t.Errorf(`Unexpected default limit, got %d`, limit)
+	}
+}
@@ -0,0 +1,110 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package model // import "miniflux.app/v2/internal/model"
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Highlight represents a passage of an entry highlighted by the user, with an optional note.
+//
+// The passage is anchored by the quoted text and a few characters around it,
+// the offsets are the position of the quote in the text content of the entry.
+type Highlight struct {
+	ID          int64     `json:"id"`
+	UserID      int64     `json:"user_id"`
+	EntryID     int64     `json:"entry_id"`
+	Quote       string    `json:"quote"`
+	Prefix      string    `json:"prefix"`
+	Suffix      string    `json:"suffix"`
+	StartOffset int       `json:"start_offset"`
+	EndOffset   int       `json:"end_offset"`
+	Note        string    `json:"note"`
+	CreatedAt   time.Time `json:"created_at"`
+	ChangedAt   time.Time `json:"changed_at"`
+	// The entry and feed are only set when listing the highlights across entries.
+	EntryTitle string `json:"entry_title,omitempty"`
+	EntryURL   string `json:"entry_url,omitempty"`
+	FeedID     int64  `json:"feed_id,omitempty"`
+	FeedTitle  string `json:"feed_title,omitempty"`
+}
+
+func (h *Highlight) String() string {
+	return fmt.Sprintf("ID=%d, UserID=%d, EntryID=%d, Offsets=%d-%d", h.ID, h.UserID, h.EntryID, h.StartOffset, h.EndOffset)
+}
+
+type HighlightCreationRequest struct {
+	Quote       string `json:"quote"`
+	Prefix      string `json:"prefix"`
+	Suffix      string `json:"suffix"`
+	StartOffset int    `json:"start_offset"`
+	EndOffset   int    `json:"end_offset"`
+	Note        string `json:"note"`
+}
+
+type HighlightModificationRequest struct {
+	Note *string `json:"note"`
+}
+
+func (h *HighlightModificationRequest) Patch(highlight *Highlight) {
+	if h.Note != nil {
+		highlight.Note = *h.Note
+	}
+}
+
+// Highlights represents a list of highlights.
+type Highlights []*Highlight
+
+// HighlightsResponse represents the response of the highlight list endpoint.
+type HighlightsResponse struct {
+	Total      int        `json:"total"`
+	Highlights Highlights `json:"highlights"`
+}
+
+// ExportMarkdown returns the highlights as a Markdown document, grouped by entry.
+// The entries are listed in the order of their first highlight.
+func (h Highlights) ExportMarkdown() string {
+	var entryIDs []int64
+	highlightsByEntry := make(map[int64]Highlights)
+	for _, highlight := range h {
+		if _, found := highlightsByEntry[highlight.EntryID]; !found {
+			entryIDs = append(entryIDs, highlight.EntryID)
+		}
+		highlightsByEntry[highlight.EntryID] = append(highlightsByEntry[highlight.EntryID], highlight)
+	}
+
+	var sb strings.Builder
+	sb.WriteString("# Highlights\n")
+
+	for _, entryID := range entryIDs {
+		entryHighlights := highlightsByEntry[entryID]
+		first := entryHighlights[0]
+
+		sb.WriteString("\n## ")
+		if first.EntryURL != "" {
+			fmt.Fprintf(&sb, "[%s](%s)\n", first.EntryTitle, first.EntryURL)
+		} else {
+			sb.WriteString(first.EntryTitle + "\n")
+		}
+
+		if first.FeedTitle != "" {
+			fmt.Fprintf(&sb, "\n*%s*\n", first.FeedTitle)
+		}
+
+		for _, highlight := range entryHighlights {
+			sb.WriteString("\n")
+			for line := range strings.SplitSeq(strings.TrimSpace(highlight.Quote), "\n") {
+				sb.WriteString(strings.TrimRight("> "+strings.TrimSpace(line), " ") + "\n")
+			}
+
+			if note := strings.TrimSpace(highlight.Note); note != "" {
+				sb.WriteString("\n" + note + "\n")
+			}
+		}
+	}
+
+	return sb.String()
+}
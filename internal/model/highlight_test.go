@@ -0,0 +1,51 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package model // import "miniflux.app/v2/internal/model"
+
+import "testing"
+
+func TestHighlightModificationRequestPatch(t *testing.T) {
+	highlight := &Highlight{ID: 1, Quote: "quote", Note: "Old"}
+
+	(&HighlightModificationRequest{}).Patch(highlight)
+	if highlight.Note != "Old" {
+		t.Errorf(`The note should not change without a new value, got %q`, highlight.Note)
+	}
+
+	note := ""
+	(&HighlightModificationRequest{Note: &note}).Patch(highlight)
+	if highlight.Note != "" {
+		t.Errorf(`The note should be cleared, got %q`, highlight.Note)
+	}
+}
+
+func TestHighlightsExportMarkdown(t *testing.T) {
+	highlights := Highlights{
+		{EntryID: 2, EntryTitle: "Second", EntryURL: "https://example.org/2", FeedTitle: "Example", Quote: "Line 1\n  Line 2", Note: "My note"},
+		{EntryID: 1, EntryTitle: "First", Quote: "Only quote"},
+		{EntryID: 2, EntryTitle: "Second", EntryURL: "https://example.org/2", FeedTitle: "Example", Quote: "Another quote"},
+	}
+
+	expected := `# Highlights
+
+## [Second](https://example.org/2)
+
+*Example*
+
+> Line 1
+> Line 2
+
+My note
+
+> Another quote
+
+## First
+
+> Only quote
+`
+
+	if markdown := highlights.ExportMarkdown(); markdown != expected {
+		t.Errorf(`Unexpected Markdown export, got:\n%s`, markdown)
+	}
+}
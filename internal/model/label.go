@@ -0,0 +1,47 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package model // import "miniflux.app/v2/internal/model"
+
+import "fmt"
+
+// Label represents a label attached to entries by the user, unlike the tags provided by the feed.
+type Label struct {
+	ID     int64  `json:"id"`
+	UserID int64  `json:"user_id"`
+	Title  string `json:"title"`
+	// Pointers are needed to avoid breaking /v1/labels without counts
+	EntryCount  *int `json:"entry_count,omitempty"`
+	TotalUnread *int `json:"total_unread,omitempty"`
+}
+
+func (l *Label) String() string {
+	return fmt.Sprintf("ID=%d, UserID=%d, Title=%s", l.ID, l.UserID, l.Title)
+}
+
+type LabelCreationRequest struct {
+	Title string `json:"title"`
+}
+
+type LabelModificationRequest struct {
+	Title *string `json:"title"`
+}
+
+func (l *LabelModificationRequest) Patch(label *Label) {
+	if l.Title != nil {
+		label.Title = *l.Title
+	}
+}
+
+// Labels represents a list of labels.
+type Labels []*Label
+
+// HasID returns true if the list contains the label.
+func (l Labels) HasID(labelID int64) bool {
+	for _, label := range l {
+		if label.ID == labelID {
+			return true
+		}
+	}
+	return false
+}
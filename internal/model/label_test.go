@@ -0,0 +1,33 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package model // import "miniflux.app/v2/internal/model"
+
+import "testing"
+
+func TestLabelModificationRequestPatch(t *testing.T) {
+	label := &Label{ID: 1, Title: "Old"}
+
+	(&LabelModificationRequest{}).Patch(label)
+	if label.Title != "Old" {
+		t.Errorf(`The title should not change without a new value, got %q`, label.Title)
+	}
+
+	title := "New"
+	(&LabelModificationRequest{Title: &title}).Patch(label)
+	if label.Title != "New" {
+		t.Errorf(`The title should be updated, got %q`, label.Title)
+	}
+}
+
+func TestLabelsHasID(t *testing.T) {
+	labels := Labels{{ID: 1}, {ID: 3}}
+
+	if !labels.HasID(3) {
+		t.Error(`The label #3 should be found`)
+	}
+
+	if labels.HasID(2) {
+		t.Error(`The label #2 should not be found`)
+	}
+}
@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package model // import "miniflux.app/v2/internal/model"
+
+import (
+	"strings"
+	"time"
+)
+
+// NewsletterAddress represents an inbound email address that turns the received newsletters into feed entries.
+// Each sender writing to the address gets its own feed in the chosen category.
+type NewsletterAddress struct {
+	ID             int64      `json:"id"`
+	UserID         int64      `json:"user_id"`
+	CategoryID     int64      `json:"category_id"`
+	Token          string     `json:"token"`
+	Description    string     `json:"description"`
+	AllowedSenders []string   `json:"allowed_senders"`
+	LastReceivedAt *time.Time `json:"last_received_at"`
+	CreatedAt      time.Time  `json:"created_at"`
+}
+
+// Address returns the email address of the newsletter address for the given domain.
+func (n *NewsletterAddress) Address(domain string) string {
+	return n.Token + "@" + domain
+}
+
+// AllowsSender returns true if the given email address is allowed to write to this newsletter address.
+// Allowed senders are either complete email addresses or domain names, an empty list allows everyone.
+func (n *NewsletterAddress) AllowsSender(sender string) bool {
+	if len(n.AllowedSenders) == 0 {
+		return true
+	}
+
+	sender = strings.ToLower(strings.TrimSpace(sender))
+	_, senderDomain, found := strings.Cut(sender, "@")
+	if !found {
+		return false
+	}
+
+	for _, allowedSender := range n.AllowedSenders {
+		allowedSender = strings.ToLower(strings.TrimSpace(allowedSender))
+		if strings.Contains(allowedSender, "@") {
+			if allowedSender == sender {
+				return true
+			}
+		} else if allowedSender == senderDomain || strings.HasSuffix(senderDomain, "."+allowedSender) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// NewsletterAddresses represents a list of newsletter addresses.
+type NewsletterAddresses []*NewsletterAddress
+
+// NewsletterAddressCreationRequest represents the request to create a new newsletter address.
+type NewsletterAddressCreationRequest struct {
+	CategoryID     int64    `json:"category_id"`
+	Description    string   `json:"description"`
+	AllowedSenders []string `json:"allowed_senders"`
+}
+
+// NewsletterImage represents an inline image of a newsletter, referenced in the entry content by its external ID.
+type NewsletterImage struct {
+	ID         int64
+	EntryID    int64
+	ExternalID string
+	MimeType   string
+	Content    []byte
+}
@@ -0,0 +1,39 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package model // import "miniflux.app/v2/internal/model"
+
+import "testing"
+
+func TestNewsletterAddressAllowsSender(t *testing.T) {
+	scenarios := []struct {
+		allowedSenders []string
+		sender         string
+		expected       bool
+	}{
+		{nil, "news@example.org", true},
+		{[]string{"news@example.org"}, "News@Example.org", true},
+		{[]string{"news@example.org"}, "other@example.org", false},
+		{[]string{"example.org"}, "news@example.org", true},
+		{[]string{"example.org"}, "news@mail.example.org", true},
+		{[]string{"example.org"}, "news@badexample.org", false},
+		{[]string{"example.org"}, "invalid", false},
+	}
+
+	for _, scenario := range scenarios {
+		address := &NewsletterAddress{AllowedSenders: scenario.allowedSenders}
+		if result := address.AllowsSender(scenario.sender); result != scenario.expected {
+			t.Errorf(`Unexpected result for sender %q with %v, got %v instead of %v`, scenario.sender, scenario.allowedSenders, result, scenario.expected)
+		}
+	}
+}
+
+func TestFeedIsNewsletter(t *testing.T) {
+	if !(&Feed{FeedURL: "mailto:news@example.org?to=abc%40localhost"}).IsNewsletter() {
+		t.Error(`A mailto feed URL should be a newsletter`)
+	}
+
+	if (&Feed{FeedURL: "https://example.org/feed.xml"}).IsNewsletter() {
+		t.Error(`An HTTP feed URL should not be a newsletter`)
+	}
+}
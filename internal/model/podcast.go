@@ -0,0 +1,119 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package model // import "miniflux.app/v2/internal/model"
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// PodcastMetadata represents the Podcasting 2.0 attributes of an episode.
+// Specs: https://podcasting2.org/docs/podcast-namespace
+type PodcastMetadata struct {
+	Season      *PodcastSeason       `json:"season,omitempty"`
+	Episode     *PodcastEpisode      `json:"episode,omitempty"`
+	Transcripts []*PodcastTranscript `json:"transcripts,omitempty"`
+	ChaptersURL string               `json:"chapters_url,omitempty"`
+	Chapters    []*PodcastChapter    `json:"chapters,omitempty"`
+	Persons     []*PodcastPerson     `json:"persons,omitempty"`
+	Funding     []*PodcastFunding    `json:"funding,omitempty"`
+	Soundbites  []*PodcastSoundbite  `json:"soundbites,omitempty"`
+}
+
+// PodcastSeason represents the season of an episode, the name is optional.
+type PodcastSeason struct {
+	Number int    `json:"number"`
+	Name   string `json:"name,omitempty"`
+}
+
+// PodcastEpisode represents the episode number, the display value is used instead of the number when defined.
+type PodcastEpisode struct {
+	Number  float64 `json:"number"`
+	Display string  `json:"display,omitempty"`
+}
+
+// PodcastTranscript represents a link to the transcript of an episode.
+type PodcastTranscript struct {
+	URL      string `json:"url"`
+	MimeType string `json:"mime_type"`
+	Language string `json:"language,omitempty"`
+	Rel      string `json:"rel,omitempty"`
+}
+
+// PodcastChapter represents a chapter of an episode, the start time is expressed in seconds.
+type PodcastChapter struct {
+	StartTime float64 `json:"start_time"`
+	Title     string  `json:"title,omitempty"`
+	URL       string  `json:"url,omitempty"`
+	ImageURL  string  `json:"image_url,omitempty"`
+}
+
+// PodcastPerson represents a person involved in an episode.
+type PodcastPerson struct {
+	Name     string `json:"name"`
+	Role     string `json:"role,omitempty"`
+	Group    string `json:"group,omitempty"`
+	ImageURL string `json:"image_url,omitempty"`
+	URL      string `json:"url,omitempty"`
+}
+
+// PodcastFunding represents a donation or support link.
+type PodcastFunding struct {
+	URL   string `json:"url"`
+	Title string `json:"title,omitempty"`
+}
+
+// PodcastSoundbite represents a highlight of an episode, times are expressed in seconds.
+type PodcastSoundbite struct {
+	StartTime float64 `json:"start_time"`
+	Duration  float64 `json:"duration"`
+	Title     string  `json:"title,omitempty"`
+}
+
+// IsEmpty returns true if the episode does not have any Podcasting 2.0 attribute.
+func (p *PodcastMetadata) IsEmpty() bool {
+	return p == nil || (p.Season == nil && p.Episode == nil && len(p.Transcripts) == 0 && p.ChaptersURL == "" &&
+		len(p.Chapters) == 0 && len(p.Persons) == 0 && len(p.Funding) == 0 && len(p.Soundbites) == 0)
+}
+
+// FindTextTranscript returns the transcript that is the most suitable to be displayed and indexed as text.
+// Formats with timestamps are preferred to HTML because they are easier to convert to plain text.
+func (p *PodcastMetadata) FindTextTranscript() *PodcastTranscript {
+	if p == nil {
+		return nil
+	}
+
+	preferredTypes := []string{"text/vtt", "application/x-subrip", "application/srt", "text/plain", "text/html"}
+	for _, mimeType := range preferredTypes {
+		for _, transcript := range p.Transcripts {
+			if strings.EqualFold(transcript.MimeType, mimeType) {
+				return transcript
+			}
+		}
+	}
+
+	return nil
+}
+
+// MarshalPodcastMetadata serializes the metadata for the database, empty metadata is stored as NULL.
+func MarshalPodcastMetadata(metadata *PodcastMetadata) ([]byte, error) {
+	if metadata.IsEmpty() {
+		return nil, nil
+	}
+	return json.Marshal(metadata)
+}
+
+// UnmarshalPodcastMetadata deserializes the metadata stored in the database.
+func UnmarshalPodcastMetadata(data []byte) (*PodcastMetadata, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	var metadata PodcastMetadata
+	if err := json.Unmarshal(data, &metadata); err != nil {
+		return nil, err
+	}
+
+	return &metadata, nil
+}
@@ -0,0 +1,58 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package model // import "miniflux.app/v2/internal/model"
+
+import "testing"
+
+func TestPodcastMetadataFindTextTranscript(t *testing.T) {
+	metadata := &PodcastMetadata{
+		Transcripts: []*PodcastTranscript{
+			{URL: "https://example.org/transcript.json", MimeType: "application/json"},
+			{URL: "https://example.org/transcript.html", MimeType: "text/html"},
+			{URL: "https://example.org/transcript.srt", MimeType: "application/x-subrip"},
+		},
+	}
+
+	if transcript := metadata.FindTextTranscript(); transcript == nil || transcript.URL != "https://example.org/transcript.srt" {
+		t.Errorf(`Unexpected transcript: %+v`, transcript)
+	}
+
+	metadata.Transcripts = metadata.Transcripts[:1]
+	if transcript := metadata.FindTextTranscript(); transcript != nil {
+		t.Errorf(`JSON transcripts should be ignored, got %+v`, transcript)
+	}
+
+	var empty *PodcastMetadata
+	if transcript := empty.FindTextTranscript(); transcript != nil {
+		t.Errorf(`No transcript should be returned, got %+v`, transcript)
+	}
+}
+
+func TestPodcastMetadataMarshalling(t *testing.T) {
+	data, err := MarshalPodcastMetadata(&PodcastMetadata{})
+	if err != nil || data != nil {
+		t.Errorf(`Empty metadata should be stored as NULL, got %q (%v)`, data, err)
+	}
+
+	data, err = MarshalPodcastMetadata(&PodcastMetadata{
+		Episode:  &PodcastEpisode{Number: 3},
+		Chapters: []*PodcastChapter{{StartTime: 12.5, Title: "Intro"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	metadata, err := UnmarshalPodcastMetadata(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if metadata.Episode.Number != 3 || len(metadata.Chapters) != 1 || metadata.Chapters[0].StartTime != 12.5 || metadata.Chapters[0].Title != "Intro" {
+		t.Errorf(`Unexpected metadata: %+v`, metadata)
+	}
+
+	if metadata, err := UnmarshalPodcastMetadata(nil); metadata != nil || err != nil {
+		t.Errorf(`NULL values should return nil, got %+v (%v)`, metadata, err)
+	}
+}
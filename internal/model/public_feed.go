@@ -0,0 +1,42 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package model // import "miniflux.app/v2/internal/model"
+
+import (
+	"time"
+)
+
+// Public feed output formats.
+const (
+	PublicFeedFormatRSS  = "rss"
+	PublicFeedFormatAtom = "atom"
+	PublicFeedFormatJSON = "json"
+)
+
+// PublicFeed represents a token-protected feed that republishes a selection of entries.
+// A zero CategoryID and empty Tag or SearchQuery mean that the filter is not applied.
+type PublicFeed struct {
+	ID             int64      `json:"id"`
+	UserID         int64      `json:"user_id"`
+	Token          string     `json:"token"`
+	Title          string     `json:"title"`
+	Starred        bool       `json:"starred"`
+	CategoryID     int64      `json:"category_id"`
+	Tag            string     `json:"tag"`
+	SearchQuery    string     `json:"search_query"`
+	LastAccessedAt *time.Time `json:"last_accessed_at"`
+	CreatedAt      time.Time  `json:"created_at"`
+}
+
+// PublicFeeds represents a list of public feeds.
+type PublicFeeds []*PublicFeed
+
+// PublicFeedCreationRequest represents the request to create a new public feed.
+type PublicFeedCreationRequest struct {
+	Title       string `json:"title"`
+	Starred     bool   `json:"starred"`
+	CategoryID  int64  `json:"category_id"`
+	Tag         string `json:"tag"`
+	SearchQuery string `json:"search_query"`
+}
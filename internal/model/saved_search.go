@@ -0,0 +1,101 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package model // import "miniflux.app/v2/internal/model"
+
+import (
+	"fmt"
+	"time"
+)
+
+// SavedSearch represents a named entry query that behaves like a virtual feed.
+// Empty lists, a false Starred and a zero MaxAgeDays mean that the filter is not applied.
+type SavedSearch struct {
+	ID          int64     `json:"id"`
+	UserID      int64     `json:"user_id"`
+	Title       string    `json:"title"`
+	SearchQuery string    `json:"search_query"`
+	Statuses    []string  `json:"statuses"`
+	Starred     bool      `json:"starred"`
+	CategoryIDs []int64   `json:"category_ids"`
+	FeedIDs     []int64   `json:"feed_ids"`
+	Tags        []string  `json:"tags"`
+	MaxAgeDays  int       `json:"max_age_days"`
+	CreatedAt   time.Time `json:"created_at"`
+	// Pointer is needed to avoid breaking /v1/saved-searches without counts
+	TotalUnread *int `json:"total_unread,omitempty"`
+}
+
+func (s *SavedSearch) String() string {
+	return fmt.Sprintf("ID=%d, UserID=%d, Title=%s", s.ID, s.UserID, s.Title)
+}
+
+// PublishedAfter returns the start of the date window, or the zero time without window.
+func (s *SavedSearch) PublishedAfter(now time.Time) time.Time {
+	if s.MaxAgeDays <= 0 {
+		return time.Time{}
+	}
+	return now.AddDate(0, 0, -s.MaxAgeDays)
+}
+
+// SavedSearches represents a list of saved searches.
+type SavedSearches []*SavedSearch
+
+// SavedSearchCreationRequest represents the request to create a saved search.
+type SavedSearchCreationRequest struct {
+	Title       string   `json:"title"`
+	SearchQuery string   `json:"search_query"`
+	Statuses    []string `json:"statuses"`
+	Starred     bool     `json:"starred"`
+	CategoryIDs []int64  `json:"category_ids"`
+	FeedIDs     []int64  `json:"feed_ids"`
+	Tags        []string `json:"tags"`
+	MaxAgeDays  int      `json:"max_age_days"`
+}
+
+// SavedSearchModificationRequest represents the request to update a saved search.
+type SavedSearchModificationRequest struct {
+	Title       *string   `json:"title"`
+	SearchQuery *string   `json:"search_query"`
+	Statuses    *[]string `json:"statuses"`
+	Starred     *bool     `json:"starred"`
+	CategoryIDs *[]int64  `json:"category_ids"`
+	FeedIDs     *[]int64  `json:"feed_ids"`
+	Tags        *[]string `json:"tags"`
+	MaxAgeDays  *int      `json:"max_age_days"`
+}
+
+// Patch updates the saved search with the fields present in the request.
+func (r *SavedSearchModificationRequest) Patch(savedSearch *SavedSearch) {
+	if r.Title != nil {
+		savedSearch.Title = *r.Title
+	}
+
+	if r.SearchQuery != nil {
+		savedSearch.SearchQuery = *r.SearchQuery
+	}
+
+	if r.Statuses != nil {
+		savedSearch.Statuses = *r.Statuses
+	}
+
+	if r.Starred != nil {
+		savedSearch.Starred = *r.Starred
+	}
+
+	if r.CategoryIDs != nil {
+		savedSearch.CategoryIDs = *r.CategoryIDs
+	}
+
+	if r.FeedIDs != nil {
+		savedSearch.FeedIDs = *r.FeedIDs
+	}
+
+	if r.Tags != nil {
+		savedSearch.Tags = *r.Tags
+	}
+
+	if r.MaxAgeDays != nil {
+		savedSearch.MaxAgeDays = *r.MaxAgeDays
+	}
+}
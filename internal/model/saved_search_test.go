@@ -0,0 +1,55 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package model // import "miniflux.app/v2/internal/model"
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestSavedSearchPublishedAfter(t *testing.T) {
+	now := time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	if publishedAfter := (&SavedSearch{}).PublishedAfter(now); !publishedAfter.IsZero() {
+		t.Errorf(`A saved search without date window should not have a start date, got %v`, publishedAfter)
+	}
+
+	expected := time.Date(2024, 3, 3, 12, 0, 0, 0, time.UTC)
+	if publishedAfter := (&SavedSearch{MaxAgeDays: 7}).PublishedAfter(now); !publishedAfter.Equal(expected) {
+		t.Errorf(`Expected %v, got %v`, expected, publishedAfter)
+	}
+}
+
+func TestSavedSearchModificationRequestPatch(t *testing.T) {
+	savedSearch := &SavedSearch{
+		Title:       "Go",
+		SearchQuery: "golang",
+		Statuses:    []string{EntryStatusUnread},
+		CategoryIDs: []int64{1},
+		MaxAgeDays:  7,
+	}
+
+	request := &SavedSearchModificationRequest{
+		Statuses:    &[]string{},
+		FeedIDs:     &[]int64{2, 3},
+		Starred:     new(true),
+		SearchQuery: new("generics"),
+	}
+	request.Patch(savedSearch)
+
+	expected := &SavedSearch{
+		Title:       "Go",
+		SearchQuery: "generics",
+		Statuses:    []string{},
+		Starred:     true,
+		CategoryIDs: []int64{1},
+		FeedIDs:     []int64{2, 3},
+		MaxAgeDays:  7,
+	}
+
+	if !reflect.DeepEqual(savedSearch, expected) {
+		t.Errorf(`Expected %+v, got %+v`, expected, savedSearch)
+	}
+}
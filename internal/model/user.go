@@ -26,6 +26,7 @@ type User struct {
 	OpenIDConnectID                 string     `json:"openid_connect_id"`
 	EntriesPerPage                  int        `json:"entries_per_page"`
 	GestureNav                      string     `json:"gesture_nav"`
+	EntryListLayout                 string     `json:"entry_list_layout"`
 	LastLoginAt                     *time.Time `json:"last_login_at"`
 	DisplayMode                     string     `json:"display_mode"`
 	DefaultReadingSpeed             int        `json:"default_reading_speed"`
@@ -35,10 +36,13 @@ type User struct {
 	MediaPlaybackRate               float64    `json:"media_playback_rate"`
 	BlockFilterEntryRules           string     `json:"block_filter_entry_rules"`
 	KeepFilterEntryRules            string     `json:"keep_filter_entry_rules"`
+	ActionEntryRules                string     `json:"action_entry_rules"`
 	MarkReadOnView                  bool       `json:"mark_read_on_view"`
 	MarkReadOnMediaPlayerCompletion bool       `json:"mark_read_on_media_player_completion"`
 	AlwaysOpenExternalLinks         bool       `json:"always_open_external_links"`
 	OpenExternalLinksInNewTab       bool       `json:"open_external_links_in_new_tab"`
+	MarkDuplicateEntriesAsRead      bool       `json:"mark_duplicate_entries_as_read"`
+	GroupEntriesByStory             bool       `json:"group_entries_by_story"`
 	KeyboardShortcuts               bool       `json:"keyboard_shortcuts"`
 	ShowReadingTime                 bool       `json:"show_reading_time"`
 	EntrySwipe                      bool       `json:"entry_swipe"`
@@ -72,6 +76,7 @@ type UserModificationRequest struct {
 	ShowReadingTime                 *bool    `json:"show_reading_time"`
 	EntrySwipe                      *bool    `json:"entry_swipe"`
 	GestureNav                      *string  `json:"gesture_nav"`
+	EntryListLayout                 *string  `json:"entry_list_layout"`
 	DisplayMode                     *string  `json:"display_mode"`
 	DefaultReadingSpeed             *int     `json:"default_reading_speed"`
 	CJKReadingSpeed                 *int     `json:"cjk_reading_speed"`
@@ -82,8 +87,11 @@ type UserModificationRequest struct {
 	MediaPlaybackRate               *float64 `json:"media_playback_rate"`
 	BlockFilterEntryRules           *string  `json:"block_filter_entry_rules"`
 	KeepFilterEntryRules            *string  `json:"keep_filter_entry_rules"`
+	ActionEntryRules                *string  `json:"action_entry_rules"`
 	AlwaysOpenExternalLinks         *bool    `json:"always_open_external_links"`
 	OpenExternalLinksInNewTab       *bool    `json:"open_external_links_in_new_tab"`
+	MarkDuplicateEntriesAsRead      *bool    `json:"mark_duplicate_entries_as_read"`
+	GroupEntriesByStory             *bool    `json:"group_entries_by_story"`
 }
 
 // Patch updates the User object with the modification request.
@@ -152,6 +160,10 @@ func (u *UserModificationRequest) Patch(user *User) {
 		user.GestureNav = *u.GestureNav
 	}
 
+	if u.EntryListLayout != nil {
+		user.EntryListLayout = *u.EntryListLayout
+	}
+
 	if u.DisplayMode != nil {
 		user.DisplayMode = *u.DisplayMode
 	}
@@ -192,6 +204,10 @@ func (u *UserModificationRequest) Patch(user *User) {
 		user.KeepFilterEntryRules = *u.KeepFilterEntryRules
 	}
 
+	if u.ActionEntryRules != nil {
+		user.ActionEntryRules = *u.ActionEntryRules
+	}
+
 	if u.AlwaysOpenExternalLinks != nil {
 		user.AlwaysOpenExternalLinks = *u.AlwaysOpenExternalLinks
 	}
@@ -199,6 +215,14 @@ func (u *UserModificationRequest) Patch(user *User) {
 	if u.OpenExternalLinksInNewTab != nil {
 		user.OpenExternalLinksInNewTab = *u.OpenExternalLinksInNewTab
 	}
+
+	if u.MarkDuplicateEntriesAsRead != nil {
+		user.MarkDuplicateEntriesAsRead = *u.MarkDuplicateEntriesAsRead
+	}
+
+	if u.GroupEntriesByStory != nil {
+		user.GroupEntriesByStory = *u.GroupEntriesByStory
+	}
 }
 
 // UseTimezone converts last login date to the given timezone.
@@ -0,0 +1,46 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package model // import "miniflux.app/v2/internal/model"
+
+import (
+	"time"
+
+	"miniflux.app/v2/internal/config"
+)
+
+// List of WebSub subscription states.
+const (
+	WebSubStatePending = "pending"
+	WebSubStateActive  = "active"
+	WebSubStateDenied  = "denied"
+)
+
+// WebSubSubscription represents a push subscription of a feed to a WebSub hub.
+// We need to use a pointer for LeaseExpiresAt,
+// as the lease is only known once the hub has verified the subscription.
+type WebSubSubscription struct {
+	FeedID         int64
+	UserID         int64
+	HubURL         string
+	TopicURL       string
+	Secret         string
+	CallbackToken  string
+	State          string
+	LeaseExpiresAt *time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// CallbackURL returns the public URL the hub uses to reach this subscription.
+func (s *WebSubSubscription) CallbackURL() string {
+	return config.Opts.BaseURL() + "/websub/" + s.CallbackToken
+}
+
+// IsActive returns true if the hub has verified the subscription and the lease is still valid.
+func (s *WebSubSubscription) IsActive() bool {
+	return s.State == WebSubStateActive && s.LeaseExpiresAt != nil && time.Now().Before(*s.LeaseExpiresAt)
+}
+
+// WebSubSubscriptions represents a list of WebSub subscriptions.
+type WebSubSubscriptions []*WebSubSubscription
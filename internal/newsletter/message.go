@@ -0,0 +1,291 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package newsletter // import "miniflux.app/v2/internal/newsletter"
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"net/textproto"
+	"strings"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+
+	"miniflux.app/v2/internal/reader/encoding"
+)
+
+const (
+	// maxMultipartDepth limits the nesting of multipart bodies, real newsletters rarely use more than three levels.
+	maxMultipartDepth = 10
+
+	// maxInlineImageSize limits the size of each inline image, larger images are dropped.
+	maxInlineImageSize = 1024 * 1024
+)
+
+var (
+	// ErrInvalidMessage is returned when the message cannot be parsed.
+	ErrInvalidMessage = errors.New("newsletter: invalid message")
+
+	wordDecoder   = &mime.WordDecoder{CharsetReader: encoding.CharsetReader}
+	addressParser = &mail.AddressParser{WordDecoder: wordDecoder}
+
+	webVersionLabels = []string{
+		"view in browser",
+		"view in your browser",
+		"view this email in your browser",
+		"view online",
+		"read online",
+		"web version",
+		"open in browser",
+	}
+)
+
+// Message represents a newsletter received by email.
+type Message struct {
+	MessageID      string
+	From           *mail.Address
+	Subject        string
+	Date           time.Time
+	Content        string
+	UnsubscribeURL string
+	WebVersionURL  string
+	InlineImages   []*InlineImage
+}
+
+// InlineImage represents an image attached to the message and referenced in the content with a cid: URL.
+type InlineImage struct {
+	ContentID string
+	MimeType  string
+	Content   []byte
+}
+
+type messageParts struct {
+	html         string
+	text         string
+	inlineImages []*InlineImage
+}
+
+// ParseMessage parses a MIME message and extracts the HTML content of the newsletter.
+//
+// The HTML part is preferred over the plain text part. Inline images are returned separately, the content keeps
+// their cid: URLs. The unsubscribe link comes from the List-Unsubscribe header, or from the content as a fallback.
+func ParseMessage(r io.Reader) (*Message, error) {
+	rawMessage, err := mail.ReadMessage(r)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidMessage, err)
+	}
+
+	from, err := addressParser.Parse(rawMessage.Header.Get("From"))
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid From header: %v", ErrInvalidMessage, err)
+	}
+	from.Address = strings.ToLower(from.Address)
+
+	message := &Message{
+		MessageID: strings.Trim(strings.TrimSpace(rawMessage.Header.Get("Message-Id")), "<>"),
+		From:      from,
+		Subject:   decodeHeader(rawMessage.Header.Get("Subject")),
+		Date:      time.Now(),
+	}
+
+	if date, err := rawMessage.Header.Date(); err == nil {
+		message.Date = date
+	}
+
+	parts := &messageParts{}
+	if err := parts.walk(textproto.MIMEHeader(rawMessage.Header), rawMessage.Body, 0); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidMessage, err)
+	}
+
+	switch {
+	case parts.html != "":
+		message.Content = parts.html
+	case parts.text != "":
+		message.Content = textToHTML(parts.text)
+	}
+
+	for _, image := range parts.inlineImages {
+		if strings.Contains(message.Content, "cid:"+image.ContentID) {
+			message.InlineImages = append(message.InlineImages, image)
+		}
+	}
+
+	message.UnsubscribeURL = parseListUnsubscribe(rawMessage.Header.Get("List-Unsubscribe"))
+	if message.UnsubscribeURL == "" {
+		message.UnsubscribeURL = findLinkByLabel(message.Content, []string{"unsubscribe"})
+	}
+
+	message.WebVersionURL = findLinkByLabel(message.Content, webVersionLabels)
+
+	return message, nil
+}
+
+func (p *messageParts) walk(header textproto.MIMEHeader, body io.Reader, depth int) error {
+	mediaType, params, err := mime.ParseMediaType(header.Get("Content-Type"))
+	if err != nil {
+		mediaType = "text/plain"
+		params = map[string]string{}
+	}
+
+	if strings.HasPrefix(mediaType, "multipart/") {
+		if depth >= maxMultipartDepth {
+			return errors.New("too many nested multipart bodies")
+		}
+
+		reader := multipart.NewReader(body, params["boundary"])
+		for {
+			part, err := reader.NextRawPart()
+			if err == io.EOF {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+
+			if err := p.walk(part.Header, part, depth+1); err != nil {
+				return err
+			}
+		}
+	}
+
+	disposition, _, _ := mime.ParseMediaType(header.Get("Content-Disposition"))
+	body = decodeTransferEncoding(header.Get("Content-Transfer-Encoding"), body)
+
+	if strings.HasPrefix(mediaType, "image/") {
+		contentID := strings.Trim(header.Get("Content-Id"), "<> ")
+		if contentID == "" {
+			return nil
+		}
+
+		content, err := io.ReadAll(io.LimitReader(body, maxInlineImageSize+1))
+		if err != nil {
+			return err
+		}
+
+		if len(content) > maxInlineImageSize {
+			return nil
+		}
+
+		p.inlineImages = append(p.inlineImages, &InlineImage{ContentID: contentID, MimeType: mediaType, Content: content})
+		return nil
+	}
+
+	content, err := io.ReadAll(body)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case disposition == "attachment":
+		// Attached documents are not part of the newsletter content.
+	case mediaType == "text/html" && p.html == "":
+		p.html = decodeCharset(params["charset"], content)
+	case mediaType == "text/plain" && p.text == "":
+		p.text = decodeCharset(params["charset"], content)
+	}
+
+	return nil
+}
+
+func decodeTransferEncoding(transferEncoding string, body io.Reader) io.Reader {
+	switch strings.ToLower(strings.TrimSpace(transferEncoding)) {
+	case "base64":
+		return base64.NewDecoder(base64.StdEncoding, body)
+	case "quoted-printable":
+		return quotedprintable.NewReader(body)
+	default:
+		return body
+	}
+}
+
+func decodeCharset(charset string, content []byte) string {
+	if charset == "" {
+		return string(content)
+	}
+
+	reader, err := encoding.CharsetReader(charset, strings.NewReader(string(content)))
+	if err != nil {
+		return string(content)
+	}
+
+	decoded, err := io.ReadAll(reader)
+	if err != nil {
+		return string(content)
+	}
+
+	return string(decoded)
+}
+
+func decodeHeader(value string) string {
+	decoded, err := wordDecoder.DecodeHeader(value)
+	if err != nil {
+		return value
+	}
+	return strings.Join(strings.Fields(decoded), " ")
+}
+
+func textToHTML(text string) string {
+	var builder strings.Builder
+	for paragraph := range strings.SplitSeq(strings.ReplaceAll(text, "\r\n", "\n"), "\n\n") {
+		paragraph = strings.TrimSpace(paragraph)
+		if paragraph == "" {
+			continue
+		}
+
+		builder.WriteString("<p>")
+		builder.WriteString(strings.ReplaceAll(html.EscapeString(paragraph), "\n", "<br>"))
+		builder.WriteString("</p>")
+	}
+	return builder.String()
+}
+
+// parseListUnsubscribe returns the web link of a List-Unsubscribe header (RFC 2369), or the mailto link if there is none.
+func parseListUnsubscribe(value string) string {
+	var mailtoURL string
+	for item := range strings.SplitSeq(value, ",") {
+		link := strings.Trim(strings.TrimSpace(item), "<>")
+		switch {
+		case strings.HasPrefix(link, "https://"), strings.HasPrefix(link, "http://"):
+			return link
+		case strings.HasPrefix(link, "mailto:") && mailtoURL == "":
+			mailtoURL = link
+		}
+	}
+	return mailtoURL
+}
+
+func findLinkByLabel(content string, labels []string) string {
+	if content == "" {
+		return ""
+	}
+
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(content))
+	if err != nil {
+		return ""
+	}
+
+	var link string
+	doc.Find("a[href]").EachWithBreak(func(_ int, anchor *goquery.Selection) bool {
+		text := strings.ToLower(strings.Join(strings.Fields(anchor.Text()), " "))
+		for _, label := range labels {
+			if strings.Contains(text, label) {
+				href := strings.TrimSpace(anchor.AttrOr("href", ""))
+				if strings.HasPrefix(href, "https://") || strings.HasPrefix(href, "http://") {
+					link = href
+					return false
+				}
+			}
+		}
+		return true
+	})
+
+	return link
+}
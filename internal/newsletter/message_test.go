@@ -0,0 +1,192 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package newsletter // import "miniflux.app/v2/internal/newsletter"
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestParseMultipartMessage(t *testing.T) {
+	data := strings.ReplaceAll(`From: =?UTF-8?Q?Caf=C3=A9_Weekly?= <News@Example.org>
+To: abc@localhost
+Subject: =?UTF-8?B?V2Vla2x5IGRpZ2VzdCDigJQgaXNzdWUgIzQy?=
+Date: Tue, 14 Oct 2025 08:30:00 +0000
+Message-ID: <issue-42@example.org>
+List-Unsubscribe: <mailto:unsubscribe@example.org>, <https://example.org/unsubscribe?id=42>
+MIME-Version: 1.0
+Content-Type: multipart/related; boundary="related"
+
+--related
+Content-Type: multipart/alternative; boundary="alternative"
+
+--alternative
+Content-Type: text/plain; charset=utf-8
+
+Plain text version
+--alternative
+Content-Type: text/html; charset=utf-8
+Content-Transfer-Encoding: quoted-printable
+
+<p><a href=3D"https://example.org/issues/42">View in browser</a></p>
+<p>Caf=C3=A9 news <img src=3D"cid:logo@example.org"></p>
+--alternative--
+--related
+Content-Type: image/png
+Content-Transfer-Encoding: base64
+Content-ID: <logo@example.org>
+
+iVBORw0KGgo=
+--related--
+`, "\n", "\r\n")
+
+	message, err := ParseMessage(strings.NewReader(data))
+	if err != nil {
+		t.Fatalf(`Unexpected error: %v`, err)
+	}
+
+	if message.From.Name != "Café Weekly" || message.From.Address != "news@example.org" {
+		t.Errorf(`Unexpected sender: %v`, message.From)
+	}
+
+	if message.Subject != "Weekly digest — issue #42" {
+		t.Errorf(`Unexpected subject: %q`, message.Subject)
+	}
+
+	if message.MessageID != "issue-42@example.org" {
+		t.Errorf(`Unexpected message ID: %q`, message.MessageID)
+	}
+
+	if message.Date.Year() != 2025 || message.Date.Month() != 10 || message.Date.Day() != 14 {
+		t.Errorf(`Unexpected date: %v`, message.Date)
+	}
+
+	if !strings.Contains(message.Content, "Café news") {
+		t.Errorf(`The HTML part should be used, got %q`, message.Content)
+	}
+
+	if !strings.Contains(message.Content, `src="cid:logo@example.org"`) {
+		t.Errorf(`Inline images should keep their cid: URLs, got %q`, message.Content)
+	}
+
+	if len(message.InlineImages) != 1 {
+		t.Fatalf(`One inline image was expected, got %d`, len(message.InlineImages))
+	}
+
+	if image := message.InlineImages[0]; image.ContentID != "logo@example.org" || image.MimeType != "image/png" || len(image.Content) != 8 {
+		t.Errorf(`Unexpected inline image: %+v`, image)
+	}
+
+	if message.UnsubscribeURL != "https://example.org/unsubscribe?id=42" {
+		t.Errorf(`Unexpected unsubscribe URL: %q`, message.UnsubscribeURL)
+	}
+
+	if message.WebVersionURL != "https://example.org/issues/42" {
+		t.Errorf(`Unexpected web version URL: %q`, message.WebVersionURL)
+	}
+}
+
+func TestParseMessageIgnoresLargeAndUnreferencedInlineImages(t *testing.T) {
+	data := strings.ReplaceAll(`From: news@example.org
+Subject: Images
+MIME-Version: 1.0
+Content-Type: multipart/related; boundary="related"
+
+--related
+Content-Type: text/html
+
+<p><img src="cid:large@example.org"><img src="cid:small@example.org"></p>
+--related
+Content-Type: image/png
+Content-ID: <large@example.org>
+
+`+strings.Repeat("a", maxInlineImageSize+1)+`
+--related
+Content-Type: image/png
+Content-ID: <small@example.org>
+
+small
+--related
+Content-Type: image/png
+Content-ID: <unused@example.org>
+
+unused
+--related--
+`, "\n", "\r\n")
+
+	message, err := ParseMessage(strings.NewReader(data))
+	if err != nil {
+		t.Fatalf(`Unexpected error: %v`, err)
+	}
+
+	if len(message.InlineImages) != 1 || message.InlineImages[0].ContentID != "small@example.org" {
+		t.Errorf(`Only the small referenced image should be kept, got %d images`, len(message.InlineImages))
+	}
+}
+
+func TestParsePlainTextMessage(t *testing.T) {
+	data := "From: news@example.org\r\n" +
+		"Subject: =?ISO-8859-1?Q?R=E9sum=E9?=\r\n" +
+		"Content-Type: text/plain; charset=iso-8859-1\r\n" +
+		"\r\n" +
+		"First line\r\nsecond <line>\r\n\r\nOther paragraph\xe9\r\n"
+
+	message, err := ParseMessage(strings.NewReader(data))
+	if err != nil {
+		t.Fatalf(`Unexpected error: %v`, err)
+	}
+
+	if message.Subject != "Résumé" {
+		t.Errorf(`Unexpected subject: %q`, message.Subject)
+	}
+
+	expected := "<p>First line<br>second &lt;line&gt;</p><p>Other paragraphé</p>"
+	if message.Content != expected {
+		t.Errorf(`Unexpected content: got %q instead of %q`, message.Content, expected)
+	}
+
+	if message.UnsubscribeURL != "" || message.WebVersionURL != "" {
+		t.Errorf(`No link should be found, got %q and %q`, message.UnsubscribeURL, message.WebVersionURL)
+	}
+}
+
+func TestParseMessageWithUnsubscribeLinkInContent(t *testing.T) {
+	data := "From: news@example.org\r\n" +
+		"Subject: Test\r\n" +
+		"Content-Type: text/html\r\n" +
+		"\r\n" +
+		`<p>Hello</p><a href="mailto:someone@example.org">Unsubscribe by email</a> <a href="https://example.org/u/1">Unsubscribe</a>`
+
+	message, err := ParseMessage(strings.NewReader(data))
+	if err != nil {
+		t.Fatalf(`Unexpected error: %v`, err)
+	}
+
+	if message.UnsubscribeURL != "https://example.org/u/1" {
+		t.Errorf(`Unexpected unsubscribe URL: %q`, message.UnsubscribeURL)
+	}
+}
+
+func TestParseMessageWithoutSender(t *testing.T) {
+	_, err := ParseMessage(strings.NewReader("Subject: Test\r\n\r\nHello"))
+	if !errors.Is(err, ErrInvalidMessage) {
+		t.Errorf(`Expected ErrInvalidMessage, got %v`, err)
+	}
+}
+
+func TestParseListUnsubscribe(t *testing.T) {
+	scenarios := map[string]string{
+		"":                       "",
+		"<mailto:u@example.org>": "mailto:u@example.org",
+		"<http://example.org/u>": "http://example.org/u",
+		"<mailto:u@example.org>, <https://example.org/u>": "https://example.org/u",
+	}
+
+	for input, expected := range scenarios {
+		if result := parseListUnsubscribe(input); result != expected {
+			t.Errorf(`Unexpected result for %q: got %q instead of %q`, input, result, expected)
+		}
+	}
+}
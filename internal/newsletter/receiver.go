@@ -0,0 +1,191 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package newsletter // import "miniflux.app/v2/internal/newsletter"
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/mail"
+	"net/url"
+	"strings"
+
+	"miniflux.app/v2/internal/config"
+	"miniflux.app/v2/internal/crypto"
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/reader/processor"
+	"miniflux.app/v2/internal/storage"
+)
+
+// ErrSenderNotAllowed is returned when the sender is not in the allowlist of the newsletter address.
+var ErrSenderNotAllowed = errors.New("newsletter: sender not allowed")
+
+// FeedURL returns the URL of the synthetic feed that receives the messages of a sender written to a newsletter address.
+func FeedURL(sender, token string) string {
+	return "mailto:" + sender + "?newsletter=" + url.QueryEscape(token)
+}
+
+type receiver struct {
+	store  *storage.Storage
+	domain string
+}
+
+// newsletterAddress returns the newsletter address that matches the recipient, subaddresses like token+tag are accepted.
+func (r *receiver) newsletterAddress(recipient string) (*model.NewsletterAddress, error) {
+	index := strings.LastIndex(recipient, "@")
+	if index == -1 || !strings.EqualFold(recipient[index+1:], r.domain) {
+		return nil, nil
+	}
+
+	token, _, _ := strings.Cut(strings.ToLower(recipient[:index]), "+")
+	return r.store.NewsletterAddressByToken(token)
+}
+
+func (r *receiver) AcceptRecipient(recipient string) (bool, error) {
+	address, err := r.newsletterAddress(recipient)
+	return address != nil, err
+}
+
+func (r *receiver) Deliver(sender, recipient string, data []byte) error {
+	address, err := r.newsletterAddress(recipient)
+	if err != nil {
+		return err
+	}
+
+	if address == nil {
+		return fmt.Errorf("newsletter: unknown recipient %q", recipient)
+	}
+
+	message, err := ParseMessage(bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+
+	// Newsletters are usually sent with a bounce address as envelope sender, the From header is checked as well.
+	if !address.AllowsSender(message.From.Address) && !address.AllowsSender(sender) {
+		return ErrSenderNotAllowed
+	}
+
+	feed, err := r.senderFeed(address, message.From)
+	if err != nil {
+		return err
+	}
+
+	entry := newEntry(message)
+	images := replaceInlineImages(entry, message.InlineImages)
+	feed.Entries = model.Entries{entry}
+	processor.ProcessFeedEntries(r.store, feed, address.UserID, false)
+
+	if len(feed.Entries) == 0 {
+		slog.Debug("Newsletter blocked by filter rules",
+			slog.Int64("user_id", address.UserID),
+			slog.Int64("feed_id", feed.ID),
+			slog.String("entry_title", entry.Title),
+		)
+	} else {
+		created, err := r.store.InsertEntryForFeed(address.UserID, feed.ID, entry)
+		if err != nil && !errors.Is(err, storage.ErrEntryTombstoned) {
+			return err
+		}
+
+		if created && len(images) > 0 {
+			if err := r.store.CreateNewsletterImages(entry.ID, images); err != nil {
+				return err
+			}
+		}
+	}
+
+	if message.UnsubscribeURL != "" && message.UnsubscribeURL != feed.UnsubscribeURL {
+		if err := r.store.UpdateFeedUnsubscribeURL(feed.ID, message.UnsubscribeURL); err != nil {
+			return err
+		}
+	}
+
+	return r.store.SetNewsletterAddressReceivedTimestamp(address.ID)
+}
+
+// senderFeed returns the feed of the sender, the feed is created in the category of the address on the first message.
+func (r *receiver) senderFeed(address *model.NewsletterAddress, from *mail.Address) (*model.Feed, error) {
+	feedURL := FeedURL(from.Address, address.Token)
+	feedID, err := r.store.FeedIDByURL(address.UserID, feedURL)
+	if err != nil {
+		return nil, err
+	}
+
+	if feedID > 0 {
+		return r.store.FeedByID(address.UserID, feedID)
+	}
+
+	feed := &model.Feed{
+		UserID:  address.UserID,
+		FeedURL: feedURL,
+		Title:   from.Name,
+	}
+	feed.WithCategoryID(address.CategoryID)
+
+	if feed.Title == "" {
+		feed.Title = from.Address
+	}
+
+	if _, domain, found := strings.Cut(from.Address, "@"); found {
+		feed.SiteURL = "https://" + domain
+	}
+
+	if err := r.store.CreateFeed(feed); err != nil {
+		return nil, err
+	}
+
+	slog.Info("Created newsletter feed",
+		slog.Int64("user_id", address.UserID),
+		slog.Int64("feed_id", feed.ID),
+		slog.String("sender", from.Address),
+	)
+
+	return feed, nil
+}
+
+func newEntry(message *Message) *model.Entry {
+	entry := model.NewEntry()
+	entry.Title = message.Subject
+	entry.URL = message.WebVersionURL
+	entry.Author = message.From.Name
+	entry.Date = message.Date
+	entry.Content = message.Content
+
+	if entry.Title == "" {
+		entry.Title = message.From.Address
+	}
+
+	// The Message-ID avoids duplicates when the same newsletter is delivered twice.
+	if message.MessageID != "" {
+		entry.Hash = crypto.SHA256(message.MessageID)
+	} else {
+		entry.Hash = crypto.SHA256(message.From.Address + message.Subject + message.Date.String())
+	}
+
+	return entry
+}
+
+// replaceInlineImages replaces the cid: URLs of the entry content with the URLs of the stored images.
+// The images are served by the application, the media proxy rewrites their URLs like any other image.
+func replaceInlineImages(entry *model.Entry, inlineImages []*InlineImage) []*model.NewsletterImage {
+	images := make([]*model.NewsletterImage, 0, len(inlineImages))
+	for _, inlineImage := range inlineImages {
+		image := &model.NewsletterImage{
+			ExternalID: crypto.GenerateRandomStringHex(20),
+			MimeType:   inlineImage.MimeType,
+			Content:    inlineImage.Content,
+		}
+
+		entry.Content = strings.ReplaceAll(entry.Content, "cid:"+inlineImage.ContentID, imageURL(image.ExternalID))
+		images = append(images, image)
+	}
+	return images
+}
+
+// imageURL returns the absolute URL of a newsletter image.
+func imageURL(externalID string) string {
+	return config.Opts.BaseURL() + "/newsletter-image/" + externalID
+}
@@ -0,0 +1,33 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package newsletter // import "miniflux.app/v2/internal/newsletter"
+
+import (
+	"strings"
+	"testing"
+
+	"miniflux.app/v2/internal/config"
+	"miniflux.app/v2/internal/model"
+)
+
+func TestReplaceInlineImages(t *testing.T) {
+	t.Setenv("BASE_URL", "https://reader.example.org/miniflux")
+
+	var err error
+	if config.Opts, err = config.NewConfigParser().ParseEnvironmentVariables(); err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	entry := &model.Entry{Content: `<img src="cid:logo@example.org"><img src="cid:logo@example.org">`}
+	images := replaceInlineImages(entry, []*InlineImage{{ContentID: "logo@example.org", MimeType: "image/png", Content: []byte("png")}})
+
+	if len(images) != 1 || images[0].ExternalID == "" || images[0].MimeType != "image/png" || string(images[0].Content) != "png" {
+		t.Fatalf(`Unexpected images: %+v`, images)
+	}
+
+	imageURL := "https://reader.example.org/miniflux/newsletter-image/" + images[0].ExternalID
+	if strings.Contains(entry.Content, "cid:") || strings.Count(entry.Content, imageURL) != 2 {
+		t.Errorf(`The cid: URLs should be replaced by the image URL, got %q`, entry.Content)
+	}
+}
@@ -0,0 +1,483 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package newsletter // import "miniflux.app/v2/internal/newsletter"
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"net/textproto"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"miniflux.app/v2/internal/config"
+	"miniflux.app/v2/internal/storage"
+)
+
+const (
+	// maxLineLength is larger than the 512 bytes required by RFC 5321 to tolerate long command parameters.
+	maxLineLength = 4096
+	maxRecipients = 100
+	readTimeout   = 5 * time.Minute
+	writeTimeout  = time.Minute
+)
+
+var errLineTooLong = errors.New("newsletter: line too long")
+
+type backend interface {
+	AcceptRecipient(recipient string) (bool, error)
+	Deliver(sender, recipient string, data []byte) error
+}
+
+// Server receives newsletters with SMTP or LMTP and stores them as feed entries.
+type Server struct {
+	backend        backend
+	protocol       string
+	domain         string
+	maxMessageSize int64
+
+	listener    net.Listener
+	mu          sync.Mutex
+	connections map[net.Conn]struct{}
+	wg          sync.WaitGroup
+}
+
+// StartServer starts the newsletter receiver on the configured address.
+// Addresses starting with a slash are Unix sockets, which is the usual way to plug LMTP into a mail server.
+func StartServer(store *storage.Storage) (*Server, error) {
+	listenAddr := config.Opts.NewsletterListenAddr()
+
+	// The permissions of the Unix socket follow the umask of the process.
+	network := "tcp"
+	if strings.HasPrefix(listenAddr, "/") {
+		network = "unix"
+		if err := removeStaleSocket(listenAddr); err != nil {
+			return nil, err
+		}
+	}
+
+	listener, err := net.Listen(network, listenAddr)
+	if err != nil {
+		return nil, fmt.Errorf("newsletter: unable to listen on %q: %w", listenAddr, err)
+	}
+
+	server := newServer(
+		&receiver{store: store, domain: config.Opts.NewsletterDomain()},
+		config.Opts.NewsletterProtocol(),
+		config.Opts.NewsletterDomain(),
+		config.Opts.NewsletterMaxMessageSize(),
+	)
+
+	slog.Info("Starting newsletter receiver",
+		slog.String("listen_address", listenAddr),
+		slog.String("protocol", server.protocol),
+		slog.String("domain", server.domain),
+	)
+
+	go server.serve(listener)
+
+	return server, nil
+}
+
+// removeStaleSocket removes the socket left by a previous process, any other kind of file is kept.
+func removeStaleSocket(socketFile string) error {
+	fileInfo, err := os.Lstat(socketFile)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("newsletter: unable to stat %q: %w", socketFile, err)
+	}
+
+	if fileInfo.Mode().Type() != os.ModeSocket {
+		return fmt.Errorf("newsletter: %q already exists and is not a socket", socketFile)
+	}
+
+	if err := os.Remove(socketFile); err != nil {
+		return fmt.Errorf("newsletter: unable to remove %q: %w", socketFile, err)
+	}
+
+	return nil
+}
+
+func newServer(backend backend, protocol, domain string, maxMessageSize int64) *Server {
+	return &Server{
+		backend:        backend,
+		protocol:       protocol,
+		domain:         domain,
+		maxMessageSize: maxMessageSize,
+		connections:    make(map[net.Conn]struct{}),
+	}
+}
+
+func (s *Server) serve(listener net.Listener) {
+	s.mu.Lock()
+	s.listener = listener
+	s.mu.Unlock()
+
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			if !errors.Is(err, net.ErrClosed) {
+				slog.Error("Unable to accept newsletter connection", slog.Any("error", err))
+			}
+			return
+		}
+
+		s.mu.Lock()
+		s.connections[conn] = struct{}{}
+		s.wg.Add(1)
+		s.mu.Unlock()
+
+		go func() {
+			defer func() {
+				conn.Close()
+				s.mu.Lock()
+				delete(s.connections, conn)
+				s.mu.Unlock()
+				s.wg.Done()
+			}()
+
+			newSession(s, conn).run()
+		}()
+	}
+}
+
+// Shutdown stops accepting connections and waits for the running sessions, they are closed when the context expires.
+func (s *Server) Shutdown(ctx context.Context) error {
+	s.mu.Lock()
+	if s.listener != nil {
+		s.listener.Close()
+	}
+	s.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		s.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		s.mu.Lock()
+		for conn := range s.connections {
+			conn.Close()
+		}
+		s.mu.Unlock()
+		return ctx.Err()
+	}
+}
+
+type session struct {
+	server     *Server
+	conn       net.Conn
+	reader     *bufio.Reader
+	writer     *bufio.Writer
+	greeted    bool
+	sender     string
+	hasSender  bool
+	recipients []string
+}
+
+func newSession(server *Server, conn net.Conn) *session {
+	return &session{
+		server: server,
+		conn:   conn,
+		reader: bufio.NewReaderSize(conn, maxLineLength),
+		writer: bufio.NewWriter(conn),
+	}
+}
+
+func (s *session) isLMTP() bool {
+	return s.server.protocol == "lmtp"
+}
+
+func (s *session) run() {
+	if s.isLMTP() {
+		s.reply(220, s.server.domain+" LMTP Miniflux ready")
+	} else {
+		s.reply(220, s.server.domain+" ESMTP Miniflux ready")
+	}
+
+	for {
+		s.conn.SetReadDeadline(time.Now().Add(readTimeout))
+		line, err := s.readLine()
+		if err != nil {
+			if errors.Is(err, errLineTooLong) {
+				s.reply(500, "Line too long")
+			}
+			return
+		}
+
+		verb, argument, _ := strings.Cut(line, " ")
+		argument = strings.TrimSpace(argument)
+
+		switch strings.ToUpper(verb) {
+		case "HELO", "EHLO":
+			if s.isLMTP() {
+				s.reply(500, "This is a LMTP server, use LHLO")
+				continue
+			}
+			s.handleHello(strings.ToUpper(verb) == "EHLO")
+		case "LHLO":
+			if !s.isLMTP() {
+				s.reply(500, "This is a SMTP server, use EHLO")
+				continue
+			}
+			s.handleHello(true)
+		case "MAIL":
+			s.handleMail(argument)
+		case "RCPT":
+			s.handleRcpt(argument)
+		case "DATA":
+			if !s.handleData() {
+				return
+			}
+		case "RSET":
+			s.reset()
+			s.reply(250, "OK")
+		case "NOOP":
+			s.reply(250, "OK")
+		case "VRFY":
+			s.reply(252, "Cannot verify the user, but will accept the message")
+		case "QUIT":
+			s.reply(221, "Bye")
+			return
+		case "STARTTLS", "AUTH", "EXPN", "TURN", "ETRN", "BDAT":
+			s.reply(502, "Command not implemented")
+		default:
+			s.reply(500, "Unknown command")
+		}
+	}
+}
+
+func (s *session) readLine() (string, error) {
+	line, err := s.reader.ReadSlice('\n')
+	if errors.Is(err, bufio.ErrBufferFull) {
+		return "", errLineTooLong
+	}
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(string(line), "\r\n"), nil
+}
+
+func (s *session) reply(code int, lines ...string) {
+	s.conn.SetWriteDeadline(time.Now().Add(writeTimeout))
+	for i, line := range lines {
+		separator := " "
+		if i < len(lines)-1 {
+			separator = "-"
+		}
+		fmt.Fprintf(s.writer, "%d%s%s\r\n", code, separator, line)
+	}
+	s.writer.Flush()
+}
+
+func (s *session) reset() {
+	s.sender = ""
+	s.hasSender = false
+	s.recipients = nil
+}
+
+func (s *session) handleHello(extended bool) {
+	s.reset()
+	s.greeted = true
+
+	if !extended {
+		s.reply(250, s.server.domain)
+		return
+	}
+
+	s.reply(250,
+		s.server.domain,
+		"PIPELINING",
+		"8BITMIME",
+		"SIZE "+strconv.FormatInt(s.server.maxMessageSize, 10),
+	)
+}
+
+func (s *session) handleMail(argument string) {
+	if !s.greeted {
+		s.reply(503, "Send HELO first")
+		return
+	}
+
+	if s.hasSender {
+		s.reply(503, "Sender already specified")
+		return
+	}
+
+	address, parameters, ok := parsePath(argument, "FROM:")
+	if !ok {
+		s.reply(501, "Syntax: MAIL FROM:<address>")
+		return
+	}
+
+	for _, parameter := range parameters {
+		name, value, _ := strings.Cut(parameter, "=")
+		if strings.EqualFold(name, "SIZE") {
+			if size, err := strconv.ParseInt(value, 10, 64); err == nil && size > s.server.maxMessageSize {
+				s.reply(552, "Message too large")
+				return
+			}
+		}
+	}
+
+	s.sender = strings.ToLower(address)
+	s.hasSender = true
+	s.reply(250, "OK")
+}
+
+func (s *session) handleRcpt(argument string) {
+	if !s.hasSender {
+		s.reply(503, "Send MAIL first")
+		return
+	}
+
+	address, _, ok := parsePath(argument, "TO:")
+	if !ok || address == "" {
+		s.reply(501, "Syntax: RCPT TO:<address>")
+		return
+	}
+
+	if len(s.recipients) >= maxRecipients {
+		s.reply(452, "Too many recipients")
+		return
+	}
+
+	accepted, err := s.server.backend.AcceptRecipient(address)
+	switch {
+	case err != nil:
+		slog.Error("Unable to check newsletter recipient", slog.String("recipient", address), slog.Any("error", err))
+		s.reply(451, "Temporary failure, try again later")
+	case !accepted:
+		s.reply(550, "No such user")
+	default:
+		s.recipients = append(s.recipients, address)
+		s.reply(250, "OK")
+	}
+}
+
+// handleData reads the message and delivers it to each recipient. It returns false if the connection must be closed.
+func (s *session) handleData() bool {
+	if len(s.recipients) == 0 {
+		s.reply(503, "Send RCPT first")
+		return true
+	}
+
+	s.reply(354, "End data with <CR><LF>.<CR><LF>")
+
+	dotReader := textproto.NewReader(s.reader).DotReader()
+	data, err := io.ReadAll(io.LimitReader(dotReader, s.server.maxMessageSize+1))
+	if err != nil {
+		return false
+	}
+
+	if int64(len(data)) > s.server.maxMessageSize {
+		if _, err := io.Copy(io.Discard, dotReader); err != nil {
+			return false
+		}
+		s.replyToRecipients(552, "Message too large")
+		s.reset()
+		return true
+	}
+
+	// LMTP sends one reply per recipient, SMTP sends a single reply for the whole transaction.
+	delivered := false
+	code, message := 250, "OK"
+	for _, recipient := range s.recipients {
+		recipientCode, recipientMessage := s.deliver(recipient, data)
+		switch {
+		case s.isLMTP():
+			s.reply(recipientCode, recipientMessage)
+		case recipientCode == 250:
+			delivered = true
+		default:
+			code, message = recipientCode, recipientMessage
+		}
+	}
+
+	if !s.isLMTP() {
+		if delivered {
+			code, message = 250, "OK"
+		}
+		s.reply(code, message)
+	}
+
+	s.reset()
+	return true
+}
+
+func (s *session) replyToRecipients(code int, message string) {
+	if !s.isLMTP() {
+		s.reply(code, message)
+		return
+	}
+
+	for range s.recipients {
+		s.reply(code, message)
+	}
+}
+
+func (s *session) deliver(recipient string, data []byte) (int, string) {
+	err := s.server.backend.Deliver(s.sender, recipient, data)
+	switch {
+	case err == nil:
+		slog.Debug("Newsletter delivered",
+			slog.String("sender", s.sender),
+			slog.String("recipient", recipient),
+		)
+		return 250, "OK"
+	case errors.Is(err, ErrSenderNotAllowed):
+		slog.Info("Newsletter rejected",
+			slog.String("sender", s.sender),
+			slog.String("recipient", recipient),
+			slog.Any("error", err),
+		)
+		return 550, "Sender not allowed"
+	case errors.Is(err, ErrInvalidMessage):
+		slog.Warn("Unable to parse newsletter",
+			slog.String("sender", s.sender),
+			slog.String("recipient", recipient),
+			slog.Any("error", err),
+		)
+		return 554, "Invalid message"
+	default:
+		slog.Error("Unable to deliver newsletter",
+			slog.String("sender", s.sender),
+			slog.String("recipient", recipient),
+			slog.Any("error", err),
+		)
+		return 451, "Temporary failure, try again later"
+	}
+}
+
+// parsePath parses arguments like "FROM:<address> PARAM=value", the address can be empty for bounce messages.
+func parsePath(argument, prefix string) (address string, parameters []string, ok bool) {
+	if len(argument) < len(prefix) || !strings.EqualFold(argument[:len(prefix)], prefix) {
+		return "", nil, false
+	}
+
+	fields := strings.Fields(strings.TrimSpace(argument[len(prefix):]))
+	if len(fields) == 0 {
+		return "", nil, false
+	}
+
+	path := fields[0]
+	if !strings.HasPrefix(path, "<") || !strings.HasSuffix(path, ">") {
+		return "", nil, false
+	}
+
+	return path[1 : len(path)-1], fields[1:], true
+}
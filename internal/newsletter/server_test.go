@@ -0,0 +1,218 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package newsletter // import "miniflux.app/v2/internal/newsletter"
+
+import (
+	"context"
+	"net"
+	"net/textproto"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+type fakeBackend struct {
+	recipients map[string]error
+	delivered  []string
+}
+
+func (b *fakeBackend) AcceptRecipient(recipient string) (bool, error) {
+	_, found := b.recipients[recipient]
+	return found, nil
+}
+
+func (b *fakeBackend) Deliver(sender, recipient string, data []byte) error {
+	if err := b.recipients[recipient]; err != nil {
+		return err
+	}
+	b.delivered = append(b.delivered, sender+" "+recipient+" "+string(data))
+	return nil
+}
+
+func startSession(t *testing.T, protocol string, backend backend) *textproto.Conn {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf(`Unable to listen: %v`, err)
+	}
+
+	server := newServer(backend, protocol, "localhost", 1024)
+	go server.serve(listener)
+	t.Cleanup(func() { server.Shutdown(context.Background()) })
+
+	clientConn, err := net.Dial("tcp", listener.Addr().String())
+	if err != nil {
+		t.Fatalf(`Unable to connect: %v`, err)
+	}
+
+	client := textproto.NewConn(clientConn)
+	t.Cleanup(func() { client.Close() })
+
+	if _, _, err := client.ReadResponse(220); err != nil {
+		t.Fatalf(`Unexpected greeting: %v`, err)
+	}
+
+	return client
+}
+
+func expectResponse(t *testing.T, client *textproto.Conn, expectedCode int, command string) string {
+	t.Helper()
+
+	if err := client.PrintfLine("%s", command); err != nil {
+		t.Fatalf(`Unable to send %q: %v`, command, err)
+	}
+
+	code, message, err := client.ReadResponse(expectedCode)
+	if err != nil {
+		t.Fatalf(`Unexpected response to %q: %d %s (%v)`, command, code, message, err)
+	}
+
+	return message
+}
+
+func TestSMTPSession(t *testing.T) {
+	backend := &fakeBackend{recipients: map[string]error{"token@localhost": nil}}
+	client := startSession(t, "smtp", backend)
+
+	expectResponse(t, client, 503, "MAIL FROM:<news@example.org>")
+	expectResponse(t, client, 500, "LHLO client.example.org")
+
+	if message := expectResponse(t, client, 250, "EHLO client.example.org"); !strings.Contains(message, "SIZE 1024") {
+		t.Errorf(`The SIZE extension should be announced, got %q`, message)
+	}
+
+	expectResponse(t, client, 552, "MAIL FROM:<news@example.org> SIZE=4096")
+	expectResponse(t, client, 250, "MAIL FROM:<News@Example.org> BODY=8BITMIME")
+	expectResponse(t, client, 550, "RCPT TO:<unknown@localhost>")
+	expectResponse(t, client, 501, "RCPT TO:token@localhost")
+	expectResponse(t, client, 250, "RCPT TO:<token@localhost>")
+	expectResponse(t, client, 354, "DATA")
+
+	writer := client.DotWriter()
+	writer.Write([]byte("Subject: Test\r\n\r\n.Hello\r\n"))
+	writer.Close()
+
+	if _, _, err := client.ReadResponse(250); err != nil {
+		t.Fatalf(`Unexpected response to the message: %v`, err)
+	}
+
+	expectResponse(t, client, 221, "QUIT")
+
+	if len(backend.delivered) != 1 {
+		t.Fatalf(`One message should be delivered, got %d`, len(backend.delivered))
+	}
+
+	if expected := "news@example.org token@localhost Subject: Test\n\n.Hello\n"; backend.delivered[0] != expected {
+		t.Errorf(`Unexpected delivery: got %q instead of %q`, backend.delivered[0], expected)
+	}
+}
+
+func TestSMTPSessionWithTooLargeMessage(t *testing.T) {
+	backend := &fakeBackend{recipients: map[string]error{"token@localhost": nil}}
+	client := startSession(t, "smtp", backend)
+
+	expectResponse(t, client, 250, "HELO client.example.org")
+	expectResponse(t, client, 250, "MAIL FROM:<>")
+	expectResponse(t, client, 250, "RCPT TO:<token@localhost>")
+	expectResponse(t, client, 354, "DATA")
+
+	writer := client.DotWriter()
+	writer.Write([]byte(strings.Repeat("a", 2048)))
+	writer.Close()
+
+	if _, _, err := client.ReadResponse(552); err != nil {
+		t.Fatalf(`Unexpected response to the message: %v`, err)
+	}
+
+	// The session is still usable after the rejection.
+	expectResponse(t, client, 250, "NOOP")
+
+	if len(backend.delivered) != 0 {
+		t.Errorf(`No message should be delivered`)
+	}
+}
+
+func TestLMTPSessionRepliesForEachRecipient(t *testing.T) {
+	backend := &fakeBackend{recipients: map[string]error{
+		"first@localhost":  nil,
+		"second@localhost": ErrSenderNotAllowed,
+	}}
+	client := startSession(t, "lmtp", backend)
+
+	expectResponse(t, client, 500, "EHLO client.example.org")
+	expectResponse(t, client, 250, "LHLO client.example.org")
+	expectResponse(t, client, 250, "MAIL FROM:<news@example.org>")
+	expectResponse(t, client, 250, "RCPT TO:<first@localhost>")
+	expectResponse(t, client, 250, "RCPT TO:<second@localhost>")
+	expectResponse(t, client, 354, "DATA")
+
+	writer := client.DotWriter()
+	writer.Write([]byte("Subject: Test\r\n\r\nHello\r\n"))
+	writer.Close()
+
+	if _, _, err := client.ReadResponse(250); err != nil {
+		t.Fatalf(`Unexpected response for the first recipient: %v`, err)
+	}
+
+	if _, _, err := client.ReadResponse(550); err != nil {
+		t.Fatalf(`Unexpected response for the second recipient: %v`, err)
+	}
+
+	expectResponse(t, client, 221, "QUIT")
+}
+
+func TestSessionRejectsLongLines(t *testing.T) {
+	client := startSession(t, "smtp", &fakeBackend{})
+	expectResponse(t, client, 500, "HELO "+strings.Repeat("a", maxLineLength))
+}
+
+func TestParsePath(t *testing.T) {
+	address, parameters, ok := parsePath("from: <a@example.org> SIZE=10", "FROM:")
+	if !ok || address != "a@example.org" || len(parameters) != 1 || parameters[0] != "SIZE=10" {
+		t.Errorf(`Unexpected result: %q %v %v`, address, parameters, ok)
+	}
+
+	if _, _, ok := parsePath("TO:<a@example.org>", "FROM:"); ok {
+		t.Error(`The prefix should be checked`)
+	}
+}
+
+func TestRemoveStaleSocket(t *testing.T) {
+	socketFile := filepath.Join(t.TempDir(), "lmtp.sock")
+	listener, err := net.Listen("unix", socketFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	listener.(*net.UnixListener).SetUnlinkOnClose(false)
+	listener.Close()
+
+	if err := removeStaleSocket(socketFile); err != nil {
+		t.Fatalf("The stale socket should be removed: %v", err)
+	}
+
+	if _, err := os.Lstat(socketFile); !os.IsNotExist(err) {
+		t.Error("The stale socket still exists")
+	}
+
+	if err := removeStaleSocket(socketFile); err != nil {
+		t.Errorf("A missing socket should not return an error: %v", err)
+	}
+}
+
+func TestRemoveStaleSocketKeepsRegularFiles(t *testing.T) {
+	regularFile := filepath.Join(t.TempDir(), "lmtp.sock")
+	if err := os.WriteFile(regularFile, []byte("data"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := removeStaleSocket(regularFile); err == nil {
+		t.Error("A regular file should not be replaced by the socket")
+	}
+
+	if _, err := os.Stat(regularFile); err != nil {
+		t.Errorf("The regular file has been removed: %v", err)
+	}
+}
@@ -0,0 +1,103 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package publicfeed // import "miniflux.app/v2/internal/publicfeed"
+
+import (
+	"encoding/xml"
+	"strconv"
+	"time"
+)
+
+// Specs: https://datatracker.ietf.org/doc/html/rfc4287
+type atomFeed struct {
+	XMLName   xml.Name    `xml:"http://www.w3.org/2005/Atom feed"`
+	ID        string      `xml:"id"`
+	Title     string      `xml:"title"`
+	Updated   string      `xml:"updated"`
+	Generator string      `xml:"generator"`
+	Links     []atomLink  `xml:"link"`
+	Entries   []atomEntry `xml:"entry"`
+}
+
+type atomLink struct {
+	Href   string `xml:"href,attr"`
+	Rel    string `xml:"rel,attr"`
+	Type   string `xml:"type,attr,omitempty"`
+	Length string `xml:"length,attr,omitempty"`
+}
+
+type atomEntry struct {
+	ID         string         `xml:"id"`
+	Title      string         `xml:"title"`
+	Published  string         `xml:"published"`
+	Updated    string         `xml:"updated"`
+	Authors    []atomPerson   `xml:"author"`
+	Links      []atomLink     `xml:"link"`
+	Categories []atomCategory `xml:"category"`
+	Content    atomContent    `xml:"content"`
+}
+
+type atomPerson struct {
+	Name string `xml:"name"`
+}
+
+type atomCategory struct {
+	Term string `xml:"term,attr"`
+}
+
+type atomContent struct {
+	Type  string `xml:"type,attr"`
+	Value string `xml:",chardata"`
+}
+
+func serializeAtom(feed *Feed) ([]byte, error) {
+	document := &atomFeed{
+		ID:        feed.FeedURL,
+		Title:     feed.Title,
+		Updated:   feed.Updated.UTC().Format(time.RFC3339),
+		Generator: generator,
+		Links: []atomLink{
+			{Href: feed.FeedURL, Rel: "self", Type: "application/atom+xml"},
+			{Href: feed.SiteURL, Rel: "alternate", Type: "text/html"},
+		},
+	}
+
+	for _, entry := range feed.Entries {
+		atomEntry := atomEntry{
+			ID:        entryID(feed, entry),
+			Title:     entry.Title,
+			Published: entry.Date.UTC().Format(time.RFC3339),
+			Updated:   entryUpdated(entry).UTC().Format(time.RFC3339),
+			Content:   atomContent{Type: "html", Value: entry.Content},
+		}
+
+		if entry.Author != "" {
+			atomEntry.Authors = append(atomEntry.Authors, atomPerson{Name: entry.Author})
+		} else if entry.Feed != nil {
+			// An Atom entry must have an author when the feed does not have one.
+			atomEntry.Authors = append(atomEntry.Authors, atomPerson{Name: entry.Feed.Title})
+		}
+
+		if entry.URL != "" {
+			atomEntry.Links = append(atomEntry.Links, atomLink{Href: entry.URL, Rel: "alternate", Type: "text/html"})
+		}
+
+		for _, enclosure := range entry.Enclosures {
+			atomEntry.Links = append(atomEntry.Links, atomLink{
+				Href:   enclosure.URL,
+				Rel:    "enclosure",
+				Type:   enclosure.MimeType,
+				Length: strconv.FormatInt(enclosure.Size, 10),
+			})
+		}
+
+		for _, tag := range entry.Tags {
+			atomEntry.Categories = append(atomEntry.Categories, atomCategory{Term: tag})
+		}
+
+		document.Entries = append(document.Entries, atomEntry)
+	}
+
+	return encodeXML(document)
+}
@@ -0,0 +1,72 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package publicfeed // import "miniflux.app/v2/internal/publicfeed"
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"miniflux.app/v2/internal/model"
+)
+
+const generator = "Miniflux"
+
+// Feed represents the channel of a public feed and its entries.
+type Feed struct {
+	Title   string
+	SiteURL string
+	FeedURL string
+	Updated time.Time
+	Entries model.Entries
+}
+
+// Serialize converts the feed to the given format and returns the document with its content type.
+func Serialize(format string, feed *Feed) (contentType string, body []byte, err error) {
+	switch format {
+	case model.PublicFeedFormatRSS:
+		body, err = serializeRSS(feed)
+		contentType = "application/rss+xml; charset=utf-8"
+	case model.PublicFeedFormatAtom:
+		body, err = serializeAtom(feed)
+		contentType = "application/atom+xml; charset=utf-8"
+	case model.PublicFeedFormatJSON:
+		body, err = serializeJSON(feed)
+		contentType = "application/feed+json; charset=utf-8"
+	default:
+		err = fmt.Errorf("publicfeed: unsupported format %q", format)
+	}
+
+	return contentType, body, err
+}
+
+// IsValidFormat returns true if the format is supported.
+func IsValidFormat(format string) bool {
+	switch format {
+	case model.PublicFeedFormatRSS, model.PublicFeedFormatAtom, model.PublicFeedFormatJSON:
+		return true
+	}
+	return false
+}
+
+// entryID returns a globally unique identifier that does not depend on the token of the public feed,
+// so the entries keep the same identifier if the token is revoked and the feed recreated.
+//
+// Specs: https://www.rfc-editor.org/rfc/rfc4151
+func entryID(feed *Feed, entry *model.Entry) string {
+	hostname := "localhost"
+	if parsedURL, err := url.Parse(feed.FeedURL); err == nil && parsedURL.Hostname() != "" {
+		hostname = parsedURL.Hostname()
+	}
+
+	return "tag:" + hostname + "," + entry.CreatedAt.UTC().Format(time.DateOnly) + ":entry:" + strconv.FormatInt(entry.ID, 10)
+}
+
+func entryUpdated(entry *model.Entry) time.Time {
+	if entry.ChangedAt.After(entry.Date) {
+		return entry.ChangedAt
+	}
+	return entry.Date
+}
@@ -0,0 +1,135 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package publicfeed // import "miniflux.app/v2/internal/publicfeed"
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/reader/parser"
+)
+
+func newTestFeed() *Feed {
+	entry := model.NewEntry()
+	entry.ID = 42
+	entry.Title = "Entry title"
+	entry.URL = "https://example.org/article"
+	entry.Author = "Jane Doe"
+	entry.Content = `<p>Some <b>content</b> with ]]> inside</p>`
+	entry.Date = time.Date(2024, time.March, 1, 10, 0, 0, 0, time.UTC)
+	entry.CreatedAt = time.Date(2024, time.March, 1, 11, 0, 0, 0, time.UTC)
+	entry.ChangedAt = time.Date(2024, time.March, 2, 11, 0, 0, 0, time.UTC)
+	entry.Tags = []string{"go", "rss"}
+	entry.Feed.Title = "Source feed"
+	entry.Enclosures = model.EnclosureList{
+		{URL: "https://example.org/podcast.mp3", MimeType: "audio/mpeg", Size: 1234},
+	}
+
+	return &Feed{
+		Title:   "Starred entries",
+		SiteURL: "https://miniflux.example.org/",
+		FeedURL: "https://miniflux.example.org:8080/public/token/rss",
+		Updated: entry.ChangedAt,
+		Entries: model.Entries{entry},
+	}
+}
+
+func TestSerializeRoundTrip(t *testing.T) {
+	scenarios := []struct {
+		format      string
+		contentType string
+	}{
+		{model.PublicFeedFormatRSS, "application/rss+xml; charset=utf-8"},
+		{model.PublicFeedFormatAtom, "application/atom+xml; charset=utf-8"},
+		{model.PublicFeedFormatJSON, "application/feed+json; charset=utf-8"},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.format, func(t *testing.T) {
+			contentType, body, err := Serialize(scenario.format, newTestFeed())
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if contentType != scenario.contentType {
+				t.Errorf("Incorrect content type, got: %q", contentType)
+			}
+
+			feed, err := parser.ParseFeed("https://miniflux.example.org/", bytes.NewReader(body))
+			if err != nil {
+				t.Fatalf("Unable to parse the generated feed: %v\n%s", err, body)
+			}
+
+			if feed.Title != "Starred entries" {
+				t.Errorf("Incorrect feed title, got: %q", feed.Title)
+			}
+
+			if len(feed.Entries) != 1 {
+				t.Fatalf("Incorrect number of entries, got: %d", len(feed.Entries))
+			}
+
+			entry := feed.Entries[0]
+			if entry.Title != "Entry title" {
+				t.Errorf("Incorrect entry title, got: %q", entry.Title)
+			}
+
+			if entry.URL != "https://example.org/article" {
+				t.Errorf("Incorrect entry URL, got: %q", entry.URL)
+			}
+
+			if entry.Author != "Jane Doe" {
+				t.Errorf("Incorrect entry author, got: %q", entry.Author)
+			}
+
+			if !strings.Contains(entry.Content, "<b>content</b> with ]]> inside") {
+				t.Errorf("Incorrect entry content, got: %q", entry.Content)
+			}
+
+			if !entry.Date.Equal(time.Date(2024, time.March, 1, 10, 0, 0, 0, time.UTC)) {
+				t.Errorf("Incorrect entry date, got: %v", entry.Date)
+			}
+
+			if len(entry.Enclosures) != 1 || entry.Enclosures[0].URL != "https://example.org/podcast.mp3" {
+				t.Errorf("Incorrect entry enclosures, got: %v", entry.Enclosures)
+			}
+		})
+	}
+}
+
+func TestSerializeEmptyFeed(t *testing.T) {
+	feed := newTestFeed()
+	feed.Entries = nil
+
+	_, body, err := Serialize(model.PublicFeedFormatJSON, feed)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if !bytes.Contains(body, []byte(`"items": []`)) {
+		t.Errorf("The items should be an empty list, got: %s", body)
+	}
+}
+
+func TestSerializeUnsupportedFormat(t *testing.T) {
+	if _, _, err := Serialize("opml", newTestFeed()); err == nil {
+		t.Fatal("Expected an error for an unsupported format")
+	}
+}
+
+func TestEntryIDIsStable(t *testing.T) {
+	feed := newTestFeed()
+	expected := "tag:miniflux.example.org,2024-03-01:entry:42"
+
+	if id := entryID(feed, feed.Entries[0]); id != expected {
+		t.Errorf("Incorrect entry ID, got: %q instead of %q", id, expected)
+	}
+
+	feed.FeedURL = "https://miniflux.example.org:8080/public/another-token/atom"
+	if id := entryID(feed, feed.Entries[0]); id != expected {
+		t.Errorf("The entry ID should not depend on the token, got: %q", id)
+	}
+}
@@ -0,0 +1,120 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package publicfeed // import "miniflux.app/v2/internal/publicfeed"
+
+import (
+	"bytes"
+	"encoding/xml"
+	"strconv"
+	"time"
+)
+
+// Specs: https://www.rssboard.org/rss-specification
+type rssDocument struct {
+	XMLName             xml.Name   `xml:"rss"`
+	Version             string     `xml:"version,attr"`
+	AtomNamespace       string     `xml:"xmlns:atom,attr"`
+	DublinCoreNamespace string     `xml:"xmlns:dc,attr"`
+	Channel             rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title         string    `xml:"title"`
+	Link          string    `xml:"link"`
+	Description   string    `xml:"description"`
+	Generator     string    `xml:"generator"`
+	LastBuildDate string    `xml:"lastBuildDate,omitempty"`
+	AtomLink      rssLink   `xml:"atom:link"`
+	Items         []rssItem `xml:"item"`
+}
+
+type rssLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr"`
+	Type string `xml:"type,attr"`
+}
+
+type rssItem struct {
+	Title       string         `xml:"title"`
+	Link        string         `xml:"link,omitempty"`
+	GUID        rssGUID        `xml:"guid"`
+	PubDate     string         `xml:"pubDate"`
+	Creator     string         `xml:"dc:creator,omitempty"`
+	Comments    string         `xml:"comments,omitempty"`
+	Categories  []string       `xml:"category"`
+	Description rssCDATA       `xml:"description"`
+	Enclosures  []rssEnclosure `xml:"enclosure"`
+}
+
+type rssGUID struct {
+	Value       string `xml:",chardata"`
+	IsPermaLink string `xml:"isPermaLink,attr"`
+}
+
+type rssCDATA struct {
+	Value string `xml:",cdata"`
+}
+
+type rssEnclosure struct {
+	URL    string `xml:"url,attr"`
+	Length string `xml:"length,attr"`
+	Type   string `xml:"type,attr"`
+}
+
+func serializeRSS(feed *Feed) ([]byte, error) {
+	document := &rssDocument{
+		Version:             "2.0",
+		AtomNamespace:       "http://www.w3.org/2005/Atom",
+		DublinCoreNamespace: "http://purl.org/dc/elements/1.1/",
+		Channel: rssChannel{
+			Title:       feed.Title,
+			Link:        feed.SiteURL,
+			Description: feed.Title,
+			Generator:   generator,
+			AtomLink:    rssLink{Href: feed.FeedURL, Rel: "self", Type: "application/rss+xml"},
+		},
+	}
+
+	if !feed.Updated.IsZero() {
+		document.Channel.LastBuildDate = feed.Updated.UTC().Format(time.RFC1123Z)
+	}
+
+	for _, entry := range feed.Entries {
+		item := rssItem{
+			Title:       entry.Title,
+			Link:        entry.URL,
+			GUID:        rssGUID{Value: entryID(feed, entry), IsPermaLink: "false"},
+			PubDate:     entry.Date.UTC().Format(time.RFC1123Z),
+			Creator:     entry.Author,
+			Comments:    entry.CommentsURL,
+			Categories:  entry.Tags,
+			Description: rssCDATA{Value: entry.Content},
+		}
+
+		for _, enclosure := range entry.Enclosures {
+			item.Enclosures = append(item.Enclosures, rssEnclosure{
+				URL:    enclosure.URL,
+				Length: strconv.FormatInt(enclosure.Size, 10),
+				Type:   enclosure.MimeType,
+			})
+		}
+
+		document.Channel.Items = append(document.Channel.Items, item)
+	}
+
+	return encodeXML(document)
+}
+
+func encodeXML(document any) ([]byte, error) {
+	var buffer bytes.Buffer
+	buffer.WriteString(xml.Header)
+
+	encoder := xml.NewEncoder(&buffer)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(document); err != nil {
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
+}
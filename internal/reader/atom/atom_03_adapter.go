@@ -31,6 +31,15 @@ func (a *atom03Adapter) buildFeed(baseURL string) *model.Feed {
 	if feedURL != "" {
 		if absoluteFeedURL, err := urllib.ResolveToAbsoluteURL(baseURL, feedURL); err == nil {
 			feed.FeedURL = absoluteFeedURL
+			feed.SelfURL = absoluteFeedURL
+		}
+	}
+
+	// Populate the WebSub hub URL.
+	hubURL := a.atomFeed.Links.firstLinkWithRelation("hub")
+	if hubURL != "" {
+		if absoluteHubURL, err := urllib.ResolveToAbsoluteURL(baseURL, hubURL); err == nil {
+			feed.HubURL = absoluteHubURL
 		}
 	}
 
@@ -32,6 +32,15 @@ func (a *atom10Adapter) buildFeed(baseURL string) *model.Feed {
 	if feedURL != "" {
 		if absoluteFeedURL, err := urllib.ResolveToAbsoluteURL(baseURL, feedURL); err == nil {
 			feed.FeedURL = absoluteFeedURL
+			feed.SelfURL = absoluteFeedURL
+		}
+	}
+
+	// Populate the WebSub hub URL.
+	hubURL := a.atomFeed.Links.firstLinkWithRelation("hub")
+	if hubURL != "" {
+		if absoluteHubURL, err := urllib.ResolveToAbsoluteURL(baseURL, hubURL); err == nil {
+			feed.HubURL = absoluteHubURL
 		}
 	}
 
@@ -175,6 +184,13 @@ func (a *atom10Adapter) populateEntries(siteURL string) model.Entries {
 			}
 		}
 
+		// Populate the entry thumbnail.
+		if imageURL, width, height := atomEntry.FirstMediaImage(); imageURL != "" {
+			if absoluteImageURL, err := urllib.ResolveToAbsoluteURL(siteURL, imageURL); err == nil {
+				entry.SetThumbnail(absoluteImageURL, width, height)
+			}
+		}
+
 		// Populate the entry enclosures.
 		uniqueEnclosuresMap := make(map[string]bool)
 
@@ -240,6 +240,30 @@ func TestParseFeedURL(t *testing.T) {
 	}
 }
 
+func TestParseFeedWithWebSubHubLink(t *testing.T) {
+	data := `<?xml version="1.0" encoding="utf-8"?>
+	<feed xmlns="http://www.w3.org/2005/Atom">
+	  <title>Example Feed</title>
+	  <link rel="alternate" type="text/html" href="https://example.org/"/>
+	  <link rel="self" type="application/atom+xml" href="https://example.org/feed"/>
+	  <link rel="hub" href="https://pubsubhubbub.example.com/"/>
+	  <updated>2003-12-13T18:30:02Z</updated>
+	</feed>`
+
+	feed, err := Parse("https://example.org/", bytes.NewReader([]byte(data)), "10")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if feed.SelfURL != "https://example.org/feed" {
+		t.Errorf("Incorrect self URL, got: %s", feed.SelfURL)
+	}
+
+	if feed.HubURL != "https://pubsubhubbub.example.com/" {
+		t.Errorf("Incorrect hub URL, got: %s", feed.HubURL)
+	}
+}
+
 func TestParseFeedWithRelativeFeedURL(t *testing.T) {
 	data := `<?xml version="1.0" encoding="utf-8"?>
 	<feed xmlns="http://www.w3.org/2005/Atom">
@@ -2042,3 +2066,28 @@ func TestParseFeedWithUnqualifiedLangAttribute(t *testing.T) {
 		t.Errorf("Expected empty language for unqualified lang attribute, got: %q", feed.Language)
 	}
 }
+
+func TestParseEntryWithMediaThumbnail(t *testing.T) {
+	data := `<?xml version="1.0" encoding="utf-8"?>
+	<feed xmlns="http://www.w3.org/2005/Atom" xmlns:media="http://search.yahoo.com/mrss/">
+		<title>Example Feed</title>
+		<link href="http://example.org/"/>
+		<entry>
+			<title>Atom-Powered Robots Run Amok</title>
+			<link href="http://example.org/2003/12/13/atom03"/>
+			<id>urn:uuid:1225c695-cfb8-4ebb-aaaa-80da344efa6a</id>
+			<updated>2003-12-13T18:30:02Z</updated>
+			<media:thumbnail url="/thumbnail.jpg" width="320" height="180"/>
+		</entry>
+	</feed>`
+
+	feed, err := Parse("http://example.org/feed.xml", bytes.NewReader([]byte(data)), "10")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	entry := feed.Entries[0]
+	if entry.ThumbnailURL != "http://example.org/thumbnail.jpg" || entry.ThumbnailWidth != 320 || entry.ThumbnailHeight != 180 {
+		t.Errorf("Incorrect thumbnail, got: %q %dx%d", entry.ThumbnailURL, entry.ThumbnailWidth, entry.ThumbnailHeight)
+	}
+}
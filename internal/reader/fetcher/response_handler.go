@@ -33,6 +33,14 @@ func (r *ResponseHandler) EffectiveURL() string {
 	return r.httpResponse.Request.URL.String()
 }
 
+// StatusCode returns the HTTP status code of the response, or 0 if no response was received.
+func (r *ResponseHandler) StatusCode() int {
+	if r.httpResponse == nil {
+		return 0
+	}
+	return r.httpResponse.StatusCode
+}
+
 func (r *ResponseHandler) ContentType() string {
 	return r.httpResponse.Header.Get("Content-Type")
 }
@@ -124,6 +132,25 @@ func (r *ResponseHandler) IsRedirect() bool {
 			r.httpResponse.StatusCode == http.StatusPermanentRedirect)
 }
 
+// PermanentRedirectURL returns the final URL of the request if it has been reached
+// only through permanent redirects (301 or 308 status codes), or an empty string otherwise.
+func (r *ResponseHandler) PermanentRedirectURL() string {
+	if r.httpResponse == nil || r.httpResponse.Request == nil || r.httpResponse.Request.Response == nil {
+		return ""
+	}
+
+	// The HTTP client keeps the response that caused each redirect in the redirected request.
+	for request := r.httpResponse.Request; request != nil && request.Response != nil; request = request.Response.Request {
+		switch request.Response.StatusCode {
+		case http.StatusMovedPermanently, http.StatusPermanentRedirect:
+		default:
+			return ""
+		}
+	}
+
+	return r.EffectiveURL()
+}
+
 func (r *ResponseHandler) Close() {
 	if r.httpResponse != nil && r.httpResponse.Body != nil {
 		r.httpResponse.Body.Close()
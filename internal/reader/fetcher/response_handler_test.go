@@ -7,6 +7,7 @@ import (
 	"errors"
 	"io"
 	"net/http"
+	"net/url"
 	"testing"
 	"time"
 )
@@ -190,6 +191,76 @@ func TestCacheControlMaxAgeInMinutes(t *testing.T) {
 	}
 }
 
+func TestStatusCode(t *testing.T) {
+	rh := NewResponseHandler(&http.Response{StatusCode: http.StatusNotModified}, nil)
+	if rh.StatusCode() != http.StatusNotModified {
+		t.Errorf("Expected %d, got %d", http.StatusNotModified, rh.StatusCode())
+	}
+
+	rh = NewResponseHandler(nil, errors.New("connection refused"))
+	if rh.StatusCode() != 0 {
+		t.Errorf("Expected 0 when there is no response, got %d", rh.StatusCode())
+	}
+}
+
+func TestPermanentRedirectURL(t *testing.T) {
+	redirectedRequest := func(requestURL string, previousStatusCode int, previousRequest *http.Request) *http.Request {
+		parsedURL, _ := url.Parse(requestURL)
+		request := &http.Request{URL: parsedURL}
+		if previousRequest != nil {
+			request.Response = &http.Response{StatusCode: previousStatusCode, Request: previousRequest}
+		}
+		return request
+	}
+
+	originalRequest := redirectedRequest("https://example.org/feed", 0, nil)
+
+	scenarios := map[string]struct {
+		request  *http.Request
+		expected string
+	}{
+		"no redirect": {
+			request:  originalRequest,
+			expected: "",
+		},
+		"moved permanently": {
+			request:  redirectedRequest("https://example.org/new-feed", http.StatusMovedPermanently, originalRequest),
+			expected: "https://example.org/new-feed",
+		},
+		"permanent redirect": {
+			request:  redirectedRequest("https://example.org/new-feed", http.StatusPermanentRedirect, originalRequest),
+			expected: "https://example.org/new-feed",
+		},
+		"temporary redirect": {
+			request:  redirectedRequest("https://example.org/new-feed", http.StatusFound, originalRequest),
+			expected: "",
+		},
+		"chain of permanent redirects": {
+			request: redirectedRequest("https://example.com/feed", http.StatusPermanentRedirect,
+				redirectedRequest("https://example.org/new-feed", http.StatusMovedPermanently, originalRequest)),
+			expected: "https://example.com/feed",
+		},
+		"temporary redirect in the chain": {
+			request: redirectedRequest("https://example.com/feed", http.StatusMovedPermanently,
+				redirectedRequest("https://example.org/new-feed", http.StatusTemporaryRedirect, originalRequest)),
+			expected: "",
+		},
+	}
+
+	for name, scenario := range scenarios {
+		t.Run(name, func(t *testing.T) {
+			rh := NewResponseHandler(&http.Response{StatusCode: http.StatusOK, Request: scenario.request}, nil)
+			if result := rh.PermanentRedirectURL(); result != scenario.expected {
+				t.Errorf("Expected %q, got %q", scenario.expected, result)
+			}
+		})
+	}
+
+	if result := NewResponseHandler(nil, errors.New("connection refused")).PermanentRedirectURL(); result != "" {
+		t.Errorf("Expected an empty URL when there is no response, got %q", result)
+	}
+}
+
 func TestIsCloudflareChallenge(t *testing.T) {
 	makeResp := func(status int, headers map[string]string) *http.Response {
 		h := http.Header{}
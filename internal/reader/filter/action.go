@@ -0,0 +1,147 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package filter // import "miniflux.app/v2/internal/reader/filter"
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"miniflux.app/v2/internal/model"
+)
+
+// Actions available in action rules.
+//
+// An action rule is a filter rule followed by "=>" and a comma-separated list of actions:
+//
+//	EntryTitle=(?i)security => star, tag:security, notify
+//
+// User action rules are evaluated before feed action rules and only the first matching rule is applied.
+const (
+	ActionMarkAsRead        = "read"
+	ActionMarkAsUnread      = "unread"
+	ActionStar              = "star"
+	ActionNotify            = "notify"
+	ActionTagPrefix         = "tag:"
+	ActionIntegrationPrefix = "integration:"
+)
+
+const actionRuleSeparator = "=>"
+
+type actionRule struct {
+	filterRule
+	actions *model.EntryActions
+}
+
+// ActionRules is an ordered list of action rules.
+type ActionRules []actionRule
+
+// ParseActionRules returns the valid user and feed action rules, in evaluation order.
+func ParseActionRules(userRules, feedRules string) ActionRules {
+	rules := make(ActionRules, 0)
+	for _, rulesText := range []string{userRules, feedRules} {
+		for line := range strings.SplitSeq(strings.TrimSpace(rulesText), "\n") {
+			if rule, err := parseActionRule(line); err == nil {
+				rules = append(rules, rule)
+			}
+		}
+	}
+	return rules
+}
+
+// ValidateActionRule returns an error if the action rule would be ignored
+// or if it sends entries to an integration that is not in the given list.
+func ValidateActionRule(line string, integrationNames []string) error {
+	rule, err := parseActionRule(line)
+	if err != nil {
+		return err
+	}
+
+	for _, name := range rule.actions.Integrations {
+		if !slices.Contains(integrationNames, name) {
+			return fmt.Errorf(`unknown integration %q, valid integrations are %s`, name, strings.Join(integrationNames, ", "))
+		}
+	}
+
+	return nil
+}
+
+// MatchActionRules returns the actions of the first rule matching the entry, or nil.
+func MatchActionRules(rules ActionRules, entry *model.Entry) *model.EntryActions {
+	for _, rule := range rules {
+		if matchesRule(rule.filterRule, entry) {
+			return rule.actions
+		}
+	}
+	return nil
+}
+
+func parseActionRule(line string) (actionRule, error) {
+	line = strings.TrimSpace(strings.ReplaceAll(line, "\r\n", ""))
+
+	// The condition is a regex that may contain the separator, so the last one is used.
+	separatorIndex := strings.LastIndex(line, actionRuleSeparator)
+	if separatorIndex == -1 {
+		return actionRule{}, errors.New(`the rule must be in the format FieldName=Value => action1, action2`)
+	}
+
+	condition := line[:separatorIndex]
+	if err := ValidateRule(condition); err != nil {
+		return actionRule{}, err
+	}
+
+	actions, err := parseActions(line[separatorIndex+len(actionRuleSeparator):])
+	if err != nil {
+		return actionRule{}, err
+	}
+
+	_, rule := parseRule(condition)
+	return actionRule{filterRule: rule, actions: actions}, nil
+}
+
+func parseActions(actionsText string) (*model.EntryActions, error) {
+	actions := &model.EntryActions{}
+	hasAction := false
+
+	for action := range strings.SplitSeq(actionsText, ",") {
+		action = strings.TrimSpace(action)
+		if action == "" {
+			continue
+		}
+		hasAction = true
+
+		switch {
+		case action == ActionMarkAsRead, action == ActionMarkAsUnread:
+			if actions.Status != "" && actions.Status != action {
+				return nil, errors.New(`the actions "read" and "unread" cannot be combined`)
+			}
+			actions.Status = action
+		case action == ActionStar:
+			actions.Starred = true
+		case action == ActionNotify:
+			actions.Notify = true
+		case strings.HasPrefix(action, ActionTagPrefix):
+			tag := strings.TrimSpace(strings.TrimPrefix(action, ActionTagPrefix))
+			if tag == "" {
+				return nil, errors.New(`the tag name is required`)
+			}
+			actions.Tags = append(actions.Tags, tag)
+		case strings.HasPrefix(action, ActionIntegrationPrefix):
+			name := strings.TrimSpace(strings.TrimPrefix(action, ActionIntegrationPrefix))
+			if name == "" {
+				return nil, errors.New(`the integration name is required`)
+			}
+			actions.Integrations = append(actions.Integrations, name)
+		default:
+			return nil, fmt.Errorf(`unknown action %q`, action)
+		}
+	}
+
+	if !hasAction {
+		return nil, errors.New(`at least one action is required`)
+	}
+
+	return actions, nil
+}
@@ -0,0 +1,85 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package filter // import "miniflux.app/v2/internal/reader/filter"
+
+import (
+	"slices"
+	"testing"
+
+	"miniflux.app/v2/internal/model"
+)
+
+func TestValidateActionRule(t *testing.T) {
+	scenarios := map[string]bool{
+		"EntryTitle=(?i)cve => star, tag:security, notify": true,
+		"EntryAuthor=Bob => read":                          true,
+		"EntryURL=example.org => integration:wallabag":     true,
+		"EntryTitle=a=>b => unread":                        true,
+		"EntryTitle=(?i)cve":                               false,
+		"EntryTitle=(?i)cve =>":                            false,
+		"EntryTitle=(?i)cve => archive":                    false,
+		"EntryTitle=(?i)cve => tag:":                       false,
+		"EntryTitle=(?i)cve => integration:":               false,
+		"EntryTitle=(?i)cve => read, unread":               false,
+		"EntryUnknown=cve => star":                         false,
+		"EntryTitle=(unclosed => star":                     false,
+		"EntryTitle=(?i)cve => integration:unknown":        false,
+	}
+
+	for rule, valid := range scenarios {
+		if err := ValidateActionRule(rule, []string{"pinboard", "wallabag"}); (err == nil) != valid {
+			t.Errorf(`Unexpected validation result for %q: %v`, rule, err)
+		}
+	}
+}
+
+func TestParseActionRules(t *testing.T) {
+	rules := ParseActionRules(
+		"EntryTitle=(?i)cve => star, tag:security\ninvalid rule\n",
+		"EntryTitle=(?i)cve => read\r\nEntryAuthor=Bob => integration:pinboard, notify",
+	)
+
+	if len(rules) != 3 {
+		t.Fatalf(`Expected 3 rules, got %d`, len(rules))
+	}
+
+	if rules[0].Type != "EntryTitle" || rules[0].Value != "(?i)cve" {
+		t.Errorf(`Unexpected first rule: %+v`, rules[0].filterRule)
+	}
+
+	if !rules[0].actions.Starred || !slices.Equal(rules[0].actions.Tags, []string{"security"}) {
+		t.Errorf(`Unexpected actions for the first rule: %+v`, rules[0].actions)
+	}
+
+	if !rules[2].actions.Notify || !slices.Equal(rules[2].actions.Integrations, []string{"pinboard"}) {
+		t.Errorf(`Unexpected actions for the last rule: %+v`, rules[2].actions)
+	}
+}
+
+func TestMatchActionRulesFirstMatchWins(t *testing.T) {
+	rules := ParseActionRules(
+		"EntryTitle=(?i)cve => star",
+		"EntryTitle=(?i)cve => read\nEntryAuthor=Bob => unread",
+	)
+
+	entry := &model.Entry{Title: "New CVE published", Author: "Bob"}
+	actions := MatchActionRules(rules, entry)
+	if actions == nil {
+		t.Fatal(`Expected the entry to match an action rule`)
+	}
+
+	if !actions.Starred || actions.Status != "" {
+		t.Errorf(`Expected only the user rule to be applied, got %+v`, actions)
+	}
+
+	entry = &model.Entry{Title: "Weekly news", Author: "Bob"}
+	if actions := MatchActionRules(rules, entry); actions == nil || actions.Status != ActionMarkAsUnread {
+		t.Errorf(`Expected the feed rule to be applied, got %+v`, actions)
+	}
+
+	entry = &model.Entry{Title: "Weekly news", Author: "Alice"}
+	if actions := MatchActionRules(rules, entry); actions != nil {
+		t.Errorf(`Expected no action, got %+v`, actions)
+	}
+}
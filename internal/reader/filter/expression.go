@@ -0,0 +1,429 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package filter // import "miniflux.app/v2/internal/reader/filter"
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"miniflux.app/v2/internal/model"
+)
+
+// Expression rules combine field comparisons with AND, OR, NOT and parentheses, for example:
+//
+//	title ~ "(?i)golang" AND NOT author = "Bob"
+//	(tags contains "go" OR tags contains "rust") AND reading_time < 10
+//	enclosure_type contains "audio" AND date > "30d"
+//
+// Field names are lowercase, keywords are case-insensitive and NOT binds tighter than AND, which binds tighter than OR.
+// Values are double-quoted strings or bare words. Regular expressions use the RE2 syntax.
+// Dates are either in the YYYY-MM-DD format or a duration relative to the current time.
+// During a refresh, reading_time is estimated from the content, the watch time of videos is not known yet.
+// A rule starting with a parenthesis, NOT or a lowercase field name is an expression,
+// every other rule keeps the FieldName=Value format.
+
+const expressionRuleType = "Expression"
+
+var expressionFields = []string{"title", "url", "comments_url", "content", "author", "language", "tags", "enclosure_type", "reading_time", "date"}
+
+var (
+	textOperators   = []string{"~", "!~", "=", "!=", "contains"}
+	numberOperators = []string{"=", "!=", "<", "<=", ">", ">="}
+	dateOperators   = []string{"<", "<=", ">", ">="}
+)
+
+type exprNode interface {
+	matches(entry *model.Entry) bool
+}
+
+type andNode struct {
+	left, right exprNode
+}
+
+func (n *andNode) matches(entry *model.Entry) bool {
+	return n.left.matches(entry) && n.right.matches(entry)
+}
+
+type orNode struct {
+	left, right exprNode
+}
+
+func (n *orNode) matches(entry *model.Entry) bool {
+	return n.left.matches(entry) || n.right.matches(entry)
+}
+
+type notNode struct {
+	node exprNode
+}
+
+func (n *notNode) matches(entry *model.Entry) bool {
+	return !n.node.matches(entry)
+}
+
+type comparisonNode struct {
+	field    string
+	operator string
+	value    string
+	regex    *regexp.Regexp
+	number   int
+	date     time.Time
+	age      time.Duration
+}
+
+func (n *comparisonNode) matches(entry *model.Entry) bool {
+	switch n.field {
+	case "reading_time":
+		return compareNumbers(entry.ReadingTime, n.operator, n.number)
+	case "date":
+		referenceDate := n.date
+		if n.age > 0 {
+			referenceDate = time.Now().Add(-n.age)
+		}
+		return compareDates(entry.Date, n.operator, referenceDate)
+	case "tags":
+		return n.matchesAny(entry.Tags)
+	case "enclosure_type":
+		mimeTypes := make([]string, 0, len(entry.Enclosures))
+		for _, enclosure := range entry.Enclosures {
+			mimeTypes = append(mimeTypes, enclosure.MimeType)
+		}
+		return n.matchesAny(mimeTypes)
+	case "title":
+		return n.matchesText(entry.Title)
+	case "url":
+		return n.matchesText(entry.URL)
+	case "comments_url":
+		return n.matchesText(entry.CommentsURL)
+	case "content":
+		return n.matchesText(entry.Content)
+	case "author":
+		return n.matchesText(entry.Author)
+	case "language":
+		return n.matchesText(entry.Language)
+	}
+	return false
+}
+
+// matchesAny returns true if one of the values matches, or if none matches for negative operators.
+func (n *comparisonNode) matchesAny(values []string) bool {
+	switch n.operator {
+	case "!~", "!=":
+		return !slices.ContainsFunc(values, func(value string) bool { return !n.matchesText(value) })
+	default:
+		return slices.ContainsFunc(values, n.matchesText)
+	}
+}
+
+func (n *comparisonNode) matchesText(text string) bool {
+	switch n.operator {
+	case "~":
+		return n.regex.MatchString(text)
+	case "!~":
+		return !n.regex.MatchString(text)
+	case "=":
+		return strings.EqualFold(text, n.value)
+	case "!=":
+		return !strings.EqualFold(text, n.value)
+	case "contains":
+		return strings.Contains(strings.ToLower(text), strings.ToLower(n.value))
+	}
+	return false
+}
+
+func compareNumbers(value int, operator string, reference int) bool {
+	switch operator {
+	case "=":
+		return value == reference
+	case "!=":
+		return value != reference
+	case "<":
+		return value < reference
+	case "<=":
+		return value <= reference
+	case ">":
+		return value > reference
+	case ">=":
+		return value >= reference
+	}
+	return false
+}
+
+func compareDates(value time.Time, operator string, reference time.Time) bool {
+	switch operator {
+	case "<":
+		return value.Before(reference)
+	case "<=":
+		return !value.After(reference)
+	case ">":
+		return value.After(reference)
+	case ">=":
+		return !value.Before(reference)
+	}
+	return false
+}
+
+// IsExpressionRule returns true if the rule must be parsed as an expression rather than a FieldName=Value rule.
+func IsExpressionRule(rule string) bool {
+	rule = strings.TrimSpace(rule)
+	if strings.HasPrefix(rule, "(") {
+		return true
+	}
+
+	end := strings.IndexFunc(rule, func(r rune) bool { return !unicode.IsLetter(r) && r != '_' })
+	if end == -1 {
+		end = len(rule)
+	}
+
+	word := rule[:end]
+	return strings.EqualFold(word, "not") || slices.Contains(expressionFields, word)
+}
+
+type exprTokenKind int
+
+const (
+	tokenWord exprTokenKind = iota
+	tokenString
+	tokenOperator
+	tokenLeftParenthesis
+	tokenRightParenthesis
+)
+
+type exprToken struct {
+	kind exprTokenKind
+	text string
+}
+
+const operatorCharacters = "~!=<>"
+
+func tokenizeExpression(input string) ([]exprToken, error) {
+	var tokens []exprToken
+	for i := 0; i < len(input); {
+		switch c := input[i]; {
+		case c == ' ' || c == '\t':
+			i++
+		case c == '(':
+			tokens = append(tokens, exprToken{kind: tokenLeftParenthesis, text: "("})
+			i++
+		case c == ')':
+			tokens = append(tokens, exprToken{kind: tokenRightParenthesis, text: ")"})
+			i++
+		case c == '"':
+			// Only \" and \\ are escape sequences, other backslashes are kept for regular expressions.
+			var value strings.Builder
+			closed := false
+			for i++; i < len(input); i++ {
+				if input[i] == '\\' && i+1 < len(input) && (input[i+1] == '"' || input[i+1] == '\\') {
+					i++
+				} else if input[i] == '"' {
+					closed = true
+					i++
+					break
+				}
+				value.WriteByte(input[i])
+			}
+			if !closed {
+				return nil, errors.New(`unterminated string`)
+			}
+			tokens = append(tokens, exprToken{kind: tokenString, text: value.String()})
+		case strings.IndexByte(operatorCharacters, c) != -1:
+			j := i + 1
+			for j < len(input) && strings.IndexByte(operatorCharacters, input[j]) != -1 {
+				j++
+			}
+			tokens = append(tokens, exprToken{kind: tokenOperator, text: input[i:j]})
+			i = j
+		default:
+			j := i + 1
+			for j < len(input) && strings.IndexByte(" \t()\""+operatorCharacters, input[j]) == -1 {
+				j++
+			}
+			tokens = append(tokens, exprToken{kind: tokenWord, text: input[i:j]})
+			i = j
+		}
+	}
+	return tokens, nil
+}
+
+type exprParser struct {
+	tokens   []exprToken
+	position int
+}
+
+func parseExpression(input string) (exprNode, error) {
+	tokens, err := tokenizeExpression(input)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(tokens) == 0 {
+		return nil, errors.New(`the expression is empty`)
+	}
+
+	parser := &exprParser{tokens: tokens}
+	node, err := parser.parseOr()
+	if err != nil {
+		return nil, err
+	}
+
+	if token := parser.next(); token != nil {
+		return nil, fmt.Errorf(`unexpected %q`, token.text)
+	}
+
+	return node, nil
+}
+
+func (p *exprParser) next() *exprToken {
+	if p.position >= len(p.tokens) {
+		return nil
+	}
+	token := &p.tokens[p.position]
+	p.position++
+	return token
+}
+
+func (p *exprParser) accept(kind exprTokenKind, keyword string) bool {
+	if p.position >= len(p.tokens) {
+		return false
+	}
+
+	token := p.tokens[p.position]
+	if token.kind != kind || (keyword != "" && !strings.EqualFold(token.text, keyword)) {
+		return false
+	}
+
+	p.position++
+	return true
+}
+
+func (p *exprParser) parseOr() (exprNode, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.accept(tokenWord, "or") {
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &orNode{left: left, right: right}
+	}
+
+	return left, nil
+}
+
+func (p *exprParser) parseAnd() (exprNode, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.accept(tokenWord, "and") {
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = &andNode{left: left, right: right}
+	}
+
+	return left, nil
+}
+
+func (p *exprParser) parseUnary() (exprNode, error) {
+	if p.accept(tokenWord, "not") {
+		node, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &notNode{node: node}, nil
+	}
+
+	if p.accept(tokenLeftParenthesis, "") {
+		node, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+
+		if !p.accept(tokenRightParenthesis, "") {
+			return nil, errors.New(`missing closing parenthesis`)
+		}
+		return node, nil
+	}
+
+	return p.parseComparison()
+}
+
+func (p *exprParser) parseComparison() (exprNode, error) {
+	fieldToken := p.next()
+	if fieldToken == nil {
+		return nil, errors.New(`unexpected end of expression`)
+	}
+
+	if fieldToken.kind != tokenWord || !slices.Contains(expressionFields, fieldToken.text) {
+		return nil, fmt.Errorf(`unknown field %q, valid fields are %s`, fieldToken.text, strings.Join(expressionFields, ", "))
+	}
+
+	operatorToken := p.next()
+	if operatorToken == nil {
+		return nil, fmt.Errorf(`missing operator after %q`, fieldToken.text)
+	}
+
+	operator := operatorToken.text
+	if operatorToken.kind == tokenWord {
+		operator = strings.ToLower(operator)
+	} else if operatorToken.kind != tokenOperator {
+		return nil, fmt.Errorf(`expected an operator after %q, got %q`, fieldToken.text, operatorToken.text)
+	}
+
+	valueToken := p.next()
+	if valueToken == nil || (valueToken.kind != tokenWord && valueToken.kind != tokenString) {
+		return nil, fmt.Errorf(`missing value after %q`, fieldToken.text+" "+operator)
+	}
+
+	return newComparisonNode(fieldToken.text, operator, valueToken.text)
+}
+
+func newComparisonNode(field, operator, value string) (*comparisonNode, error) {
+	node := &comparisonNode{field: field, operator: operator, value: value}
+
+	var operators []string
+	switch field {
+	case "reading_time":
+		operators = numberOperators
+	case "date":
+		operators = dateOperators
+	default:
+		operators = textOperators
+	}
+
+	if !slices.Contains(operators, operator) {
+		return nil, fmt.Errorf(`the operator %q is not supported for the field %q, valid operators are %s`, operator, field, strings.Join(operators, ", "))
+	}
+
+	var err error
+	switch {
+	case field == "reading_time":
+		if node.number, err = strconv.Atoi(value); err != nil {
+			return nil, fmt.Errorf(`invalid number %q`, value)
+		}
+	case field == "date":
+		if node.date, err = time.Parse("2006-01-02", value); err != nil {
+			if node.age, err = parseDuration(value); err != nil || node.age <= 0 {
+				return nil, fmt.Errorf(`invalid date %q, use the YYYY-MM-DD format or a duration like 30d`, value)
+			}
+		}
+	case operator == "~" || operator == "!~":
+		if node.regex, err = regexp.Compile(value); err != nil {
+			return nil, fmt.Errorf(`invalid regular expression: %v`, err)
+		}
+	}
+
+	return node, nil
+}
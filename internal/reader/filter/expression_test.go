@@ -0,0 +1,140 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package filter // import "miniflux.app/v2/internal/reader/filter"
+
+import (
+	"testing"
+	"time"
+
+	"miniflux.app/v2/internal/model"
+)
+
+func TestIsExpressionRule(t *testing.T) {
+	scenarios := map[string]bool{
+		`title ~ "golang"`:            true,
+		`  (title ~ "a")`:             true,
+		`NOT author = "Bob"`:          true,
+		`not(tags contains "go")`:     true,
+		`reading_time > 5`:            true,
+		`EntryTitle=golang`:           false,
+		`Title=foo`:                   false,
+		`=value`:                      false,
+		`notes = "x"`:                 false,
+		`EntryDate=before:2024-01-01`: false,
+	}
+
+	for rule, expected := range scenarios {
+		if result := IsExpressionRule(rule); result != expected {
+			t.Errorf(`Unexpected result for %q, got %v instead of %v`, rule, result, expected)
+		}
+	}
+}
+
+func TestParseExpressionErrors(t *testing.T) {
+	scenarios := []string{
+		``,
+		`title`,
+		`title ~`,
+		`title ~ "unterminated`,
+		`title ~ "(unclosed"`,
+		`title < "a"`,
+		`summary = "a"`,
+		`reading_time > "five"`,
+		`reading_time contains 5`,
+		`date = 2024-01-01`,
+		`date > yesterday`,
+		`(title = "a"`,
+		`title = "a" )`,
+		`title = "a" AND`,
+		`title = "a" author = "b"`,
+		`title = "a" AND ("b")`,
+	}
+
+	for _, expression := range scenarios {
+		if _, err := parseExpression(expression); err == nil {
+			t.Errorf(`Expected an error for %q`, expression)
+		}
+	}
+}
+
+func TestExpressionMatches(t *testing.T) {
+	entry := &model.Entry{
+		Title:       "Go 1.25 Released",
+		URL:         "https://example.org/go-1.25",
+		CommentsURL: "https://news.example.org/item?id=1",
+		Content:     "<p>The Go team is happy to announce a new release.</p>",
+		Author:      "Alice",
+		Language:    "en",
+		Tags:        []string{"Go", "Release"},
+		ReadingTime: 4,
+		Date:        time.Now().Add(-48 * time.Hour),
+		Enclosures: model.EnclosureList{
+			{MimeType: "audio/mpeg"},
+		},
+	}
+
+	scenarios := map[string]bool{
+		`title ~ "(?i)go \d+\.\d+"`:                                true,
+		`title ~ "(?i)rust"`:                                       false,
+		`title !~ "(?i)rust"`:                                      true,
+		`title contains "released"`:                                true,
+		`title = "go 1.25 released"`:                               true,
+		`author = Alice AND NOT author = Bob`:                      true,
+		`author = Alice AND NOT title contains release`:            false,
+		`author = Bob OR url contains example.org`:                 true,
+		`author = Bob OR author = Carol AND title contains go`:     false,
+		`(author = Bob OR author = Alice) AND title contains go`:   true,
+		`tags = "go"`:                                              true,
+		`tags != "rust"`:                                           true,
+		`tags != "release"`:                                        false,
+		`tags ~ "^Rel"`:                                            true,
+		`enclosure_type contains audio`:                            true,
+		`enclosure_type contains video`:                            false,
+		`reading_time < 5 AND reading_time >= 4`:                   true,
+		`reading_time > 10`:                                        false,
+		`date > 7d`:                                                true,
+		`date < "1d"`:                                              true,
+		`date < 2000-01-01`:                                        false,
+		`date >= 2000-01-01 and language = en`:                     true,
+		`comments_url contains "news."`:                            true,
+		`content contains "announce" and not content contains "x"`: true,
+		`title = "Go \"1.25\""`:                                    false,
+		`not not title contains go`:                                true,
+	}
+
+	for expression, expected := range scenarios {
+		node, err := parseExpression(expression)
+		if err != nil {
+			t.Errorf(`Unable to parse %q: %v`, expression, err)
+			continue
+		}
+
+		if result := node.matches(entry); result != expected {
+			t.Errorf(`Unexpected result for %q, got %v instead of %v`, expression, result, expected)
+		}
+	}
+}
+
+func TestExpressionRulesAreBackwardCompatible(t *testing.T) {
+	entry := &model.Entry{Title: "Sponsored: buy now", Author: "Bob"}
+	feed := &model.Feed{}
+
+	blockRules := ParseRules(`EntryTitle=(?i)sponsored`, `title contains "sponsored" AND NOT author = "Bob"`)
+	if len(blockRules) != 2 {
+		t.Fatalf(`Expected 2 rules, got %d`, len(blockRules))
+	}
+
+	if !IsBlockedEntry(blockRules, nil, feed, entry) {
+		t.Error(`Expected the entry to be blocked by the legacy rule`)
+	}
+
+	blockRules = ParseRules(`title contains "sponsored" AND NOT author = "Bob"`, `title contains "(invalid`)
+	if len(blockRules) != 1 {
+		t.Fatalf(`Expected invalid expressions to be ignored, got %d rules`, len(blockRules))
+	}
+
+	if IsBlockedEntry(blockRules, nil, feed, entry) {
+		t.Error(`Expected the entry not to be blocked by the expression`)
+	}
+}
@@ -16,6 +16,7 @@
 // 4. Feed keep filter rules
 //
 // Each rule must be on a separate line.
+// Rules can also be boolean expressions combining several fields, see expression.go.
 // Duplicate rules are allowed. For example, having multiple EntryTitle rules is possible.
 // The provided regex should use the RE2 syntax.
 // The order of the rules matters as the processor stops on the first match for both Block and Keep rules.
@@ -37,8 +38,18 @@ import (
 )
 
 type filterRule struct {
-	Type  string
-	Value string
+	Type       string
+	Value      string
+	source     string
+	expression exprNode
+}
+
+// String returns the normalized text of the rule.
+func (r filterRule) String() string {
+	if r.expression != nil {
+		return r.Value
+	}
+	return r.Type + "=" + r.Value
 }
 
 type filterRules []filterRule
@@ -75,11 +86,13 @@ func ParseRules(userRules, feedRules string) filterRules {
 	rules := make(filterRules, 0)
 	for line := range strings.SplitSeq(strings.TrimSpace(userRules), "\n") {
 		if valid, filterRule := parseRule(line); valid {
+			filterRule.source = RuleSourceUser
 			rules = append(rules, filterRule)
 		}
 	}
 	for line := range strings.SplitSeq(strings.TrimSpace(feedRules), "\n") {
 		if valid, filterRule := parseRule(line); valid {
+			filterRule.source = RuleSourceFeed
 			rules = append(rules, filterRule)
 		}
 	}
@@ -88,6 +101,14 @@ func ParseRules(userRules, feedRules string) filterRules {
 
 func parseRule(userDefinedRule string) (bool, filterRule) {
 	userDefinedRule = strings.TrimSpace(strings.ReplaceAll(userDefinedRule, "\r\n", ""))
+	if IsExpressionRule(userDefinedRule) {
+		expression, err := parseExpression(userDefinedRule)
+		if err != nil {
+			return false, filterRule{}
+		}
+		return true, filterRule{Type: expressionRuleType, Value: userDefinedRule, expression: expression}
+	}
+
 	parts := strings.SplitN(userDefinedRule, "=", 2)
 	if len(parts) != 2 {
 		return false, filterRule{}
@@ -98,28 +119,53 @@ func parseRule(userDefinedRule string) (bool, filterRule) {
 	}
 }
 
+// IsBlockedEntry reports whether the entry is blocked by the filter rules, the matches are not recorded.
 func IsBlockedEntry(blockRules filterRules, allowRules filterRules, feed *model.Feed, entry *model.Entry) bool {
-	if matchesEntryFilterRules(blockRules, feed, entry) {
+	return isBlockedEntry(blockRules, allowRules, feed, entry, false)
+}
+
+// IsBlockedIncomingEntry reports whether an entry received during a feed refresh is blocked by the filter rules.
+// The matches are recorded in the statistics of the rules.
+func IsBlockedIncomingEntry(blockRules filterRules, allowRules filterRules, feed *model.Feed, entry *model.Entry) bool {
+	return isBlockedEntry(blockRules, allowRules, feed, entry, true)
+}
+
+func isBlockedEntry(blockRules filterRules, allowRules filterRules, feed *model.Feed, entry *model.Entry, recordHits bool) bool {
+	recordHit := func(source, rule string) {
+		if recordHits {
+			recordRuleHit(feed, source, rule)
+		}
+	}
+
+	if rule, found := matchingEntryFilterRule(blockRules, feed, entry); found {
+		recordHit(rule.source, rule.String())
 		return true
 	}
 
 	if matches, valid := matchesEntryRegexRules(feed.BlocklistRules, feed, entry); valid && matches {
+		recordHit(RuleSourceFeed, feed.BlocklistRules)
 		return true
 	}
 
 	// If allow rules exist, only entries that match them should be retained
 	if len(allowRules) > 0 {
-		if !matchesEntryFilterRules(allowRules, feed, entry) {
+		rule, found := matchingEntryFilterRule(allowRules, feed, entry)
+		if !found {
 			return true // Block entry if it doesn't match any allow rules
 		}
+		recordHit(rule.source, rule.String())
 		return false // Allow entry if it matches allow rules
 	}
 
 	// If keeplist rules exist, only entries that match them should be retained
 	if feed.KeeplistRules != "" {
-		if matches, valid := matchesEntryRegexRules(feed.KeeplistRules, feed, entry); valid && !matches {
+		matches, valid := matchesEntryRegexRules(feed.KeeplistRules, feed, entry)
+		if valid && !matches {
 			return true // Block entry if it doesn't match keeplist rules
 		}
+		if matches {
+			recordHit(RuleSourceFeed, feed.KeeplistRules)
+		}
 		return false // Allow entry if it matches keeplist rules or rule is invalid (ignored)
 	}
 
@@ -160,6 +206,12 @@ func matchesEntryRegexRules(regexPattern string, feed *model.Feed, entry *model.
 }
 
 func matchesEntryFilterRules(rules filterRules, feed *model.Feed, entry *model.Entry) bool {
+	_, found := matchingEntryFilterRule(rules, feed, entry)
+	return found
+}
+
+// matchingEntryFilterRule returns the first rule matching the entry.
+func matchingEntryFilterRule(rules filterRules, feed *model.Feed, entry *model.Entry) (filterRule, bool) {
 	for _, rule := range rules {
 		if matchesRule(rule, entry) {
 			slog.Debug("Entry matches filter rule",
@@ -170,13 +222,17 @@ func matchesEntryFilterRules(rules filterRules, feed *model.Feed, entry *model.E
 				slog.String("rule_type", rule.Type),
 				slog.String("rule_value", rule.Value),
 			)
-			return true
+			return rule, true
 		}
 	}
-	return false
+	return filterRule{}, false
 }
 
 func matchesRule(rule filterRule, entry *model.Entry) bool {
+	if rule.expression != nil {
+		return rule.expression.matches(entry)
+	}
+
 	if rule.Type == "EntryDate" {
 		return isDateMatchingPattern(rule.Value, entry.Date)
 	}
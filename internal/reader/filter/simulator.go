@@ -0,0 +1,214 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package filter // import "miniflux.app/v2/internal/reader/filter"
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"miniflux.app/v2/internal/model"
+)
+
+// Rule sources and kinds reported by the simulator.
+const (
+	RuleSourceUser = "user"
+	RuleSourceFeed = "feed"
+	RuleKindBlock  = "block"
+	RuleKindKeep   = "keep"
+)
+
+// maxSimulationSampleEntries is the number of matched entries reported for each rule.
+const maxSimulationSampleEntries = 10
+
+var ruleTypes = []string{"EntryTitle", "EntryURL", "EntryCommentsURL", "EntryContent", "EntryAuthor", "EntryTag", "EntryDate"}
+
+// ValidateRule returns an error if the rule line would be ignored or could never match.
+func ValidateRule(line string) error {
+	if rule := strings.TrimSpace(strings.ReplaceAll(line, "\r\n", "")); IsExpressionRule(rule) {
+		_, err := parseExpression(rule)
+		return err
+	}
+
+	valid, rule := parseRule(line)
+	if !valid {
+		return errors.New(`the rule must be in the format FieldName=Value`)
+	}
+
+	if !slices.Contains(ruleTypes, rule.Type) {
+		return fmt.Errorf(`unknown field %q, valid fields are %s`, rule.Type, strings.Join(ruleTypes, ", "))
+	}
+
+	if rule.Value == "" {
+		return errors.New(`the value is required`)
+	}
+
+	if rule.Type == "EntryDate" {
+		return validateDatePattern(rule.Value)
+	}
+
+	if _, err := regexp.Compile(rule.Value); err != nil {
+		return fmt.Errorf(`invalid regular expression: %v`, err)
+	}
+
+	return nil
+}
+
+func validateDatePattern(pattern string) error {
+	if pattern == "future" {
+		return nil
+	}
+
+	ruleType, inputDate, found := strings.Cut(pattern, ":")
+	if !found {
+		return fmt.Errorf(`invalid date pattern %q`, pattern)
+	}
+
+	var err error
+	switch ruleType {
+	case "before", "after":
+		_, err = time.Parse("2006-01-02", inputDate)
+	case "between":
+		startDate, endDate, found := strings.Cut(inputDate, ",")
+		if !found {
+			return fmt.Errorf(`the date range %q must contain two dates`, inputDate)
+		}
+		if _, err = time.Parse("2006-01-02", startDate); err == nil {
+			_, err = time.Parse("2006-01-02", endDate)
+		}
+	case "max-age":
+		_, err = parseDuration(inputDate)
+	default:
+		return fmt.Errorf(`unknown date operator %q`, ruleType)
+	}
+
+	if err != nil {
+		return fmt.Errorf(`invalid date pattern %q: %v`, pattern, err)
+	}
+
+	return nil
+}
+
+type simulatedRule struct {
+	filterRule
+	result *model.FilterRuleSimulation
+}
+
+// Simulator evaluates block and keep rules against stored entries without modifying them.
+// Unlike ParseRules, each rule keeps its line number and invalid rules are reported instead of being ignored.
+type Simulator struct {
+	blockRules []*simulatedRule
+	keepRules  []*simulatedRule
+	result     *model.FilterSimulation
+}
+
+// NewSimulator parses the rules in the same order as the processor: user rules first, then feed rules.
+func NewSimulator(userBlockRules, feedBlockRules, userKeepRules, feedKeepRules string) *Simulator {
+	s := &Simulator{
+		result: &model.FilterSimulation{
+			NotKeptEntries: make([]*model.FilterSimulationEntry, 0),
+			Rules:          make([]*model.FilterRuleSimulation, 0),
+			Errors:         make([]*model.FilterRuleError, 0),
+		},
+	}
+
+	s.blockRules = append(s.parseRules(RuleSourceUser, RuleKindBlock, userBlockRules), s.parseRules(RuleSourceFeed, RuleKindBlock, feedBlockRules)...)
+	s.keepRules = append(s.parseRules(RuleSourceUser, RuleKindKeep, userKeepRules), s.parseRules(RuleSourceFeed, RuleKindKeep, feedKeepRules)...)
+
+	return s
+}
+
+func (s *Simulator) parseRules(source, kind, rulesText string) []*simulatedRule {
+	var rules []*simulatedRule
+
+	for i, line := range strings.Split(strings.TrimSpace(rulesText), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		if err := ValidateRule(line); err != nil {
+			s.result.Errors = append(s.result.Errors, &model.FilterRuleError{
+				Source: source,
+				Kind:   kind,
+				Line:   i + 1,
+				Rule:   line,
+				Error:  err.Error(),
+			})
+			continue
+		}
+
+		_, rule := parseRule(line)
+		ruleResult := &model.FilterRuleSimulation{
+			Source:  source,
+			Kind:    kind,
+			Line:    i + 1,
+			Rule:    line,
+			Entries: make([]*model.FilterSimulationEntry, 0),
+		}
+		s.result.Rules = append(s.result.Rules, ruleResult)
+		rules = append(rules, &simulatedRule{filterRule: rule, result: ruleResult})
+	}
+
+	return rules
+}
+
+// Evaluate records the rules matching the entry. Every rule is evaluated to report all matches,
+// but only the first matching rule decides the outcome, like during feed refreshes.
+func (s *Simulator) Evaluate(entry *model.Entry) {
+	s.result.EntriesCount++
+
+	blocked := s.evaluateRules(s.blockRules, entry)
+	if blocked {
+		s.result.BlockedCount++
+	}
+
+	kept := s.evaluateRules(s.keepRules, entry)
+	if !blocked && len(s.keepRules) > 0 && !kept {
+		s.result.NotKeptCount++
+		if len(s.result.NotKeptEntries) < maxSimulationSampleEntries {
+			s.result.NotKeptEntries = append(s.result.NotKeptEntries, newSimulationEntry(entry, false))
+		}
+	}
+}
+
+func (s *Simulator) evaluateRules(rules []*simulatedRule, entry *model.Entry) bool {
+	matched := false
+	for _, rule := range rules {
+		if !matchesRule(rule.filterRule, entry) {
+			continue
+		}
+
+		firstMatch := !matched
+		matched = true
+
+		rule.result.MatchCount++
+		if firstMatch {
+			rule.result.FirstMatchCount++
+		}
+
+		if len(rule.result.Entries) < maxSimulationSampleEntries {
+			rule.result.Entries = append(rule.result.Entries, newSimulationEntry(entry, firstMatch))
+		}
+	}
+	return matched
+}
+
+// Result returns the outcome of the evaluated entries.
+func (s *Simulator) Result() *model.FilterSimulation {
+	return s.result
+}
+
+func newSimulationEntry(entry *model.Entry, firstMatch bool) *model.FilterSimulationEntry {
+	return &model.FilterSimulationEntry{
+		EntryID:    entry.ID,
+		FeedID:     entry.FeedID,
+		Title:      entry.Title,
+		URL:        entry.URL,
+		FirstMatch: firstMatch,
+	}
+}
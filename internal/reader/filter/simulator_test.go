@@ -0,0 +1,100 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package filter // import "miniflux.app/v2/internal/reader/filter"
+
+import (
+	"testing"
+
+	"miniflux.app/v2/internal/model"
+)
+
+func TestValidateRule(t *testing.T) {
+	scenarios := map[string]bool{
+		"EntryTitle=(?i)golang":                   true,
+		"EntryTag=go|rust":                        true,
+		"EntryDate=future":                        true,
+		"EntryDate=before:2024-01-01":             true,
+		"EntryDate=between:2024-01-01,2024-12-31": true,
+		"EntryDate=max-age:30d":                   true,
+		"EntryTitle":                              false,
+		"EntryTitle=":                             false,
+		"EntryUnknown=test":                       false,
+		"EntryTitle=(unclosed":                    false,
+		"EntryDate=before:yesterday":              false,
+		"EntryDate=between:2024-01-01":            false,
+		"EntryDate=sometime:2024-01-01":           false,
+		"EntryDate=max-age:forever":               false,
+	}
+
+	for rule, valid := range scenarios {
+		if err := ValidateRule(rule); (err == nil) != valid {
+			t.Errorf(`Unexpected validation result for %q: %v`, rule, err)
+		}
+	}
+}
+
+func TestSimulator(t *testing.T) {
+	simulator := NewSimulator(
+		"EntryTitle=(?i)sponsored\nEntryTitle=(unclosed",
+		"EntryAuthor=Spammer\n\nEntryTitle=(?i)deal",
+		"",
+		"EntryTag=golang",
+	)
+
+	entries := []*model.Entry{
+		{ID: 1, FeedID: 1, Title: "Sponsored deal", Author: "Spammer"},
+		{ID: 2, FeedID: 1, Title: "Best deal", Tags: []string{"golang"}},
+		{ID: 3, FeedID: 1, Title: "Go 1.25 released", Tags: []string{"golang"}},
+		{ID: 4, FeedID: 1, Title: "Rust release"},
+	}
+
+	for _, entry := range entries {
+		simulator.Evaluate(entry)
+	}
+
+	result := simulator.Result()
+
+	if result.EntriesCount != 4 {
+		t.Errorf(`Unexpected number of entries, got %d`, result.EntriesCount)
+	}
+
+	if result.BlockedCount != 2 {
+		t.Errorf(`Unexpected number of blocked entries, got %d`, result.BlockedCount)
+	}
+
+	if result.NotKeptCount != 1 || result.NotKeptEntries[0].EntryID != 4 {
+		t.Errorf(`Unexpected entries not kept, got %d`, result.NotKeptCount)
+	}
+
+	if len(result.Errors) != 1 || result.Errors[0].Line != 2 || result.Errors[0].Source != RuleSourceUser {
+		t.Fatalf(`Unexpected errors, got %+v`, result.Errors)
+	}
+
+	if len(result.Rules) != 4 {
+		t.Fatalf(`Unexpected number of rules, got %d`, len(result.Rules))
+	}
+
+	expected := []struct {
+		source          string
+		kind            string
+		line            int
+		matchCount      int
+		firstMatchCount int
+	}{
+		{RuleSourceUser, RuleKindBlock, 1, 1, 1},
+		{RuleSourceFeed, RuleKindBlock, 1, 1, 0},
+		{RuleSourceFeed, RuleKindBlock, 3, 2, 1},
+		{RuleSourceFeed, RuleKindKeep, 1, 2, 2},
+	}
+
+	for i, rule := range result.Rules {
+		if rule.Source != expected[i].source || rule.Kind != expected[i].kind || rule.Line != expected[i].line {
+			t.Errorf(`Unexpected rule #%d, got %+v`, i, rule)
+		}
+
+		if rule.MatchCount != expected[i].matchCount || rule.FirstMatchCount != expected[i].firstMatchCount {
+			t.Errorf(`Unexpected counters for rule %q, got %d matches and %d first matches`, rule.Rule, rule.MatchCount, rule.FirstMatchCount)
+		}
+	}
+}
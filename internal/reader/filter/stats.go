@@ -0,0 +1,81 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package filter // import "miniflux.app/v2/internal/reader/filter"
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"miniflux.app/v2/internal/model"
+)
+
+type ruleHitKey struct {
+	userID int64
+	feedID int64
+	rule   string
+}
+
+// ruleHits accumulates the matches in memory until they are flushed to the database.
+var ruleHits = struct {
+	sync.Mutex
+	stats map[ruleHitKey]*model.FilterRuleStat
+}{stats: make(map[ruleHitKey]*model.FilterRuleStat)}
+
+// recordRuleHit counts a match of a rule, user rules are not attached to a feed.
+func recordRuleHit(feed *model.Feed, source, rule string) {
+	if feed.UserID == 0 {
+		return
+	}
+
+	key := ruleHitKey{userID: feed.UserID, rule: rule}
+	if source == RuleSourceFeed {
+		key.feedID = feed.ID
+	}
+
+	ruleHits.Lock()
+	defer ruleHits.Unlock()
+
+	stat, found := ruleHits.stats[key]
+	if !found {
+		stat = &model.FilterRuleStat{UserID: key.userID, FeedID: key.feedID, Rule: rule}
+		ruleHits.stats[key] = stat
+	}
+	stat.HitCount++
+	stat.LastMatchedAt = time.Now()
+}
+
+// FlushRuleHits returns the matches recorded since the last call and resets the counters.
+func FlushRuleHits() model.FilterRuleStats {
+	ruleHits.Lock()
+	defer ruleHits.Unlock()
+
+	stats := make(model.FilterRuleStats, 0, len(ruleHits.stats))
+	for _, stat := range ruleHits.stats {
+		stats = append(stats, stat)
+	}
+	clear(ruleHits.stats)
+	return stats
+}
+
+// NormalizeRule returns the rule text used to record the matches of a filter rule.
+func NormalizeRule(line string) string {
+	if valid, rule := parseRule(line); valid {
+		return rule.String()
+	}
+	return strings.TrimSpace(line)
+}
+
+// NormalizeRules returns the normalized text of each non-empty line of the given filter rules.
+func NormalizeRules(rulesTexts ...string) []string {
+	rules := make([]string, 0)
+	for _, rulesText := range rulesTexts {
+		for line := range strings.SplitSeq(rulesText, "\n") {
+			if strings.TrimSpace(line) != "" {
+				rules = append(rules, NormalizeRule(line))
+			}
+		}
+	}
+	return rules
+}
@@ -0,0 +1,119 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package filter // import "miniflux.app/v2/internal/reader/filter"
+
+import (
+	"slices"
+	"testing"
+
+	"miniflux.app/v2/internal/model"
+)
+
+func TestRuleHitsAreRecorded(t *testing.T) {
+	FlushRuleHits()
+
+	feed := &model.Feed{ID: 42, UserID: 1, BlocklistRules: "(?i)spam"}
+	blockRules := ParseRules("EntryTitle=(?i)sponsored", "  EntryAuthor  =  Bob  ")
+
+	entries := []*model.Entry{
+		{Title: "Sponsored post"},
+		{Title: "Sponsored post again"},
+		{Title: "Hello", Author: "Bob"},
+		{Title: "Spam"},
+		{Title: "Regular post"},
+	}
+	for _, entry := range entries {
+		IsBlockedIncomingEntry(blockRules, nil, feed, entry)
+	}
+
+	stats := FlushRuleHits()
+	if len(stats) != 3 {
+		t.Fatalf(`Expected 3 rules with hits, got %d`, len(stats))
+	}
+
+	if stat := stats.Find(0, "EntryTitle=(?i)sponsored"); stat == nil || stat.HitCount != 2 || stat.UserID != 1 {
+		t.Errorf(`Unexpected statistics for the user rule: %+v`, stat)
+	}
+
+	if stat := stats.Find(42, "EntryAuthor=Bob"); stat == nil || stat.HitCount != 1 || stat.LastMatchedAt.IsZero() {
+		t.Errorf(`Unexpected statistics for the feed rule: %+v`, stat)
+	}
+
+	if stat := stats.Find(42, "(?i)spam"); stat == nil || stat.HitCount != 1 {
+		t.Errorf(`Unexpected statistics for the blocklist rule: %+v`, stat)
+	}
+
+	if stats := FlushRuleHits(); len(stats) != 0 {
+		t.Errorf(`Expected the counters to be reset, got %d rules`, len(stats))
+	}
+}
+
+func TestRuleHitsAreNotRecordedWithoutUser(t *testing.T) {
+	FlushRuleHits()
+
+	IsBlockedIncomingEntry(ParseRules("EntryTitle=.*", ""), nil, &model.Feed{}, &model.Entry{Title: "Test"})
+
+	if stats := FlushRuleHits(); len(stats) != 0 {
+		t.Errorf(`Expected no statistics, got %d rules`, len(stats))
+	}
+}
+
+func TestRuleHitsAreNotRecordedOutsideRefreshes(t *testing.T) {
+	FlushRuleHits()
+
+	feed := &model.Feed{ID: 42, UserID: 1, BlocklistRules: "(?i)spam", KeeplistRules: "(?i)post"}
+	IsBlockedEntry(ParseRules("EntryTitle=(?i)sponsored", ""), nil, feed, &model.Entry{Title: "Sponsored post"})
+	IsBlockedEntry(nil, nil, feed, &model.Entry{Title: "Spam"})
+	IsBlockedEntry(nil, ParseRules("EntryTitle=(?i)post", ""), feed, &model.Entry{Title: "Regular post"})
+
+	if stats := FlushRuleHits(); len(stats) != 0 {
+		t.Errorf(`Expected no statistics, got %d rules`, len(stats))
+	}
+}
+
+func TestKeeplistAndAllowRuleHitsAreRecorded(t *testing.T) {
+	FlushRuleHits()
+
+	feed := &model.Feed{ID: 42, UserID: 1, KeeplistRules: "(?i)release"}
+	IsBlockedIncomingEntry(nil, nil, feed, &model.Entry{Title: "Release 1.0"})
+	IsBlockedIncomingEntry(nil, nil, feed, &model.Entry{Title: "Other"})
+	IsBlockedIncomingEntry(nil, ParseRules("EntryTitle=(?i)changelog", ""), feed, &model.Entry{Title: "Changelog"})
+
+	stats := FlushRuleHits()
+	if stat := stats.Find(42, "(?i)release"); stat == nil || stat.HitCount != 1 {
+		t.Errorf(`Unexpected statistics for the keeplist rule: %+v`, stat)
+	}
+
+	if stat := stats.Find(0, "EntryTitle=(?i)changelog"); stat == nil || stat.HitCount != 1 {
+		t.Errorf(`Unexpected statistics for the allow rule: %+v`, stat)
+	}
+}
+
+func TestNormalizeRule(t *testing.T) {
+	scenarios := map[string]string{
+		"  EntryTitle  =  test  ": "EntryTitle=test",
+		"EntryTitle=test\r\n":     "EntryTitle=test",
+		` title contains "a" `:    `title contains "a"`,
+		"not a rule ":             "not a rule",
+		"EntryContent=x=y":        "EntryContent=x=y",
+	}
+
+	for input, expected := range scenarios {
+		if result := NormalizeRule(input); result != expected {
+			t.Errorf(`Unexpected result for %q, got %q instead of %q`, input, result, expected)
+		}
+	}
+}
+
+func TestNormalizeRules(t *testing.T) {
+	rules := NormalizeRules("EntryTitle = a\r\n\n  \nEntryURL=b", "", ` title contains "c" `)
+	expected := []string{"EntryTitle=a", "EntryURL=b", `title contains "c"`}
+	if !slices.Equal(rules, expected) {
+		t.Errorf(`Unexpected rules, got %q instead of %q`, rules, expected)
+	}
+
+	if rules := NormalizeRules("", " "); rules == nil || len(rules) != 0 {
+		t.Errorf(`Expected an empty list of rules, got %#v`, rules)
+	}
+}
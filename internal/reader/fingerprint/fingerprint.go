@@ -0,0 +1,71 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package fingerprint computes similarity fingerprints to detect articles telling the same story.
+package fingerprint // import "miniflux.app/v2/internal/reader/fingerprint"
+
+import (
+	"hash/fnv"
+	"math/bits"
+	"strings"
+	"unicode"
+
+	"miniflux.app/v2/internal/reader/sanitizer"
+)
+
+// MaxDistance is the maximum number of different bits between the fingerprints of two similar articles.
+const MaxDistance = 3
+
+// Articles with fewer words do not contain enough information to be compared.
+const minWords = 8
+
+// Compute returns the 64-bit SimHash of the title and the text of the article.
+// Each word is a feature and the word order is ignored: shingles of consecutive words change too many
+// features when a republished article adds a short sentence, the articles are not long enough to absorb it.
+// Zero is returned when the article is too short.
+func Compute(title, content string) int64 {
+	words := tokenize(title + " " + sanitizer.StripTags(content))
+	if len(words) < minWords {
+		return 0
+	}
+
+	var weights [64]int
+	for _, word := range words {
+		hasher := fnv.New64a()
+		hasher.Write([]byte(word))
+		featureHash := hasher.Sum64()
+
+		for bit := range 64 {
+			if featureHash&(1<<bit) != 0 {
+				weights[bit]++
+			} else {
+				weights[bit]--
+			}
+		}
+	}
+
+	var simhash uint64
+	for bit, weight := range weights {
+		if weight > 0 {
+			simhash |= 1 << bit
+		}
+	}
+
+	return int64(simhash)
+}
+
+// Distance returns the number of different bits between two fingerprints.
+func Distance(a, b int64) int {
+	return bits.OnesCount64(uint64(a ^ b))
+}
+
+// IsSimilar reports whether both fingerprints belong to the same story.
+func IsSimilar(a, b int64) bool {
+	return a != 0 && b != 0 && Distance(a, b) <= MaxDistance
+}
+
+func tokenize(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+}
@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package fingerprint // import "miniflux.app/v2/internal/reader/fingerprint"
+
+import "testing"
+
+const story = `<p>The city council approved on Tuesday a new budget that increases funding for public transportation,
+libraries and parks, while reducing the spending on road construction for the next three years.
+The mayor said the decision reflects the priorities expressed by residents during the consultation held last spring.</p>`
+
+func TestComputeShortArticle(t *testing.T) {
+	if result := Compute("Title", "<p>Too short</p>"); result != 0 {
+		t.Errorf(`Short articles should not have a fingerprint, got %d`, result)
+	}
+}
+
+func TestComputeIsDeterministic(t *testing.T) {
+	if Compute("Budget approved", story) != Compute("Budget approved", story) {
+		t.Error(`The fingerprint should be the same for the same article`)
+	}
+}
+
+func TestSimilarArticles(t *testing.T) {
+	original := Compute("City council approves new budget", story)
+	republished := Compute("City Council approves new budget", story+"<p>Read more on our website.</p>")
+
+	if !IsSimilar(original, republished) {
+		t.Errorf(`Republished articles should be similar, the distance is %d`, Distance(original, republished))
+	}
+}
+
+func TestDifferentArticles(t *testing.T) {
+	original := Compute("City council approves new budget", story)
+	other := Compute("Local team wins the championship", `<p>The local football team won the national championship on Sunday
+after a dramatic final, scoring twice in the last ten minutes in front of a sold-out stadium and thousands of fans.</p>`)
+
+	if IsSimilar(original, other) {
+		t.Errorf(`Different articles should not be similar, the distance is %d`, Distance(original, other))
+	}
+}
+
+func TestIsSimilarWithoutFingerprint(t *testing.T) {
+	if IsSimilar(0, 0) {
+		t.Error(`Articles without fingerprint should never be similar`)
+	}
+}
+
+func TestDistance(t *testing.T) {
+	scenarios := []struct {
+		a, b     int64
+		expected int
+	}{
+		{0, 0, 0},
+		{0b1011, 0b0001, 2},
+		{-1, 0, 64},
+	}
+
+	for _, scenario := range scenarios {
+		if result := Distance(scenario.a, scenario.b); result != scenario.expected {
+			t.Errorf(`Unexpected distance between %b and %b, got %d instead of %d`, scenario.a, scenario.b, result, scenario.expected)
+		}
+	}
+}
@@ -5,6 +5,7 @@ package handler // import "miniflux.app/v2/internal/reader/handler"
 
 import (
 	"bytes"
+	"cmp"
 	"errors"
 	"log/slog"
 	"time"
@@ -14,13 +15,19 @@ import (
 	"miniflux.app/v2/internal/locale"
 	"miniflux.app/v2/internal/model"
 	"miniflux.app/v2/internal/proxyrotator"
+	"miniflux.app/v2/internal/reader/encoding"
 	"miniflux.app/v2/internal/reader/fetcher"
 	"miniflux.app/v2/internal/reader/icon"
+	"miniflux.app/v2/internal/reader/pagefeed"
 	"miniflux.app/v2/internal/reader/parser"
 	"miniflux.app/v2/internal/reader/processor"
+	"miniflux.app/v2/internal/reader/websub"
 	"miniflux.app/v2/internal/storage"
 )
 
+// webSubRetryInterval is the delay before a subscription that the hub never verified, denied or let expire is requested again.
+const webSubRetryInterval = 24 * time.Hour
+
 var (
 	ErrCategoryNotFound = errors.New("fetcher: category not found")
 	ErrFeedNotFound     = errors.New("fetcher: feed not found")
@@ -101,6 +108,10 @@ func CreateFeedFromSubscriptionDiscovery(store *storage.Storage, userID int64, f
 
 	icon.NewIconChecker(store, subscription).UpdateOrCreateFeedIcon()
 
+	if config.Opts.WebSub() && subscription.HubURL != "" {
+		go subscribeToHub(store, subscription.UserID, subscription.ID, subscription.HubURL, cmp.Or(subscription.SelfURL, subscription.FeedURL))
+	}
+
 	return subscription, nil
 }
 
@@ -150,9 +161,9 @@ func CreateFeed(store *storage.Storage, userID int64, feedCreationRequest *model
 		return nil, locale.NewLocalizedErrorWrapper(ErrDuplicatedFeed, "error.duplicated_feed")
 	}
 
-	subscription, parseErr := parser.ParseFeed(responseHandler.EffectiveURL(), bytes.NewReader(responseBody))
-	if parseErr != nil {
-		return nil, locale.NewLocalizedErrorWrapper(parseErr, "error.unable_to_parse_feed", parseErr)
+	subscription, localizedError := parseFeed(responseHandler.EffectiveURL(), responseBody, responseHandler.ContentType(), &feedCreationRequest.PageFeedSelectors)
+	if localizedError != nil {
+		return nil, localizedError
 	}
 
 	subscription.UserID = userID
@@ -180,6 +191,7 @@ func CreateFeed(store *storage.Storage, userID int64, feedCreationRequest *model
 	subscription.LastModifiedHeader = responseHandler.LastModified()
 	subscription.FeedURL = responseHandler.EffectiveURL()
 	subscription.ProxyURL = feedCreationRequest.ProxyURL
+	subscription.PageFeedSelectors = feedCreationRequest.PageFeedSelectors
 	subscription.WithCategoryID(feedCreationRequest.CategoryID)
 	subscription.CheckedNow()
 
@@ -197,11 +209,16 @@ func CreateFeed(store *storage.Storage, userID int64, feedCreationRequest *model
 
 	icon.NewIconChecker(store, subscription).UpdateOrCreateFeedIcon()
 
+	if config.Opts.WebSub() && subscription.HubURL != "" {
+		go subscribeToHub(store, subscription.UserID, subscription.ID, subscription.HubURL, cmp.Or(subscription.SelfURL, subscription.FeedURL))
+	}
+
 	return subscription, nil
 }
 
 // RefreshFeed refreshes a feed.
-func RefreshFeed(store *storage.Storage, userID, feedID int64, forceRefresh bool) *locale.LocalizedErrorWrapper {
+// Each refresh attempt is recorded in the feed fetch history.
+func RefreshFeed(store *storage.Storage, userID, feedID int64, forceRefresh bool) (refreshErr *locale.LocalizedErrorWrapper) {
 	slog.Debug("Begin feed refresh process",
 		slog.Int64("user_id", userID),
 		slog.Int64("feed_id", feedID),
@@ -217,6 +234,14 @@ func RefreshFeed(store *storage.Storage, userID, feedID int64, forceRefresh bool
 		return locale.NewLocalizedErrorWrapper(ErrFeedNotFound, "error.feed_not_found")
 	}
 
+	if originalFeed.IsNewsletter() {
+		slog.Debug("Skipping refresh of newsletter feed",
+			slog.Int64("user_id", userID),
+			slog.Int64("feed_id", feedID),
+		)
+		return nil
+	}
+
 	weeklyEntryCount := 0
 	if config.Opts.PollingScheduler() == model.SchedulerEntryFrequency {
 		var weeklyCountErr error
@@ -226,8 +251,31 @@ func RefreshFeed(store *storage.Storage, userID, feedID int64, forceRefresh bool
 		}
 	}
 
+	// Feeds with an active push subscription receive updates from the hub,
+	// polling them is only a fallback in case the hub misses an update.
+	var webSubSubscription *model.WebSubSubscription
+	pushRefreshDelay := time.Duration(0)
+	if config.Opts.WebSub() {
+		webSubSubscription, storeErr = store.WebSubSubscriptionByFeedID(userID, feedID)
+		if storeErr != nil {
+			slog.Error("Unable to fetch WebSub subscription",
+				slog.Int64("user_id", userID),
+				slog.Int64("feed_id", feedID),
+				slog.Any("error", storeErr),
+			)
+		} else if webSubSubscription != nil && webSubSubscription.IsActive() {
+			pushRefreshDelay = config.Opts.WebSubPollingInterval()
+		}
+	}
+
+	feedFetch := &model.FeedFetch{UserID: userID, FeedID: feedID}
+	startTime := time.Now()
+	defer func() {
+		recordFeedFetch(store, feedFetch, startTime, refreshErr)
+	}()
+
 	originalFeed.CheckedNow()
-	originalFeed.ScheduleNextCheck(weeklyEntryCount, time.Duration(0))
+	originalFeed.ScheduleNextCheck(weeklyEntryCount, pushRefreshDelay)
 
 	requestBuilder := fetcher.NewRequestBuilder().
 		WithUsernameAndPassword(originalFeed.Username, originalFeed.Password).
@@ -251,9 +299,11 @@ func RefreshFeed(store *storage.Storage, userID, feedID int64, forceRefresh bool
 	responseHandler := fetcher.NewResponseHandler(requestBuilder.ExecuteRequest(originalFeed.FeedURL))
 	defer responseHandler.Close()
 
+	feedFetch.StatusCode = responseHandler.StatusCode()
+
 	if responseHandler.IsRateLimited() {
 		retryDelay := responseHandler.ParseRetryDelay()
-		calculatedNextCheckInterval := originalFeed.ScheduleNextCheck(weeklyEntryCount, retryDelay)
+		calculatedNextCheckInterval := originalFeed.ScheduleNextCheck(weeklyEntryCount, max(retryDelay, pushRefreshDelay))
 
 		slog.Warn("Feed is rate limited",
 			slog.String("feed_url", originalFeed.FeedURL),
@@ -292,12 +342,10 @@ func RefreshFeed(store *storage.Storage, userID, feedID int64, forceRefresh bool
 			return localizedError
 		}
 
-		updatedFeed, parseErr := parser.ParseFeed(responseHandler.EffectiveURL(), bytes.NewReader(responseBody))
-		if parseErr != nil {
-			localizedError := locale.NewLocalizedErrorWrapper(parseErr, "error.unable_to_parse_feed", parseErr)
-			if errors.Is(parseErr, parser.ErrFeedFormatNotDetected) {
-				localizedError = locale.NewLocalizedErrorWrapper(parseErr, "error.feed_format_not_detected", parseErr)
-			}
+		feedFetch.ResponseSize = int64(len(responseBody))
+
+		updatedFeed, localizedError := parseFeed(responseHandler.EffectiveURL(), responseBody, responseHandler.ContentType(), &originalFeed.PageFeedSelectors)
+		if localizedError != nil {
 			return getTranslatedLocalizedError(store, userID, originalFeed, localizedError)
 		}
 
@@ -306,7 +354,12 @@ func RefreshFeed(store *storage.Storage, userID, feedID int64, forceRefresh bool
 		feedTTLValue := updatedFeed.TTL
 		cacheControlMaxAgeValue := responseHandler.CacheControlMaxAge()
 		expiresValue := responseHandler.Expires()
-		refreshDelay := max(feedTTLValue, cacheControlMaxAgeValue, expiresValue)
+		refreshDelay := max(feedTTLValue, cacheControlMaxAgeValue, expiresValue, pushRefreshDelay)
+
+		// Keep the scheduling hints declared by the feed (skipHours, skipDays and sy:updatePeriod).
+		originalFeed.SkipHours = updatedFeed.SkipHours
+		originalFeed.SkipDays = updatedFeed.SkipDays
+		originalFeed.UpdatePeriod = updatedFeed.UpdatePeriod
 
 		// Set the next check at with updated arguments.
 		calculatedNextCheckInterval := originalFeed.ScheduleNextCheck(weeklyEntryCount, refreshDelay)
@@ -318,33 +371,23 @@ func RefreshFeed(store *storage.Storage, userID, feedID int64, forceRefresh bool
 			slog.Int("feed_ttl_minutes", int(feedTTLValue.Minutes())),
 			slog.Int("cache_control_max_age_in_minutes", int(cacheControlMaxAgeValue.Minutes())),
 			slog.Int("expires_in_minutes", int(expiresValue.Minutes())),
+			slog.Int("push_refresh_delay_in_minutes", int(pushRefreshDelay.Minutes())),
+			slog.Int("update_period_in_minutes", int(originalFeed.UpdatePeriod.Minutes())),
 			slog.Int("refresh_delay_in_minutes", int(refreshDelay.Minutes())),
 			slog.Int("calculated_next_check_interval_in_minutes", int(calculatedNextCheckInterval.Minutes())),
 			slog.Time("new_next_check_at", originalFeed.NextCheckAt),
 		)
 
-		originalFeed.Entries = updatedFeed.Entries
-		processor.ProcessFeedEntries(store, originalFeed, userID, forceRefresh)
-
-		// We don't update existing entries when the crawler is enabled (we crawl only inexisting entries).
-		// We also skip updating existing entries if the feed has ignore_entry_updates enabled.
-		// Unless it is forced to refresh.
-		updateExistingEntries := forceRefresh || (!originalFeed.Crawler && !originalFeed.IgnoreEntryUpdates)
-		newEntries, storeErr := store.RefreshFeedEntries(originalFeed.UserID, originalFeed.ID, originalFeed.Entries, updateExistingEntries)
-		if storeErr != nil {
-			localizedError := locale.NewLocalizedErrorWrapper(storeErr, "error.database_error", storeErr)
+		feedFetch.NewEntriesCount, feedFetch.UpdatedEntriesCount, localizedError = refreshFeedEntries(store, originalFeed, updatedFeed.Entries, forceRefresh)
+		if localizedError != nil {
 			return getTranslatedLocalizedError(store, userID, originalFeed, localizedError)
 		}
 
-		userIntegrations, intErr := store.Integration(userID)
-		if intErr != nil {
-			slog.Error("Fetching integrations failed; the refresh process will go on, but no integrations will run this time",
-				slog.Int64("user_id", userID),
-				slog.Int64("feed_id", feedID),
-				slog.Any("error", intErr),
-			)
-		} else if userIntegrations != nil && len(newEntries) > 0 {
-			go integration.PushEntries(originalFeed, newEntries, userIntegrations)
+		if config.Opts.WebSub() && updatedFeed.HubURL != "" {
+			topicURL := cmp.Or(updatedFeed.SelfURL, originalFeed.FeedURL)
+			if needsWebSubSubscription(webSubSubscription, updatedFeed.HubURL, topicURL) {
+				go subscribeToHub(store, userID, feedID, updatedFeed.HubURL, topicURL)
+			}
 		}
 
 		originalFeed.EtagHeader = responseHandler.ETag()
@@ -363,6 +406,8 @@ func RefreshFeed(store *storage.Storage, userID, feedID int64, forceRefresh bool
 			slog.Int64("feed_id", feedID),
 		)
 
+		feedFetch.CacheHit = true
+
 		// Last-Modified may be updated even if ETag is not. In this case, per
 		// RFC9111 sections 3.2 and 4.3.4, the stored response must be updated.
 		if responseHandler.LastModified() != "" {
@@ -370,6 +415,8 @@ func RefreshFeed(store *storage.Storage, userID, feedID int64, forceRefresh bool
 		}
 	}
 
+	followPermanentRedirect(store, originalFeed, feedFetch, responseHandler.PermanentRedirectURL())
+
 	originalFeed.ResetErrorCounter()
 
 	if storeErr := store.UpdateFeed(originalFeed); storeErr != nil {
@@ -379,3 +426,228 @@ func RefreshFeed(store *storage.Storage, userID, feedID int64, forceRefresh bool
 
 	return nil
 }
+
+// RefreshFeedWithPushedContent processes the feed content pushed by a WebSub hub.
+// Unlike RefreshFeed, nothing is fetched and the polling schedule of the feed is left untouched.
+func RefreshFeedWithPushedContent(store *storage.Storage, userID, feedID int64, content []byte) *locale.LocalizedErrorWrapper {
+	slog.Debug("Begin pushed feed content process",
+		slog.Int64("user_id", userID),
+		slog.Int64("feed_id", feedID),
+		slog.Int("content_length", len(content)),
+	)
+
+	originalFeed, storeErr := store.FeedByID(userID, feedID)
+	if storeErr != nil {
+		return locale.NewLocalizedErrorWrapper(storeErr, "error.database_error", storeErr)
+	}
+
+	if originalFeed == nil {
+		return locale.NewLocalizedErrorWrapper(ErrFeedNotFound, "error.feed_not_found")
+	}
+
+	if originalFeed.Disabled {
+		return nil
+	}
+
+	pushedFeed, parseErr := parser.ParseFeed(originalFeed.FeedURL, bytes.NewReader(content))
+	if parseErr != nil {
+		return locale.NewLocalizedErrorWrapper(parseErr, "error.unable_to_parse_feed", parseErr)
+	}
+
+	_, _, localizedError := refreshFeedEntries(store, originalFeed, pushedFeed.Entries, false)
+	return localizedError
+}
+
+// FetchFeedEntries downloads and parses the feed without storing anything.
+// The entries are returned as published by the website, before any processing, at most limit entries are returned.
+func FetchFeedEntries(feed *model.Feed, limit int) (model.Entries, *locale.LocalizedErrorWrapper) {
+	requestBuilder := fetcher.NewRequestBuilder().
+		WithUsernameAndPassword(feed.Username, feed.Password).
+		WithUserAgent(feed.UserAgent, config.Opts.HTTPClientUserAgent()).
+		WithCookie(feed.Cookie).
+		WithTimeout(config.Opts.HTTPClientTimeout()).
+		WithProxyRotator(proxyrotator.ProxyRotatorInstance).
+		WithCustomFeedProxyURL(feed.ProxyURL).
+		WithCustomApplicationProxyURL(config.Opts.HTTPClientProxyURL()).
+		UseCustomApplicationProxyURL(feed.FetchViaProxy).
+		IgnoreTLSErrors(feed.AllowSelfSignedCertificates).
+		DisableHTTP2(feed.DisableHTTP2)
+
+	responseHandler := fetcher.NewResponseHandler(requestBuilder.ExecuteRequest(feed.FeedURL))
+	defer responseHandler.Close()
+
+	if localizedError := responseHandler.LocalizedError(); localizedError != nil {
+		return nil, localizedError
+	}
+
+	responseBody, localizedError := responseHandler.ReadBody(config.Opts.HTTPClientMaxBodySize())
+	if localizedError != nil {
+		return nil, localizedError
+	}
+
+	fetchedFeed, localizedError := parseFeed(responseHandler.EffectiveURL(), responseBody, responseHandler.ContentType(), &feed.PageFeedSelectors)
+	if localizedError != nil {
+		return nil, localizedError
+	}
+
+	if len(fetchedFeed.Entries) > limit {
+		return fetchedFeed.Entries[:limit], nil
+	}
+
+	return fetchedFeed.Entries, nil
+}
+
+// parseFeed parses the feed document, or generates the feed from the web page when page selectors are defined.
+func parseFeed(feedURL string, body []byte, contentType string, selectors *model.PageFeedSelectors) (*model.Feed, *locale.LocalizedErrorWrapper) {
+	if selectors.IsPageFeed() {
+		htmlDocumentReader, err := encoding.NewCharsetReaderFromBytes(body, contentType)
+		if err != nil {
+			return nil, locale.NewLocalizedErrorWrapper(err, "error.unable_to_parse_feed", err)
+		}
+
+		feed, err := pagefeed.Parse(feedURL, htmlDocumentReader, selectors)
+		if err != nil {
+			return nil, locale.NewLocalizedErrorWrapper(err, "error.unable_to_parse_feed", err)
+		}
+
+		return feed, nil
+	}
+
+	feed, parseErr := parser.ParseFeed(feedURL, bytes.NewReader(body))
+	if parseErr != nil {
+		if errors.Is(parseErr, parser.ErrFeedFormatNotDetected) {
+			return nil, locale.NewLocalizedErrorWrapper(parseErr, "error.feed_format_not_detected", parseErr)
+		}
+		return nil, locale.NewLocalizedErrorWrapper(parseErr, "error.unable_to_parse_feed", parseErr)
+	}
+
+	return feed, nil
+}
+
+// refreshFeedEntries processes and stores the given entries, then sends the new ones to the user integrations.
+// It returns the number of created and updated entries.
+func refreshFeedEntries(store *storage.Storage, originalFeed *model.Feed, entries model.Entries, forceRefresh bool) (int, int, *locale.LocalizedErrorWrapper) {
+	originalFeed.Entries = entries
+	processor.ProcessFeedEntries(store, originalFeed, originalFeed.UserID, forceRefresh)
+
+	// We don't update existing entries when the crawler is enabled (we crawl only inexisting entries).
+	// We also skip updating existing entries if the feed has ignore_entry_updates enabled.
+	// Unless it is forced to refresh.
+	updateExistingEntries := forceRefresh || (!originalFeed.Crawler && !originalFeed.IgnoreEntryUpdates)
+	newEntries, updatedEntriesCount, storeErr := store.RefreshFeedEntries(originalFeed.UserID, originalFeed.ID, originalFeed.Entries, updateExistingEntries, originalFeed.MarkUnreadOnChangeThreshold)
+	if storeErr != nil {
+		return 0, 0, locale.NewLocalizedErrorWrapper(storeErr, "error.database_error", storeErr)
+	}
+
+	userIntegrations, intErr := store.Integration(originalFeed.UserID)
+	if intErr != nil {
+		slog.Error("Fetching integrations failed; the refresh process will go on, but no integrations will run this time",
+			slog.Int64("user_id", originalFeed.UserID),
+			slog.Int64("feed_id", originalFeed.ID),
+			slog.Any("error", intErr),
+		)
+	} else if userIntegrations != nil && len(newEntries) > 0 {
+		go integration.PushEntries(originalFeed, newEntries, userIntegrations)
+		go integration.PushEntryActions(originalFeed, newEntries, userIntegrations)
+	}
+
+	return len(newEntries), updatedEntriesCount, nil
+}
+
+// followPermanentRedirect replaces the feed URL once the feed has been permanently redirected
+// to the same URL for the number of consecutive refreshes defined in the configuration.
+func followPermanentRedirect(store *storage.Storage, feed *model.Feed, feedFetch *model.FeedFetch, redirectURL string) {
+	limitReached := feed.TrackPermanentRedirect(redirectURL, config.Opts.PollingPermanentRedirectLimit())
+	feedFetch.RedirectURL = feed.PermanentRedirectURL
+	if !limitReached {
+		return
+	}
+
+	if store.AnotherFeedURLExists(feed.UserID, feed.ID, redirectURL) {
+		slog.Warn("Unable to follow permanent redirect: another feed already uses this URL",
+			slog.Int64("user_id", feed.UserID),
+			slog.Int64("feed_id", feed.ID),
+			slog.String("feed_url", feed.FeedURL),
+			slog.String("redirect_url", redirectURL),
+		)
+		return
+	}
+
+	slog.Info("Updating feed URL after consecutive permanent redirects",
+		slog.Int64("user_id", feed.UserID),
+		slog.Int64("feed_id", feed.ID),
+		slog.String("previous_feed_url", feed.FeedURL),
+		slog.String("new_feed_url", redirectURL),
+		slog.Int("permanent_redirect_count", feed.PermanentRedirectCount),
+	)
+
+	if feed.FollowPermanentRedirect(redirectURL) {
+		slog.Warn("Removed the feed credentials after a permanent redirect to another host",
+			slog.Int64("user_id", feed.UserID),
+			slog.Int64("feed_id", feed.ID),
+			slog.String("new_feed_url", redirectURL),
+		)
+	}
+	feedFetch.FeedURLUpdated = true
+}
+
+// recordFeedFetch stores the outcome of a refresh attempt in the feed fetch history.
+func recordFeedFetch(store *storage.Storage, feedFetch *model.FeedFetch, startTime time.Time, localizedError *locale.LocalizedErrorWrapper) {
+	feedFetch.Duration = time.Since(startTime).Milliseconds()
+	if localizedError != nil && localizedError.Error() != nil {
+		feedFetch.ErrorMsg = localizedError.Error().Error()
+	}
+
+	if storeErr := store.CreateFeedFetch(feedFetch); storeErr != nil {
+		slog.Error("Unable to record feed fetch",
+			slog.Int64("user_id", feedFetch.UserID),
+			slog.Int64("feed_id", feedFetch.FeedID),
+			slog.Any("error", storeErr),
+		)
+	}
+}
+
+// needsWebSubSubscription returns true if the feed must (re)subscribe to the hub it advertises.
+func needsWebSubSubscription(subscription *model.WebSubSubscription, hubURL, topicURL string) bool {
+	switch {
+	case subscription == nil:
+		return true
+	case subscription.HubURL != hubURL || subscription.TopicURL != topicURL:
+		return true
+	case subscription.IsActive():
+		return false
+	default:
+		return time.Since(subscription.UpdatedAt) > webSubRetryInterval
+	}
+}
+
+// subscribeToHub creates a pending WebSub subscription for the feed and sends the subscription request to the hub.
+func subscribeToHub(store *storage.Storage, userID, feedID int64, hubURL, topicURL string) {
+	subscription, storeErr := store.CreateWebSubSubscription(userID, feedID, hubURL, topicURL)
+	if storeErr != nil {
+		slog.Error("Unable to create WebSub subscription",
+			slog.Int64("user_id", userID),
+			slog.Int64("feed_id", feedID),
+			slog.Any("error", storeErr),
+		)
+		return
+	}
+
+	if err := websub.Subscribe(subscription, config.Opts.WebSubLeaseDuration()); err != nil {
+		slog.Warn("Unable to subscribe to WebSub hub",
+			slog.Int64("user_id", userID),
+			slog.Int64("feed_id", feedID),
+			slog.String("hub_url", hubURL),
+			slog.String("topic_url", topicURL),
+			slog.Any("error", err),
+		)
+		return
+	}
+
+	slog.Debug("WebSub subscription requested",
+		slog.Int64("user_id", userID),
+		slog.Int64("feed_id", feedID),
+		slog.String("hub_url", hubURL),
+		slog.String("topic_url", topicURL),
+	)
+}
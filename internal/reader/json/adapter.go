@@ -53,6 +53,24 @@ func (j *JSONAdapter) BuildFeed(baseURL string) *model.Feed {
 		feed.SiteURL = siteURL
 	}
 
+	// The feed_url field plays the same role as the Atom self link.
+	if strings.TrimSpace(j.jsonFeed.FeedURL) != "" {
+		feed.SelfURL = feed.FeedURL
+	}
+
+	// Populate the WebSub hub URL if present.
+	for _, hub := range j.jsonFeed.Hubs {
+		hubURL := strings.TrimSpace(hub.URL)
+		if hubURL == "" || !strings.EqualFold(hub.Type, "WebSub") {
+			continue
+		}
+
+		if absoluteHubURL, err := urllib.ResolveToAbsoluteURL(baseURL, hubURL); err == nil {
+			feed.HubURL = absoluteHubURL
+			break
+		}
+	}
+
 	// Fallback to the feed URL if the title is empty.
 	if feed.Title == "" {
 		feed.Title = feed.SiteURL
@@ -156,6 +174,17 @@ func (j *JSONAdapter) BuildFeed(baseURL string) *model.Feed {
 
 		entry.Author = strings.Join(authorNames, ", ")
 
+		// Populate the entry thumbnail.
+		for _, imageURL := range []string{item.ImageURL, item.BannerImageURL} {
+			if imageURL = strings.TrimSpace(imageURL); imageURL == "" {
+				continue
+			}
+
+			if absoluteImageURL, err := urllib.ResolveToAbsoluteURL(feed.SiteURL, imageURL); err == nil && entry.SetThumbnail(absoluteImageURL, 0, 0) {
+				break
+			}
+		}
+
 		// Populate the entry enclosures.
 		for _, attachment := range item.Attachments {
 			attachmentURL := strings.TrimSpace(attachment.URL)
@@ -221,6 +221,33 @@ func TestParseFeedWithFeedURLWithTrailingSpace(t *testing.T) {
 	}
 }
 
+func TestParseFeedWithWebSubHub(t *testing.T) {
+	data := `{
+		"version": "https://jsonfeed.org/version/1.1",
+		"title": "My Example Feed",
+		"home_page_url": "https://example.org/",
+		"feed_url": "https://example.org/feed.json",
+		"hubs": [
+			{"type": "rssCloud", "url": "https://cloud.example.org/"},
+			{"type": "WebSub", "url": "https://websub.example.org/"}
+		],
+		"items": []
+	}`
+
+	feed, err := Parse("https://example.org/feed.json", bytes.NewBufferString(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if feed.SelfURL != "https://example.org/feed.json" {
+		t.Errorf("Incorrect self URL, got: %s", feed.SelfURL)
+	}
+
+	if feed.HubURL != "https://websub.example.org/" {
+		t.Errorf("Incorrect hub URL, got: %s", feed.HubURL)
+	}
+}
+
 func TestParseFeedWithRelativeFeedURL(t *testing.T) {
 	data := `{
 		"version": "https://jsonfeed.org/version/1",
@@ -1231,3 +1258,40 @@ func TestParseItemWithPlainTextContentContainingHTMLCharacters(t *testing.T) {
 		t.Errorf("Incorrect entry content, got: %q, want: %q", feed.Entries[0].Content, want)
 	}
 }
+
+func TestParseItemWithImage(t *testing.T) {
+	data := `{
+		"version": "https://jsonfeed.org/version/1.1",
+		"title": "Example",
+		"home_page_url": "https://example.org/",
+		"feed_url": "https://example.org/feed.json",
+		"items": [
+			{
+				"id": "1",
+				"url": "https://example.org/1",
+				"content_text": "Item with an image",
+				"image": "/images/1.png",
+				"banner_image": "https://example.org/images/banner.png"
+			},
+			{
+				"id": "2",
+				"url": "https://example.org/2",
+				"content_text": "Item with a banner",
+				"banner_image": "https://example.org/images/banner.png"
+			}
+		]
+	}`
+
+	feed, err := Parse("https://example.org/feed.json", bytes.NewBufferString(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if feed.Entries[0].ThumbnailURL != "https://example.org/images/1.png" {
+		t.Errorf("Incorrect entry thumbnail, got: %q", feed.Entries[0].ThumbnailURL)
+	}
+
+	if feed.Entries[1].ThumbnailURL != "https://example.org/images/banner.png" {
+		t.Errorf("Incorrect entry thumbnail, got: %q", feed.Entries[1].ThumbnailURL)
+	}
+}
@@ -0,0 +1,104 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package language // import "miniflux.app/v2/internal/reader/language"
+
+import (
+	"strings"
+	"unicode"
+)
+
+// minStopWordMatches is the number of stop words required to trust the detection of a Latin script language.
+const minStopWordMatches = 3
+
+// stopWords lists very common words that are specific enough to tell apart the main languages written with the Latin script.
+var stopWords = map[string][]string{
+	"da": {"og", "det", "ikke", "jeg", "til", "er", "af", "med", "som", "har", "på", "hvad"},
+	"de": {"der", "die", "und", "das", "nicht", "ist", "ich", "mit", "sich", "auch", "auf", "für", "wird", "eine"},
+	"en": {"the", "and", "of", "to", "is", "that", "with", "for", "this", "are", "was", "have", "from"},
+	"es": {"el", "los", "las", "del", "que", "por", "una", "con", "para", "como", "pero", "más", "está"},
+	"fi": {"ja", "on", "ei", "että", "se", "oli", "ovat", "mutta", "kun", "myös", "tämä", "hän"},
+	"fr": {"le", "les", "des", "et", "est", "une", "du", "que", "pour", "dans", "pas", "avec", "sur", "qui"},
+	"it": {"il", "della", "che", "di", "per", "una", "non", "sono", "gli", "anche", "nel", "alla"},
+	"nl": {"de", "het", "een", "van", "en", "niet", "dat", "zijn", "voor", "met", "ook", "wordt"},
+	"pt": {"os", "das", "dos", "que", "não", "uma", "para", "com", "mais", "como", "pelo", "são"},
+	"sv": {"och", "att", "det", "som", "är", "inte", "för", "med", "den", "har", "till", "av"},
+}
+
+// Detect guesses the language of a plain text and returns its code, or an empty string when unsure.
+// The script is enough for most languages, the Latin script languages are told apart with their stop words.
+func Detect(text string) string {
+	scripts := make(map[string]int)
+	letters := 0
+
+	for _, r := range text {
+		if !unicode.IsLetter(r) {
+			continue
+		}
+		letters++
+
+		switch {
+		case unicode.In(r, unicode.Hiragana, unicode.Katakana):
+			scripts["ja"]++
+		case unicode.Is(unicode.Hangul, r):
+			scripts["ko"]++
+		case unicode.Is(unicode.Han, r):
+			scripts["zh"]++
+		case unicode.Is(unicode.Cyrillic, r):
+			scripts["ru"]++
+		case unicode.Is(unicode.Greek, r):
+			scripts["el"]++
+		case unicode.Is(unicode.Arabic, r):
+			scripts["ar"]++
+		case unicode.Is(unicode.Hebrew, r):
+			scripts["he"]++
+		case unicode.Is(unicode.Thai, r):
+			scripts["th"]++
+		}
+	}
+
+	if letters == 0 {
+		return ""
+	}
+
+	// Japanese texts mix kana with kanji, a few kana are enough to tell them apart from Chinese.
+	if scripts["ja"] > 0 && scripts["ja"]+scripts["zh"] > letters/2 {
+		return "ja"
+	}
+
+	for _, code := range []string{"ko", "zh", "ru", "el", "ar", "he", "th"} {
+		if scripts[code] > letters/2 {
+			return code
+		}
+	}
+
+	return detectLatinLanguage(text)
+}
+
+func detectLatinLanguage(text string) string {
+	words := make(map[string]int)
+	for word := range strings.FieldsFuncSeq(strings.ToLower(text), func(r rune) bool { return !unicode.IsLetter(r) }) {
+		words[word]++
+	}
+
+	bestLanguage, bestScore, secondScore := "", 0, 0
+	for code, languageStopWords := range stopWords {
+		score := 0
+		for _, stopWord := range languageStopWords {
+			score += words[stopWord]
+		}
+
+		switch {
+		case score > bestScore:
+			bestLanguage, bestScore, secondScore = code, score, bestScore
+		case score > secondScore:
+			secondScore = score
+		}
+	}
+
+	if bestScore < minStopWordMatches || bestScore == secondScore {
+		return ""
+	}
+
+	return bestLanguage
+}
@@ -0,0 +1,157 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package language // import "miniflux.app/v2/internal/reader/language"
+
+import "testing"
+
+func TestDetect(t *testing.T) {
+	cases := []struct {
+		text, want string
+	}{
+		{"", ""},
+		{"12345 !!!", ""},
+		{"The release of the new version is available for download and this is great.", "en"},
+		{"Die neue Version ist verfügbar und wird auch für die Nutzer mit der alten Version angeboten.", "de"},
+		{"La nouvelle version est disponible pour les utilisateurs et elle apporte des corrections dans le code.", "fr"},
+		{"La nueva versión está disponible para los usuarios y trae más correcciones con el código.", "es"},
+		{"新しいバージョンが公開されました。ダウンロードしてください。", "ja"},
+		{"新版本已经发布，请下载。", "zh"},
+		{"Новая версия доступна для загрузки.", "ru"},
+		{"새 버전을 다운로드할 수 있습니다.", "ko"},
+		{"Golang Kubernetes Docker", ""},
+	}
+
+	for _, c := range cases {
+		if got := Detect(c.text); got != c.want {
+			t.Errorf("Detect(%q) = %q, want %q", c.text, got, c.want)
+		}
+	}
+}
+
+func TestDetectLongTexts(t *testing.T) {
+	cases := []struct {
+		name, text, want string
+	}{
+		{
+			"english article",
+			`The maintainers announced a new release of the project this week. The update is focused on performance and
+			stability, and it includes a rewrite of the storage layer that was requested by many users. According to the
+			changelog, the memory usage of the server was reduced by half, and the startup time is now under a second.
+			Users who are upgrading from an older version should read the migration notes first, because some of the
+			configuration options have been renamed. The team also thanked the contributors who reported bugs during the
+			beta period and said that the next version will be released in the spring.`,
+			"en",
+		},
+		{
+			"german article",
+			`Die Entwickler haben in dieser Woche eine neue Version des Projekts veröffentlicht. Das Update konzentriert
+			sich auf Leistung und Stabilität, und es enthält eine Neufassung der Speicherschicht, die von vielen Nutzern
+			gewünscht wurde. Laut dem Änderungsprotokoll wurde der Speicherverbrauch des Servers halbiert. Wer von einer
+			älteren Version aktualisiert, sollte zuerst die Hinweise zur Migration lesen, da einige Optionen umbenannt
+			wurden. Das Team bedankt sich auch bei allen, die während der Testphase Fehler gemeldet haben.`,
+			"de",
+		},
+		{
+			"french article",
+			`Les développeurs ont publié cette semaine une nouvelle version du projet. La mise à jour se concentre sur les
+			performances et la stabilité, et elle comprend une réécriture de la couche de stockage qui était demandée par
+			de nombreux utilisateurs. Selon le journal des modifications, la consommation de mémoire du serveur a été
+			divisée par deux. Les utilisateurs qui mettent à jour depuis une ancienne version doivent d'abord lire les
+			notes de migration, car certaines options ont été renommées dans le fichier de configuration.`,
+			"fr",
+		},
+		{
+			"spanish article",
+			`Los desarrolladores publicaron esta semana una nueva versión del proyecto. La actualización se centra en el
+			rendimiento y la estabilidad, e incluye una reescritura de la capa de almacenamiento que pedían muchos
+			usuarios. Según el registro de cambios, el consumo de memoria del servidor se redujo a la mitad. Los usuarios
+			que actualizan desde una versión anterior deben leer primero las notas de migración, porque algunas opciones
+			de la configuración cambiaron de nombre para ser más claras.`,
+			"es",
+		},
+		{
+			"portuguese article",
+			`Os desenvolvedores publicaram esta semana uma nova versão do projeto. A atualização é focada no desempenho e
+			na estabilidade, e inclui uma reescrita da camada de armazenamento que foi pedida pelos usuários. Segundo o
+			registro das mudanças, o consumo de memória do servidor foi reduzido pela metade. Os usuários que não leram
+			as notas de migração devem fazer isso antes da atualização, porque algumas opções da configuração são
+			diferentes e mais simples para quem usa o projeto com outros serviços.`,
+			"pt",
+		},
+		{
+			"italian article",
+			`Gli sviluppatori hanno pubblicato questa settimana una nuova versione del progetto. L'aggiornamento è
+			dedicato alle prestazioni e alla stabilità, e include anche una riscrittura del livello di archiviazione che
+			era stata chiesta da molti utenti. Secondo il registro delle modifiche, il consumo di memoria del server è
+			stato dimezzato. Gli utenti che aggiornano da una versione precedente devono leggere le note di migrazione,
+			perché alcune opzioni della configurazione sono state rinominate e non sono più compatibili.`,
+			"it",
+		},
+		{
+			"dutch article",
+			`De ontwikkelaars hebben deze week een nieuwe versie van het project uitgebracht. De update richt zich op
+			prestaties en stabiliteit, en bevat ook een herschreven opslaglaag waar veel gebruikers om hadden gevraagd.
+			Volgens het wijzigingslogboek is het geheugengebruik van de server gehalveerd. Gebruikers die van een oudere
+			versie overstappen, moeten eerst de migratienotities lezen, omdat sommige opties niet meer bestaan en een
+			andere naam hebben gekregen. Het team bedankt iedereen die fouten heeft gemeld.`,
+			"nl",
+		},
+		{
+			"swedish article",
+			`Utvecklarna har släppt en ny version av projektet den här veckan. Uppdateringen fokuserar på prestanda och
+			stabilitet, och den innehåller en omskrivning av lagringslagret som många användare har önskat. Enligt
+			ändringsloggen har serverns minnesanvändning halverats. Användare som uppgraderar från en äldre version bör
+			först läsa migreringsanvisningarna, eftersom det är inte säkert att alla inställningar fungerar som förut och
+			några av dem har fått nya namn.`,
+			"sv",
+		},
+		{
+			"finnish article",
+			`Kehittäjät julkaisivat tällä viikolla projektin uuden version. Päivitys keskittyy suorituskykyyn ja
+			vakauteen, ja se sisältää myös tallennuskerroksen uudelleenkirjoituksen, jota moni käyttäjä on toivonut.
+			Muutoslokin mukaan palvelimen muistinkäyttö on puolittunut. Käyttäjien, jotka päivittävät vanhemmasta
+			versiosta, kannattaa lukea siirto-ohjeet ensin, koska osa asetuksista on nimetty uudelleen ja se ei ole
+			aina selvää. Tiimi kiittää myös kaikkia, jotka ilmoittivat virheistä, mutta kun testivaihe oli käynnissä.`,
+			"fi",
+		},
+		{
+			"english article with code identifiers",
+			`To enable the feature, set the POLLING_FREQUENCY and BATCH_SIZE variables in the environment file and restart
+			the service. The scheduler is then started with the new values, and this is the only change that is required
+			for most installations. Kubernetes users can use the ConfigMap from the examples directory with the Helm chart.`,
+			"en",
+		},
+		{
+			"japanese article with kanji",
+			`開発者は今週、プロジェクトの新しいバージョンを公開しました。今回の更新は性能と安定性に重点を置いており、
+			多くの利用者から要望があった保存層の書き直しも含まれています。変更履歴によると、サーバーの使用メモリは半分になりました。
+			古いバージョンから更新する場合は、設定項目の名前が変更されているため、最初に移行手順を確認してください。`,
+			"ja",
+		},
+		{
+			"chinese article",
+			`开发者本周发布了该项目的新版本。此次更新侧重于性能和稳定性，并包含了许多用户要求的存储层重写。根据更新日志，
+			服务器的内存使用量减少了一半。从旧版本升级的用户应首先阅读迁移说明，因为部分配置选项已被重新命名。`,
+			"zh",
+		},
+		{
+			"russian article with latin product names",
+			`Разработчики на этой неделе выпустили новую версию проекта. Обновление посвящено производительности и
+			стабильности, а также включает переписанный слой хранения данных, о котором просили многие пользователи
+			PostgreSQL и Docker. Согласно журналу изменений, потребление памяти сервером сократилось вдвое.`,
+			"ru",
+		},
+		{
+			"list of product names",
+			`Golang Kubernetes Docker PostgreSQL Redis Nginx Prometheus Grafana Terraform Ansible Jenkins GitLab GitHub`,
+			"",
+		},
+	}
+
+	for _, c := range cases {
+		if got := Detect(c.text); got != c.want {
+			t.Errorf("Detect(%s) = %q, want %q", c.name, got, c.want)
+		}
+	}
+}
@@ -52,6 +52,24 @@ func (e *MediaItemElement) AllMediaPeerLinks() []PeerLink {
 	return items
 }
 
+// FirstMediaImage returns the URL and the dimensions of the first thumbnail.
+// The first image content is used when there is no thumbnail.
+func (e *MediaItemElement) FirstMediaImage() (imageURL string, width, height int) {
+	for _, thumbnail := range e.AllMediaThumbnails() {
+		if thumbnailURL := strings.TrimSpace(thumbnail.URL); thumbnailURL != "" {
+			return thumbnailURL, parseDimension(thumbnail.Width), parseDimension(thumbnail.Height)
+		}
+	}
+
+	for _, content := range e.AllMediaContents() {
+		if contentURL := strings.TrimSpace(content.URL); contentURL != "" && content.IsImage() {
+			return contentURL, parseDimension(content.Width), parseDimension(content.Height)
+		}
+	}
+
+	return "", 0, 0
+}
+
 // FirstMediaDescription returns the first description element.
 func (e *MediaItemElement) FirstMediaDescription() string {
 	description := e.MediaDescriptions.First()
@@ -83,6 +101,13 @@ type Content struct {
 	Type     string `xml:"type,attr"`
 	FileSize string `xml:"fileSize,attr"`
 	Medium   string `xml:"medium,attr"`
+	Width    string `xml:"width,attr"`
+	Height   string `xml:"height,attr"`
+}
+
+// IsImage returns true if the content is an image.
+func (mc *Content) IsImage() bool {
+	return mc.Medium == "image" || strings.HasPrefix(strings.ToLower(mc.Type), "image/")
 }
 
 // MimeType returns the attachment mime type.
@@ -111,7 +136,9 @@ func (mc *Content) Size() int64 {
 
 // Thumbnail represents a XML element "media:thumbnail".
 type Thumbnail struct {
-	URL string `xml:"url,attr"`
+	URL    string `xml:"url,attr"`
+	Width  string `xml:"width,attr"`
+	Height string `xml:"height,attr"`
 }
 
 // MimeType returns the attachment mime type.
@@ -189,3 +216,11 @@ func (mcl MediaCategoryList) LabelsSeq() iter.Seq[string] {
 type MediaCategory struct {
 	Label string `xml:"label,attr"`
 }
+
+func parseDimension(value string) int {
+	dimension, err := strconv.Atoi(strings.TrimSpace(value))
+	if err != nil || dimension < 0 {
+		return 0
+	}
+	return dimension
+}
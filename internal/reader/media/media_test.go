@@ -109,3 +109,24 @@ func TestFirstDescription(t *testing.T) {
 		t.Errorf(`Unexpected description`)
 	}
 }
+
+func TestFirstMediaImage(t *testing.T) {
+	element := MediaItemElement{
+		MediaContents: []Content{
+			{URL: "https://example.org/video.mp4", Type: "video/mp4"},
+			{URL: "https://example.org/image.jpg", Medium: "image", Width: "640", Height: "480"},
+		},
+	}
+
+	imageURL, width, height := element.FirstMediaImage()
+	if imageURL != "https://example.org/image.jpg" || width != 640 || height != 480 {
+		t.Errorf(`Unexpected image: %q %dx%d`, imageURL, width, height)
+	}
+
+	element.MediaGroups = []Group{{MediaThumbnails: []Thumbnail{{URL: " https://example.org/thumbnail.jpg ", Width: "invalid"}}}}
+
+	imageURL, width, height = element.FirstMediaImage()
+	if imageURL != "https://example.org/thumbnail.jpg" || width != 0 || height != 0 {
+		t.Errorf(`Thumbnails should be preferred, got %q %dx%d`, imageURL, width, height)
+	}
+}
@@ -0,0 +1,165 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package pagefeed // import "miniflux.app/v2/internal/reader/pagefeed"
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"miniflux.app/v2/internal/crypto"
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/reader/date"
+	"miniflux.app/v2/internal/urllib"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// ErrNoItemFound is returned when the item selector does not match anything on the page.
+var ErrNoItemFound = errors.New("pagefeed: no item found with the item selector")
+
+// Parse generates a feed from an HTML page, using the CSS selectors of the feed definition.
+//
+// Each element matched by the item selector becomes an entry. The title, link, date and content selectors
+// are evaluated inside each item. Empty selectors fall back to sensible defaults:
+// the first link of the item, its text as title, and the whole item as content.
+func Parse(pageURL string, r io.Reader, selectors *model.PageFeedSelectors) (*model.Feed, error) {
+	doc, err := goquery.NewDocumentFromReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("pagefeed: unable to parse HTML document: %v", err)
+	}
+
+	baseURL := pageURL
+	if baseHref, exists := doc.Find("head base[href]").First().Attr("href"); exists {
+		if absoluteBaseURL, err := urllib.ResolveToAbsoluteURL(pageURL, strings.TrimSpace(baseHref)); err == nil {
+			baseURL = absoluteBaseURL
+		}
+	}
+
+	feed := &model.Feed{
+		FeedURL: pageURL,
+		SiteURL: pageURL,
+		Title:   strings.TrimSpace(doc.Find("title").First().Text()),
+	}
+
+	if feed.Title == "" {
+		feed.Title = urllib.Domain(pageURL)
+	}
+
+	if description, exists := doc.Find(`meta[name="description"]`).First().Attr("content"); exists {
+		feed.Description = strings.TrimSpace(description)
+	}
+
+	if language, exists := doc.Find("html").First().Attr("lang"); exists {
+		feed.Language = strings.TrimSpace(language)
+	}
+
+	items := doc.Find(selectors.PageItemSelector)
+	if items.Length() == 0 {
+		return nil, ErrNoItemFound
+	}
+
+	now := time.Now()
+	items.Each(func(_ int, item *goquery.Selection) {
+		entry := model.NewEntry()
+		entry.URL = findItemURL(baseURL, item, selectors.PageLinkSelector)
+		entry.Title = findItemTitle(item, selectors.PageTitleSelector)
+		entry.Content = findItemContent(item, selectors.PageContentSelector)
+		entry.Date = findItemDate(item, selectors.PageDateSelector, now)
+
+		if entry.URL == "" && entry.Title == "" {
+			return
+		}
+
+		if entry.Title == "" {
+			entry.Title = entry.URL
+		}
+
+		// The hash must not depend on the content, otherwise any change on the page would create a new entry.
+		if entry.URL != "" {
+			entry.Hash = crypto.SHA256(entry.URL)
+		} else {
+			entry.Hash = crypto.SHA256(entry.Title)
+		}
+
+		feed.Entries = append(feed.Entries, entry)
+	})
+
+	return feed, nil
+}
+
+func findItemURL(baseURL string, item *goquery.Selection, linkSelector string) string {
+	element := item
+	if linkSelector != "" {
+		element = item.Find(linkSelector).First()
+	}
+
+	href, exists := element.Attr("href")
+	if !exists {
+		href, exists = element.Find("a[href]").First().Attr("href")
+	}
+
+	href = strings.TrimSpace(href)
+	if !exists || href == "" {
+		return ""
+	}
+
+	absoluteURL, err := urllib.ResolveToAbsoluteURL(baseURL, href)
+	if err != nil {
+		return ""
+	}
+
+	return absoluteURL
+}
+
+func findItemTitle(item *goquery.Selection, titleSelector string) string {
+	element := item
+	switch {
+	case titleSelector != "":
+		element = item.Find(titleSelector).First()
+	case item.Find("a[href]").Length() > 0:
+		element = item.Find("a[href]").First()
+	}
+
+	return strings.Join(strings.Fields(element.Text()), " ")
+}
+
+func findItemContent(item *goquery.Selection, contentSelector string) string {
+	element := item
+	if contentSelector != "" {
+		element = item.Find(contentSelector).First()
+	}
+
+	content, err := element.Html()
+	if err != nil {
+		return ""
+	}
+
+	return strings.TrimSpace(content)
+}
+
+func findItemDate(item *goquery.Selection, dateSelector string, defaultDate time.Time) time.Time {
+	if dateSelector == "" {
+		return defaultDate
+	}
+
+	element := item.Find(dateSelector).First()
+
+	// Prefer machine-readable values like <time datetime="..."> or <meta content="...">.
+	for _, attribute := range []string{"datetime", "content"} {
+		if value, exists := element.Attr(attribute); exists {
+			if parsedDate, err := date.Parse(value); err == nil {
+				return parsedDate
+			}
+		}
+	}
+
+	if parsedDate, err := date.Parse(strings.TrimSpace(element.Text())); err == nil {
+		return parsedDate
+	}
+
+	return defaultDate
+}
@@ -0,0 +1,181 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package pagefeed // import "miniflux.app/v2/internal/reader/pagefeed"
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"miniflux.app/v2/internal/model"
+)
+
+const testPage = `<!DOCTYPE html>
+<html lang="en">
+<head>
+	<title>Example News</title>
+	<meta name="description" content="Latest news">
+</head>
+<body>
+	<article class="post">
+		<h2><a href="/posts/1">First   post</a></h2>
+		<time datetime="2024-03-01T10:00:00Z">March 1st</time>
+		<div class="summary"><p>Summary 1</p></div>
+	</article>
+	<article class="post">
+		<h2><a href="https://example.org/posts/2">Second post</a></h2>
+		<time datetime="2024-03-02T10:00:00Z">March 2nd</time>
+		<div class="summary"><p>Summary 2</p></div>
+	</article>
+</body>
+</html>`
+
+func TestParseWithAllSelectors(t *testing.T) {
+	selectors := &model.PageFeedSelectors{
+		PageItemSelector:    "article.post",
+		PageTitleSelector:   "h2",
+		PageLinkSelector:    "h2 a",
+		PageDateSelector:    "time",
+		PageContentSelector: ".summary",
+	}
+
+	feed, err := Parse("https://example.org/news", strings.NewReader(testPage), selectors)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if feed.Title != "Example News" {
+		t.Errorf("Incorrect feed title, got: %q", feed.Title)
+	}
+
+	if feed.Description != "Latest news" {
+		t.Errorf("Incorrect feed description, got: %q", feed.Description)
+	}
+
+	if feed.Language != "en" {
+		t.Errorf("Incorrect feed language, got: %q", feed.Language)
+	}
+
+	if len(feed.Entries) != 2 {
+		t.Fatalf("Incorrect number of entries, got: %d", len(feed.Entries))
+	}
+
+	entry := feed.Entries[0]
+	if entry.URL != "https://example.org/posts/1" {
+		t.Errorf("Incorrect entry URL, got: %q", entry.URL)
+	}
+
+	if entry.Title != "First post" {
+		t.Errorf("Incorrect entry title, got: %q", entry.Title)
+	}
+
+	if entry.Content != "<p>Summary 1</p>" {
+		t.Errorf("Incorrect entry content, got: %q", entry.Content)
+	}
+
+	expectedDate := time.Date(2024, time.March, 1, 10, 0, 0, 0, time.UTC)
+	if !entry.Date.Equal(expectedDate) {
+		t.Errorf("Incorrect entry date, got: %v", entry.Date)
+	}
+
+	if feed.Entries[1].URL != "https://example.org/posts/2" {
+		t.Errorf("Incorrect entry URL, got: %q", feed.Entries[1].URL)
+	}
+}
+
+func TestParseWithItemSelectorOnly(t *testing.T) {
+	selectors := &model.PageFeedSelectors{PageItemSelector: "article.post"}
+
+	feed, err := Parse("https://example.org/news", strings.NewReader(testPage), selectors)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(feed.Entries) != 2 {
+		t.Fatalf("Incorrect number of entries, got: %d", len(feed.Entries))
+	}
+
+	entry := feed.Entries[0]
+	if entry.URL != "https://example.org/posts/1" {
+		t.Errorf("Incorrect entry URL, got: %q", entry.URL)
+	}
+
+	if entry.Title != "First post" {
+		t.Errorf("Incorrect entry title, got: %q", entry.Title)
+	}
+
+	if !strings.Contains(entry.Content, "<p>Summary 1</p>") {
+		t.Errorf("The content should contain the whole item, got: %q", entry.Content)
+	}
+
+	if entry.Date.IsZero() {
+		t.Error("The entry date should default to the current time")
+	}
+}
+
+func TestParseHashIsStable(t *testing.T) {
+	selectors := &model.PageFeedSelectors{PageItemSelector: "article.post"}
+
+	feed, err := Parse("https://example.org/news", strings.NewReader(testPage), selectors)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	modifiedPage := strings.Replace(testPage, "Summary 1", "Updated summary", 1)
+	modifiedFeed, err := Parse("https://example.org/news", strings.NewReader(modifiedPage), selectors)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if feed.Entries[0].Hash != modifiedFeed.Entries[0].Hash {
+		t.Error("The entry hash should not change when the content changes")
+	}
+
+	if feed.Entries[0].Hash == feed.Entries[1].Hash {
+		t.Error("Different entries should have different hashes")
+	}
+}
+
+func TestParseWithBaseHref(t *testing.T) {
+	page := `<html><head><base href="https://cdn.example.org/blog/"></head>
+		<body><ul><li><a href="post-1.html">Post 1</a></li></ul></body></html>`
+
+	feed, err := Parse("https://example.org/", strings.NewReader(page), &model.PageFeedSelectors{PageItemSelector: "li"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if feed.Title != "example.org" {
+		t.Errorf("The feed title should fall back to the domain, got: %q", feed.Title)
+	}
+
+	if len(feed.Entries) != 1 {
+		t.Fatalf("Incorrect number of entries, got: %d", len(feed.Entries))
+	}
+
+	if feed.Entries[0].URL != "https://cdn.example.org/blog/post-1.html" {
+		t.Errorf("Incorrect entry URL, got: %q", feed.Entries[0].URL)
+	}
+}
+
+func TestParseSkipsEmptyItems(t *testing.T) {
+	page := `<html><body><ul><li><a href="/a">A</a></li><li>   </li></ul></body></html>`
+
+	feed, err := Parse("https://example.org/", strings.NewReader(page), &model.PageFeedSelectors{PageItemSelector: "li"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(feed.Entries) != 1 {
+		t.Fatalf("Incorrect number of entries, got: %d", len(feed.Entries))
+	}
+}
+
+func TestParseWithoutMatchingItems(t *testing.T) {
+	_, err := Parse("https://example.org/", strings.NewReader(testPage), &model.PageFeedSelectors{PageItemSelector: "div.missing"})
+	if !errors.Is(err, ErrNoItemFound) {
+		t.Fatalf("Expected ErrNoItemFound, got: %v", err)
+	}
+}
@@ -0,0 +1,78 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package podcast // import "miniflux.app/v2/internal/reader/podcast"
+
+// Specs: https://podcasting2.org/docs/podcast-namespace
+// The channel funding and persons apply to every episode without their own values.
+type PodcastChannelElement struct {
+	PodcastFunding []PodcastFundingElement `xml:"https://podcastindex.org/namespace/1.0 funding"`
+	PodcastPersons []PodcastPersonElement  `xml:"https://podcastindex.org/namespace/1.0 person"`
+}
+
+type PodcastItemElement struct {
+	PodcastTranscripts         []PodcastTranscriptElement         `xml:"https://podcastindex.org/namespace/1.0 transcript"`
+	PodcastChapters            PodcastChaptersElement             `xml:"https://podcastindex.org/namespace/1.0 chapters"`
+	PodcastPersons             []PodcastPersonElement             `xml:"https://podcastindex.org/namespace/1.0 person"`
+	PodcastSeason              PodcastSeasonElement               `xml:"https://podcastindex.org/namespace/1.0 season"`
+	PodcastEpisode             PodcastEpisodeElement              `xml:"https://podcastindex.org/namespace/1.0 episode"`
+	PodcastFunding             []PodcastFundingElement            `xml:"https://podcastindex.org/namespace/1.0 funding"`
+	PodcastSoundbites          []PodcastSoundbiteElement          `xml:"https://podcastindex.org/namespace/1.0 soundbite"`
+	PodcastAlternateEnclosures []PodcastAlternateEnclosureElement `xml:"https://podcastindex.org/namespace/1.0 alternateEnclosure"`
+}
+
+type PodcastTranscriptElement struct {
+	URL      string `xml:"url,attr"`
+	Type     string `xml:"type,attr"`
+	Language string `xml:"language,attr"`
+	Rel      string `xml:"rel,attr"`
+}
+
+type PodcastChaptersElement struct {
+	URL  string `xml:"url,attr"`
+	Type string `xml:"type,attr"`
+}
+
+type PodcastPersonElement struct {
+	Name  string `xml:",chardata"`
+	Role  string `xml:"role,attr"`
+	Group string `xml:"group,attr"`
+	Image string `xml:"img,attr"`
+	Href  string `xml:"href,attr"`
+}
+
+type PodcastSeasonElement struct {
+	Number string `xml:",chardata"`
+	Name   string `xml:"name,attr"`
+}
+
+type PodcastEpisodeElement struct {
+	Number  string `xml:",chardata"`
+	Display string `xml:"display,attr"`
+}
+
+type PodcastFundingElement struct {
+	Title string `xml:",chardata"`
+	URL   string `xml:"url,attr"`
+}
+
+type PodcastSoundbiteElement struct {
+	Title     string `xml:",chardata"`
+	StartTime string `xml:"startTime,attr"`
+	Duration  string `xml:"duration,attr"`
+}
+
+// PodcastAlternateEnclosureElement is another version of the episode media file, for example with a different bitrate or language.
+// Each source is a different location of the same file.
+type PodcastAlternateEnclosureElement struct {
+	Type    string                 `xml:"type,attr"`
+	Length  string                 `xml:"length,attr"`
+	Title   string                 `xml:"title,attr"`
+	Default string                 `xml:"default,attr"`
+	Sources []PodcastSourceElement `xml:"https://podcastindex.org/namespace/1.0 source"`
+}
+
+type PodcastSourceElement struct {
+	URI         string `xml:"uri,attr"`
+	ContentType string `xml:"contentType,attr"`
+}
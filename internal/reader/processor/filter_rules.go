@@ -0,0 +1,190 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package processor // import "miniflux.app/v2/internal/reader/processor"
+
+import (
+	"log/slog"
+
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/reader/filter"
+	"miniflux.app/v2/internal/storage"
+)
+
+// filterRulesBatchSize is the number of entries loaded at once when applying the filter rules to stored entries.
+const filterRulesBatchSize = 500
+
+// StartFilterRulesJob applies the filter rules in the background and returns the job reporting the progress.
+// A user runs a single job at a time: the running job is returned instead of starting another one.
+func StartFilterRulesJob(store *storage.Storage, user *model.User, request *model.FilterApplyRequest) (*model.FilterApplyJob, error) {
+	runningJob, err := store.RunningFilterApplyJob(user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if runningJob != nil {
+		return runningJob, nil
+	}
+
+	job, err := store.CreateFilterApplyJob(user.ID, request)
+	if err != nil {
+		return nil, err
+	}
+
+	go runFilterRulesJob(store, user, request, *job)
+
+	return job, nil
+}
+
+func runFilterRulesJob(store *storage.Storage, user *model.User, request *model.FilterApplyRequest, job model.FilterApplyJob) {
+	result, err := applyFilterRules(store, user, request, func(result *model.FilterApplyResult) {
+		job.FilterApplyResult = *result
+		if err := store.UpdateFilterApplyJob(&job); err != nil {
+			slog.Warn("Unable to update the progress of the filter rules",
+				slog.Int64("user_id", user.ID),
+				slog.Int64("job_id", job.ID),
+				slog.Any("error", err),
+			)
+		}
+	})
+
+	if err != nil {
+		slog.Error("Unable to apply the filter rules to stored entries",
+			slog.Int64("user_id", user.ID),
+			slog.Int64("job_id", job.ID),
+			slog.Any("error", err),
+		)
+		job.Status = model.FilterApplyJobFailed
+		job.Error = err.Error()
+	} else {
+		job.Status = model.FilterApplyJobDone
+		job.FilterApplyResult = *result
+	}
+
+	if err := store.UpdateFilterApplyJob(&job); err != nil {
+		slog.Error("Unable to update the status of the filter rules",
+			slog.Int64("user_id", user.ID),
+			slog.Int64("job_id", job.ID),
+			slog.Any("error", err),
+		)
+	}
+}
+
+// ApplyFilterRules evaluates the saved user and feed filter rules against stored entries and applies the outcome
+// to the blocked entries. Entries are processed in batches to keep the memory usage constant on large accounts.
+func ApplyFilterRules(store *storage.Storage, user *model.User, request *model.FilterApplyRequest) (*model.FilterApplyResult, error) {
+	return applyFilterRules(store, user, request, nil)
+}
+
+// applyFilterRules calls onProgress, when defined, after each batch of entries.
+func applyFilterRules(store *storage.Storage, user *model.User, request *model.FilterApplyRequest, onProgress func(result *model.FilterApplyResult)) (*model.FilterApplyResult, error) {
+	feeds, err := store.Feeds(user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	isBlockedByFeedID := newBlockedEntryMatchers(user, feeds)
+
+	result := &model.FilterApplyResult{}
+	var lastEntryID int64
+
+	for {
+		builder := store.NewEntryQueryBuilder(user.ID).
+			AfterEntryID(lastEntryID).
+			WithSorting("id", "asc").
+			WithLimit(filterRulesBatchSize)
+
+		if request.FeedID > 0 {
+			builder.WithFeedID(request.FeedID)
+		}
+
+		if request.CategoryID > 0 {
+			builder.WithCategoryID(request.CategoryID)
+		}
+
+		// Read entries are left untouched when marking blocked entries as read, and starred entries are never deleted.
+		if request.Outcome == model.FilterOutcomeRead {
+			builder.WithStatuses(model.EntryStatusUnread)
+		} else {
+			builder.WithStarred(false)
+		}
+
+		entries, err := builder.GetEntries()
+		if err != nil {
+			return nil, err
+		}
+
+		if len(entries) == 0 {
+			break
+		}
+
+		blockedEntryIDs := make([]int64, 0)
+		for _, entry := range entries {
+			lastEntryID = entry.ID
+
+			isBlocked, found := isBlockedByFeedID[entry.FeedID]
+			if !found {
+				continue
+			}
+
+			result.EntriesCount++
+			if isBlocked(entry) {
+				blockedEntryIDs = append(blockedEntryIDs, entry.ID)
+			}
+		}
+
+		if len(blockedEntryIDs) > 0 {
+			blockedCount, err := applyFilterOutcome(store, user.ID, blockedEntryIDs, request.Outcome)
+			if err != nil {
+				return nil, err
+			}
+			result.BlockedCount += blockedCount
+		}
+
+		if onProgress != nil {
+			onProgress(result)
+		}
+
+		if len(entries) < filterRulesBatchSize {
+			break
+		}
+	}
+
+	slog.Info("Filter rules applied to stored entries",
+		slog.Int64("user_id", user.ID),
+		slog.Int64("feed_id", request.FeedID),
+		slog.Int64("category_id", request.CategoryID),
+		slog.String("outcome", request.Outcome),
+		slog.Int("entries_count", result.EntriesCount),
+		slog.Int("blocked_count", result.BlockedCount),
+	)
+
+	return result, nil
+}
+
+// newBlockedEntryMatchers returns the filter of each feed, the rules are parsed once per feed instead of once per entry.
+// The matches are not recorded in the statistics of the rules, only the entries received during a refresh are counted.
+func newBlockedEntryMatchers(user *model.User, feeds model.Feeds) map[int64]func(entry *model.Entry) bool {
+	isBlockedByFeedID := make(map[int64]func(entry *model.Entry) bool, len(feeds))
+	for _, feed := range feeds {
+		blockRules := filter.ParseRules(user.BlockFilterEntryRules, feed.BlockFilterEntryRules)
+		allowRules := filter.ParseRules(user.KeepFilterEntryRules, feed.KeepFilterEntryRules)
+		isBlockedByFeedID[feed.ID] = func(entry *model.Entry) bool {
+			return filter.IsBlockedEntry(blockRules, allowRules, feed, entry)
+		}
+	}
+	return isBlockedByFeedID
+}
+
+func applyFilterOutcome(store *storage.Storage, userID int64, entryIDs []int64, outcome string) (int, error) {
+	switch outcome {
+	case model.FilterOutcomeRemove:
+		count, err := store.RemoveEntries(userID, entryIDs)
+		return int(count), err
+	case model.FilterOutcomeDelete:
+		count, err := store.DeleteEntries(userID, entryIDs)
+		return int(count), err
+	default:
+		return len(entryIDs), store.SetEntriesStatus(userID, entryIDs, model.EntryStatusRead)
+	}
+}
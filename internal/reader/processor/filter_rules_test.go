@@ -0,0 +1,110 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package processor // import "miniflux.app/v2/internal/reader/processor"
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"miniflux.app/v2/internal/config"
+	"miniflux.app/v2/internal/crypto"
+	"miniflux.app/v2/internal/database"
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/reader/filter"
+	"miniflux.app/v2/internal/storage"
+)
+
+func TestBlockedEntryMatchersDoNotRecordRuleHits(t *testing.T) {
+	filter.FlushRuleHits()
+
+	user := &model.User{ID: 1, BlockFilterEntryRules: "EntryTitle=(?i)sponsored"}
+	feeds := model.Feeds{
+		{ID: 1, UserID: 1, BlocklistRules: "(?i)spam"},
+		{ID: 2, UserID: 1, KeepFilterEntryRules: "EntryTitle=(?i)release"},
+	}
+
+	isBlockedByFeedID := newBlockedEntryMatchers(user, feeds)
+
+	if !isBlockedByFeedID[1](&model.Entry{Title: "Sponsored post"}) || !isBlockedByFeedID[1](&model.Entry{Title: "Spam"}) {
+		t.Error(`The entries matching the block rules should be blocked`)
+	}
+
+	if isBlockedByFeedID[2](&model.Entry{Title: "Release 1.0"}) {
+		t.Error(`The entry matching the keep rules should not be blocked`)
+	}
+
+	if stats := filter.FlushRuleHits(); len(stats) != 0 {
+		t.Errorf(`Applying the filter rules to stored entries should not record rule hits, got %d rules`, len(stats))
+	}
+}
+
+func TestApplyFilterRulesDoesNotRecordRuleHits(t *testing.T) {
+	dsn := os.Getenv("TEST_MINIFLUX_DATABASE_URL")
+	if dsn == "" {
+		t.Skip(`Set TEST_MINIFLUX_DATABASE_URL to run this test`)
+	}
+
+	var err error
+	if config.Opts, err = config.NewConfigParser().ParseEnvironmentVariables(); err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	db, err := database.NewConnectionPool(dsn, 1, 5, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	if err := database.Migrate(db); err != nil {
+		t.Fatal(err)
+	}
+
+	store := storage.NewStorage(db)
+	user, err := store.CreateUser(&model.UserCreationRequest{Username: "processor_test_" + crypto.GenerateRandomStringHex(8)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer store.RemoveUser(user.ID)
+
+	category, err := store.FirstCategory(user.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	feed := &model.Feed{
+		UserID:                user.ID,
+		FeedURL:               "https://example.org/feed.xml",
+		SiteURL:               "https://example.org/",
+		Title:                 "Example",
+		Category:              category,
+		BlockFilterEntryRules: "EntryTitle=(?i)sponsored",
+	}
+	if err := store.CreateFeed(feed); err != nil {
+		t.Fatal(err)
+	}
+
+	entries := model.Entries{
+		{Hash: "sponsored", Title: "Sponsored post", URL: "https://example.org/sponsored", Date: time.Now(), Tags: []string{}},
+		{Hash: "regular", Title: "Regular post", URL: "https://example.org/regular", Date: time.Now(), Tags: []string{}},
+	}
+	if _, _, err := store.RefreshFeedEntries(user.ID, feed.ID, entries, true, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	filter.FlushRuleHits()
+
+	result, err := ApplyFilterRules(store, user, &model.FilterApplyRequest{Outcome: model.FilterOutcomeRead})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result.EntriesCount != 2 || result.BlockedCount != 1 {
+		t.Errorf(`Unexpected result, got %d entries and %d blocked entries`, result.EntriesCount, result.BlockedCount)
+	}
+
+	if stats := filter.FlushRuleHits(); len(stats) != 0 {
+		t.Errorf(`Applying the filter rules to stored entries should not record rule hits, got %d rules`, len(stats))
+	}
+}
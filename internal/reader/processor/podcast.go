@@ -0,0 +1,168 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package processor // import "miniflux.app/v2/internal/reader/processor"
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"slices"
+	"strings"
+
+	"miniflux.app/v2/internal/config"
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/reader/fetcher"
+	"miniflux.app/v2/internal/reader/sanitizer"
+	"miniflux.app/v2/internal/urllib"
+)
+
+var webVTTVoiceRegex = regexp.MustCompile(`<v(?:\.[^ >]+)? ([^>]+)>`)
+
+// podcastChaptersDocument represents a JSON chapters file.
+// Specs: https://github.com/Podcastindex-org/podcast-namespace/blob/main/docs/examples/chapters/jsonChapters.md
+type podcastChaptersDocument struct {
+	Chapters []struct {
+		StartTime float64 `json:"startTime"`
+		Title     string  `json:"title"`
+		Image     string  `json:"img"`
+		URL       string  `json:"url"`
+		TOC       *bool   `json:"toc"`
+	} `json:"chapters"`
+}
+
+func shouldFetchPodcastResources(entry *model.Entry) bool {
+	if !config.Opts.FetchPodcastResources() {
+		return false
+	}
+
+	return entry.Podcast != nil && (entry.Podcast.ChaptersURL != "" || entry.Podcast.FindTextTranscript() != nil)
+}
+
+// fetchPodcastResources downloads the transcript and the chapters of the episode.
+// Errors are logged because the entry is still usable without them.
+func fetchPodcastResources(requestBuilder *fetcher.RequestBuilder, entry *model.Entry) {
+	if transcript := entry.Podcast.FindTextTranscript(); transcript != nil {
+		data, err := fetchPodcastResource(requestBuilder, transcript.URL)
+		if err != nil {
+			slog.Warn("Unable to fetch podcast transcript",
+				slog.String("entry_url", entry.URL),
+				slog.String("transcript_url", transcript.URL),
+				slog.Any("error", err),
+			)
+		} else {
+			entry.Transcript = convertTranscriptToText(transcript.MimeType, string(data))
+		}
+	}
+
+	if entry.Podcast.ChaptersURL != "" {
+		data, err := fetchPodcastResource(requestBuilder, entry.Podcast.ChaptersURL)
+		if err == nil {
+			entry.Podcast.Chapters, err = parsePodcastChapters(entry.Podcast.ChaptersURL, data)
+		}
+
+		if err != nil {
+			slog.Warn("Unable to fetch podcast chapters",
+				slog.String("entry_url", entry.URL),
+				slog.String("chapters_url", entry.Podcast.ChaptersURL),
+				slog.Any("error", err),
+			)
+		}
+	}
+}
+
+func fetchPodcastResource(requestBuilder *fetcher.RequestBuilder, resourceURL string) ([]byte, error) {
+	responseHandler := fetcher.NewResponseHandler(requestBuilder.ExecuteRequest(resourceURL))
+	defer responseHandler.Close()
+
+	if localizedError := responseHandler.LocalizedError(); localizedError != nil {
+		return nil, localizedError.Error()
+	}
+
+	data, localizedError := responseHandler.ReadBody(config.Opts.HTTPClientMaxBodySize())
+	if localizedError != nil {
+		return nil, localizedError.Error()
+	}
+
+	return data, nil
+}
+
+// parsePodcastChapters returns the chapters sorted by start time.
+// Chapters excluded from the table of contents are ignored.
+func parsePodcastChapters(chaptersURL string, data []byte) ([]*model.PodcastChapter, error) {
+	var document podcastChaptersDocument
+	if err := json.Unmarshal(data, &document); err != nil {
+		return nil, fmt.Errorf("unable to parse chapters: %w", err)
+	}
+
+	chapters := make([]*model.PodcastChapter, 0, len(document.Chapters))
+	for _, chapter := range document.Chapters {
+		if chapter.StartTime < 0 || (chapter.TOC != nil && !*chapter.TOC) {
+			continue
+		}
+
+		chapters = append(chapters, &model.PodcastChapter{
+			StartTime: chapter.StartTime,
+			Title:     strings.TrimSpace(chapter.Title),
+			URL:       resolvePodcastChapterURL(chaptersURL, chapter.URL),
+			ImageURL:  resolvePodcastChapterURL(chaptersURL, chapter.Image),
+		})
+	}
+
+	slices.SortStableFunc(chapters, func(a, b *model.PodcastChapter) int {
+		switch {
+		case a.StartTime < b.StartTime:
+			return -1
+		case a.StartTime > b.StartTime:
+			return 1
+		default:
+			return 0
+		}
+	})
+
+	return chapters, nil
+}
+
+func resolvePodcastChapterURL(chaptersURL, link string) string {
+	link = strings.TrimSpace(link)
+	if link == "" {
+		return ""
+	}
+
+	absoluteURL, err := urllib.ResolveToAbsoluteURL(chaptersURL, link)
+	if err != nil || !urllib.IsAbsoluteURL(absoluteURL) {
+		return ""
+	}
+
+	return absoluteURL
+}
+
+// convertTranscriptToText removes the timestamps of WebVTT and SubRip transcripts, and the tags of HTML transcripts.
+func convertTranscriptToText(mimeType, data string) string {
+	data = strings.ReplaceAll(data, "\r\n", "\n")
+
+	switch strings.ToLower(mimeType) {
+	case "text/vtt", "application/x-subrip", "application/srt":
+		var lines []string
+		for block := range strings.SplitSeq(data, "\n\n") {
+			// Blocks without timing line are headers, notes or styles.
+			_, cue, found := strings.Cut(block, "-->")
+			if !found {
+				continue
+			}
+
+			_, text, _ := strings.Cut(cue, "\n")
+			text = webVTTVoiceRegex.ReplaceAllString(text, "$1: ")
+			text = strings.Join(strings.Fields(sanitizer.StripTags(text)), " ")
+			if text != "" {
+				lines = append(lines, text)
+			}
+		}
+		return strings.Join(lines, "\n")
+	case "text/html":
+		return sanitizer.StripTags(data)
+	default:
+		return strings.TrimSpace(data)
+	}
+}
@@ -0,0 +1,72 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package processor // import "miniflux.app/v2/internal/reader/processor"
+
+import (
+	"testing"
+)
+
+func TestConvertTranscriptToText(t *testing.T) {
+	scenarios := []struct {
+		mimeType string
+		data     string
+		expected string
+	}{
+		{
+			"text/vtt",
+			"WEBVTT\r\n\r\nNOTE This is a comment\r\n\r\n1\r\n00:00:00.000 --> 00:00:02.000\r\n<v Alice>Hello <b>everyone</b>\r\n\r\n00:00:02.000 --> 00:00:04.000 align:start\r\nWelcome to\r\nthe show\r\n",
+			"Alice: Hello everyone\nWelcome to the show",
+		},
+		{
+			"application/x-subrip",
+			"1\n00:00:00,000 --> 00:00:02,000\nFirst line\n\n2\n00:00:02,000 --> 00:00:04,000\nSecond &amp; last line\n",
+			"First line\nSecond & last line",
+		},
+		{"text/html", "<p>Hello</p><p>World</p>", "HelloWorld"},
+		{"text/plain", "  Plain transcript\n", "Plain transcript"},
+	}
+
+	for _, scenario := range scenarios {
+		if result := convertTranscriptToText(scenario.mimeType, scenario.data); result != scenario.expected {
+			t.Errorf(`Unexpected result for %s, got %q instead of %q`, scenario.mimeType, result, scenario.expected)
+		}
+	}
+}
+
+func TestParsePodcastChapters(t *testing.T) {
+	data := []byte(`{
+		"version": "1.2.0",
+		"chapters": [
+			{"startTime": 120.5, "title": "Second", "url": "/second", "img": "https://example.org/second.jpg"},
+			{"startTime": 60, "title": "Hidden", "toc": false},
+			{"startTime": 0, "title": "Intro", "url": "javascript:alert(1)"},
+			{"startTime": 30, "title": "No links"}
+		]
+	}`)
+
+	chapters, err := parsePodcastChapters("https://example.org/chapters.json", data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(chapters) != 3 {
+		t.Fatalf(`Unexpected number of chapters, got %d`, len(chapters))
+	}
+
+	if chapters[0].StartTime != 0 || chapters[0].Title != "Intro" || chapters[0].URL != "" {
+		t.Errorf(`Unexpected first chapter: %+v`, chapters[0])
+	}
+
+	if chapters[1].StartTime != 30 || chapters[1].URL != "" || chapters[1].ImageURL != "" {
+		t.Errorf(`Unexpected second chapter: %+v`, chapters[1])
+	}
+
+	if chapters[2].StartTime != 120.5 || chapters[2].URL != "https://example.org/second" || chapters[2].ImageURL != "https://example.org/second.jpg" {
+		t.Errorf(`Unexpected third chapter: %+v`, chapters[2])
+	}
+
+	if _, err := parsePodcastChapters("https://example.org/chapters.json", []byte("invalid")); err == nil {
+		t.Error(`An error should be returned for invalid documents`)
+	}
+}
@@ -0,0 +1,115 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package processor // import "miniflux.app/v2/internal/reader/processor"
+
+import (
+	"log/slog"
+
+	"github.com/andybalholm/cascadia"
+
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/reader/rewrite"
+	"miniflux.app/v2/internal/reader/sanitizer"
+	"miniflux.app/v2/internal/reader/scraper"
+)
+
+// PreviewFeedEntries applies the feed rules to a copy of the given entries without persisting anything.
+// The entries must be freshly fetched, the stored entries have already been rewritten and sanitized.
+// The original web page is fetched only when the crawler is enabled or when scraper rules are defined.
+func PreviewFeedEntries(feed *model.Feed, entries model.Entries, user *model.User) *model.FeedPreview {
+	preview := &model.FeedPreview{
+		Errors:  validateFeedRules(feed),
+		Entries: make([]*model.EntryPreview, 0, len(entries)),
+	}
+
+	// Invalid rules are reported once, there is no point in running them on each entry.
+	shouldScrape := (feed.Crawler || feed.ScraperRules != "") && !hasFeedRuleError(preview.Errors, "scraper_rules")
+	requestBuilder := newFeedRequestBuilder(feed)
+
+	for _, originalEntry := range entries {
+		entry := *originalEntry
+		entry.Feed = feed
+
+		// The original content is sanitized like any entry, the preview page shows it without rules.
+		entryPreview := &model.EntryPreview{
+			Before: model.EntryPreviewContent{
+				URL:     entry.URL,
+				Title:   entry.Title,
+				Content: sanitizer.SanitizeHTML(entry.URL, entry.Content, &sanitizer.SanitizerOptions{OpenLinksInNewTab: user.OpenExternalLinksInNewTab}),
+			},
+		}
+
+		entry.URL = rewrite.RewriteEntryURL(feed, &entry)
+
+		webpageBaseURL := ""
+		if shouldScrape {
+			scrapedPageBaseURL, extractedContent, _, scraperErr := scraper.ScrapeWebsite(
+				requestBuilder,
+				entry.URL,
+				feed.ScraperRules,
+			)
+
+			if scraperErr != nil {
+				slog.Debug("Unable to scrape entry during preview",
+					slog.Int64("user_id", user.ID),
+					slog.String("entry_url", entry.URL),
+					slog.Int64("feed_id", feed.ID),
+					slog.Any("error", scraperErr),
+				)
+				entryPreview.Error = scraperErr.Error()
+			} else if extractedContent != "" {
+				entry.Content = minifyContent(extractedContent)
+				webpageBaseURL = scrapedPageBaseURL
+			}
+		}
+
+		rewrite.ApplyContentRewriteRules(&entry, feed.RewriteRules)
+
+		if webpageBaseURL == "" {
+			webpageBaseURL = entry.URL
+		}
+
+		entry.Content = sanitizer.SanitizeHTML(webpageBaseURL, entry.Content, &sanitizer.SanitizerOptions{OpenLinksInNewTab: user.OpenExternalLinksInNewTab})
+
+		entryPreview.After = model.EntryPreviewContent{
+			URL:     entry.URL,
+			Title:   entry.Title,
+			Content: entry.Content,
+		}
+
+		preview.Entries = append(preview.Entries, entryPreview)
+	}
+
+	return preview
+}
+
+// validateFeedRules returns the errors found in the scraper, rewrite and URL rewrite rules of the feed.
+func validateFeedRules(feed *model.Feed) []*model.FeedRuleError {
+	ruleErrors := make([]*model.FeedRuleError, 0)
+
+	if feed.ScraperRules != "" {
+		if _, err := cascadia.Compile(feed.ScraperRules); err != nil {
+			ruleErrors = append(ruleErrors, &model.FeedRuleError{Field: "scraper_rules", Error: err.Error()})
+		}
+	}
+
+	for _, err := range rewrite.ValidateContentRewriteRules(feed.RewriteRules) {
+		ruleErrors = append(ruleErrors, &model.FeedRuleError{Field: "rewrite_rules", Error: err.Error()})
+	}
+
+	if err := rewrite.ValidateURLRewriteRules(feed.UrlRewriteRules); err != nil {
+		ruleErrors = append(ruleErrors, &model.FeedRuleError{Field: "urlrewrite_rules", Error: err.Error()})
+	}
+
+	return ruleErrors
+}
+
+func hasFeedRuleError(ruleErrors []*model.FeedRuleError, field string) bool {
+	for _, ruleError := range ruleErrors {
+		if ruleError.Field == field {
+			return true
+		}
+	}
+	return false
+}
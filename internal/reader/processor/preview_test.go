@@ -0,0 +1,88 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package processor // import "miniflux.app/v2/internal/reader/processor"
+
+import (
+	"strings"
+	"testing"
+
+	"miniflux.app/v2/internal/config"
+	"miniflux.app/v2/internal/model"
+)
+
+func TestValidateFeedRules(t *testing.T) {
+	scenarios := []struct {
+		feed           *model.Feed
+		expectedFields []string
+	}{
+		{&model.Feed{}, nil},
+		{&model.Feed{ScraperRules: "article .content", RewriteRules: "nl2br", UrlRewriteRules: `rewrite("^(.+)$"|"$1")`}, nil},
+		{&model.Feed{ScraperRules: "div["}, []string{"scraper_rules"}},
+		{&model.Feed{RewriteRules: `unknown,replace("(")`}, []string{"rewrite_rules", "rewrite_rules"}},
+		{&model.Feed{UrlRewriteRules: "invalid"}, []string{"urlrewrite_rules"}},
+	}
+
+	for _, scenario := range scenarios {
+		ruleErrors := validateFeedRules(scenario.feed)
+		if len(ruleErrors) != len(scenario.expectedFields) {
+			t.Fatalf(`Unexpected number of errors for %+v, got %d instead of %d`, scenario.feed, len(ruleErrors), len(scenario.expectedFields))
+		}
+
+		for i, field := range scenario.expectedFields {
+			if ruleErrors[i].Field != field {
+				t.Errorf(`Unexpected field, got %q instead of %q`, ruleErrors[i].Field, field)
+			}
+		}
+
+		if hasFeedRuleError(ruleErrors, "scraper_rules") != (scenario.feed.ScraperRules == "div[") {
+			t.Errorf(`Unexpected scraper rules error detection for %+v`, scenario.feed)
+		}
+	}
+}
+
+func TestPreviewFeedEntries(t *testing.T) {
+	var err error
+	if config.Opts, err = config.NewConfigParser().ParseEnvironmentVariables(); err != nil {
+		t.Fatal(err)
+	}
+
+	feed := &model.Feed{
+		ID:              1,
+		RewriteRules:    "add_image_title",
+		UrlRewriteRules: `rewrite("^https://example.org/(.+)$"|"https://example.org/$1?full=1")`,
+	}
+	entries := model.Entries{
+		{
+			URL:     "https://example.org/article",
+			Title:   "Article",
+			Content: `<p>Text</p><script>alert(1)</script><img src="https://example.org/image.png" title="Caption">`,
+		},
+	}
+
+	preview := PreviewFeedEntries(feed, entries, &model.User{})
+	if len(preview.Errors) != 0 || len(preview.Entries) != 1 {
+		t.Fatalf(`Unexpected preview: %+v`, preview)
+	}
+
+	entryPreview := preview.Entries[0]
+	if entryPreview.Before.URL != "https://example.org/article" {
+		t.Errorf(`The original URL should be kept before the rules, got %q`, entryPreview.Before.URL)
+	}
+
+	if entryPreview.After.URL != "https://example.org/article?full=1" {
+		t.Errorf(`The URL should be rewritten once, got %q`, entryPreview.After.URL)
+	}
+
+	if strings.Contains(entryPreview.Before.Content, "<script>") || strings.Contains(entryPreview.After.Content, "<script>") {
+		t.Errorf(`The content should be sanitized before and after the rules, got %q and %q`, entryPreview.Before.Content, entryPreview.After.Content)
+	}
+
+	if strings.Contains(entryPreview.Before.Content, "<figcaption>") || !strings.Contains(entryPreview.After.Content, "<figcaption><p>Caption</p></figcaption>") {
+		t.Errorf(`The rewrite rules should only be applied after, got %q and %q`, entryPreview.Before.Content, entryPreview.After.Content)
+	}
+
+	if entries[0].URL != "https://example.org/article" {
+		t.Errorf(`The given entries should not be modified, got %q`, entries[0].URL)
+	}
+}
@@ -15,6 +15,7 @@ import (
 	"miniflux.app/v2/internal/proxyrotator"
 	"miniflux.app/v2/internal/reader/fetcher"
 	"miniflux.app/v2/internal/reader/filter"
+	"miniflux.app/v2/internal/reader/fingerprint"
 	"miniflux.app/v2/internal/reader/readingtime"
 	"miniflux.app/v2/internal/reader/rewrite"
 	"miniflux.app/v2/internal/reader/sanitizer"
@@ -37,29 +38,11 @@ func ProcessFeedEntries(store *storage.Storage, feed *model.Feed, userID int64,
 	parsedFeedURL, _ := url.Parse(feed.FeedURL)
 	parsedSiteURL, _ := url.Parse(feed.SiteURL)
 
-	blockRules := filter.ParseRules(user.BlockFilterEntryRules, feed.BlockFilterEntryRules)
-	allowRules := filter.ParseRules(user.KeepFilterEntryRules, feed.KeepFilterEntryRules)
-	slog.Debug("Filter rules",
-		slog.String("user_block_filter_rules", user.BlockFilterEntryRules),
-		slog.String("feed_block_filter_rules", feed.BlockFilterEntryRules),
-		slog.String("user_keep_filter_rules", user.KeepFilterEntryRules),
-		slog.String("feed_keep_filter_rules", feed.KeepFilterEntryRules),
-		slog.Any("block_rules", blockRules),
-		slog.Any("allow_rules", allowRules),
-		slog.Int64("user_id", user.ID),
-		slog.Int64("feed_id", feed.ID),
-	)
+	isBlockedEntry := newIncomingEntryMatcher(user, feed)
 
-	requestBuilder := fetcher.NewRequestBuilder().
-		WithUserAgent(feed.UserAgent, config.Opts.HTTPClientUserAgent()).
-		WithCookie(feed.Cookie).
-		WithTimeout(config.Opts.HTTPClientTimeout()).
-		WithProxyRotator(proxyrotator.ProxyRotatorInstance).
-		WithCustomFeedProxyURL(feed.ProxyURL).
-		WithCustomApplicationProxyURL(config.Opts.HTTPClientProxyURL()).
-		UseCustomApplicationProxyURL(feed.FetchViaProxy).
-		IgnoreTLSErrors(feed.AllowSelfSignedCertificates).
-		DisableHTTP2(feed.DisableHTTP2)
+	actionRules := filter.ParseActionRules(user.ActionEntryRules, feed.ActionEntryRules)
+
+	requestBuilder := newFeedRequestBuilder(feed)
 
 	// Processing older entries first ensures that their creation timestamp is lower than newer entries.
 	for _, entry := range slices.Backward(feed.Entries) {
@@ -72,7 +55,7 @@ func ProcessFeedEntries(store *storage.Storage, feed *model.Feed, userID int64,
 			slog.String("feed_url", feed.FeedURL),
 		)
 
-		if filter.IsBlockedEntry(blockRules, allowRules, feed, entry) {
+		if isBlockedEntry(entry) {
 			slog.Debug("Entry is blocked by filter rules",
 				slog.Int64("user_id", user.ID),
 				slog.String("entry_url", entry.URL),
@@ -91,6 +74,7 @@ func ProcessFeedEntries(store *storage.Storage, feed *model.Feed, userID int64,
 		}
 
 		webpageBaseURL := ""
+		var webpageImage *scraper.PageImage
 		entry.URL = rewrite.RewriteEntryURL(feed, entry)
 		entryIsNew := store.IsNewEntry(feed.ID, entry.Hash)
 		contentExtractedSuccessfully := false
@@ -108,7 +92,7 @@ func ProcessFeedEntries(store *storage.Storage, feed *model.Feed, userID int64,
 
 			startTime := time.Now()
 
-			scrapedPageBaseURL, extractedContent, scraperErr := scraper.ScrapeWebsite(
+			scrapedPageBaseURL, extractedContent, scrapedPageImage, scraperErr := scraper.ScrapeWebsite(
 				requestBuilder,
 				entry.URL,
 				feed.ScraperRules,
@@ -118,6 +102,8 @@ func ProcessFeedEntries(store *storage.Storage, feed *model.Feed, userID int64,
 				webpageBaseURL = scrapedPageBaseURL
 			}
 
+			webpageImage = scrapedPageImage
+
 			if config.Opts.HasMetricsCollector() {
 				status := metric.StatusSuccess
 				if scraperErr != nil {
@@ -144,7 +130,7 @@ func ProcessFeedEntries(store *storage.Storage, feed *model.Feed, userID int64,
 		rewrite.ApplyContentRewriteRules(entry, feed.RewriteRules)
 
 		// Re-run filters only when extracted content replaced entry.Content.
-		if contentExtractedSuccessfully && filter.IsBlockedEntry(blockRules, allowRules, feed, entry) {
+		if contentExtractedSuccessfully && isBlockedEntry(entry) {
 			slog.Debug("Entry is blocked by filter rules",
 				slog.Int64("user_id", user.ID),
 				slog.String("entry_url", entry.URL),
@@ -165,6 +151,23 @@ func ProcessFeedEntries(store *storage.Storage, feed *model.Feed, userID int64,
 		entry.Content = sanitizer.SanitizeHTML(webpageBaseURL, entry.Content, &sanitizer.SanitizerOptions{OpenLinksInNewTab: user.OpenExternalLinksInNewTab})
 
 		updateEntryReadingTime(store, feed, entry, entryIsNew, user)
+		updateEntryThumbnail(entry, webpageImage)
+
+		if entryIsNew && user.MarkDuplicateEntriesAsRead {
+			markDuplicateEntryAsRead(store, user.ID, feed, entry)
+		}
+
+		// Action rules run after the duplicate check: a "mark as unread" rule wins over the duplicate status.
+		applyEntryActions(actionRules, user.ID, feed, entry)
+
+		entry.Fingerprint = fingerprint.Compute(entry.Title, entry.Content)
+		if entryIsNew && entry.Fingerprint != 0 && user.GroupEntriesByStory {
+			assignEntryStory(store, user.ID, feed, entry)
+		}
+
+		if entryIsNew && shouldFetchPodcastResources(entry) {
+			fetchPodcastResources(requestBuilder, entry)
+		}
 
 		filteredEntries = append(filteredEntries, entry)
 	}
@@ -176,23 +179,111 @@ func ProcessFeedEntries(store *storage.Storage, feed *model.Feed, userID int64,
 	feed.Entries = filteredEntries
 }
 
+// newIncomingEntryMatcher returns the filter applied to the entries received during a refresh.
+// The reading time is estimated from the current content before each evaluation, otherwise the
+// reading_time field of the filter expressions would always be zero.
+func newIncomingEntryMatcher(user *model.User, feed *model.Feed) func(entry *model.Entry) bool {
+	blockRules := filter.ParseRules(user.BlockFilterEntryRules, feed.BlockFilterEntryRules)
+	allowRules := filter.ParseRules(user.KeepFilterEntryRules, feed.KeepFilterEntryRules)
+	slog.Debug("Filter rules",
+		slog.String("user_block_filter_rules", user.BlockFilterEntryRules),
+		slog.String("feed_block_filter_rules", feed.BlockFilterEntryRules),
+		slog.String("user_keep_filter_rules", user.KeepFilterEntryRules),
+		slog.String("feed_keep_filter_rules", feed.KeepFilterEntryRules),
+		slog.Any("block_rules", blockRules),
+		slog.Any("allow_rules", allowRules),
+		slog.Int64("user_id", user.ID),
+		slog.Int64("feed_id", feed.ID),
+	)
+
+	return func(entry *model.Entry) bool {
+		entry.ReadingTime = readingtime.EstimateReadingTime(entry.Content, user.DefaultReadingSpeed, user.CJKReadingSpeed)
+		return filter.IsBlockedIncomingEntry(blockRules, allowRules, feed, entry)
+	}
+}
+
+// applyEntryActions applies the first action rule matching the entry.
+// The status and the starred flag are only stored for new entries.
+func applyEntryActions(actionRules filter.ActionRules, userID int64, feed *model.Feed, entry *model.Entry) {
+	actions := filter.MatchActionRules(actionRules, entry)
+	if actions == nil {
+		return
+	}
+
+	slog.Debug("Entry matches action rule",
+		slog.Int64("user_id", userID),
+		slog.String("entry_url", entry.URL),
+		slog.String("entry_title", entry.Title),
+		slog.Int64("feed_id", feed.ID),
+		slog.Any("actions", actions),
+	)
+
+	if actions.Status != "" {
+		entry.Status = actions.Status
+	}
+
+	if actions.Starred {
+		entry.Starred = true
+	}
+
+	for _, tag := range actions.Tags {
+		if !slices.Contains(entry.Tags, tag) {
+			entry.Tags = append(entry.Tags, tag)
+		}
+	}
+
+	entry.Actions = actions
+}
+
+// markDuplicateEntryAsRead links the entry to a recent entry with the same URL found in another feed.
+func markDuplicateEntryAsRead(store *storage.Storage, userID int64, feed *model.Feed, entry *model.Entry) {
+	originalEntryID, err := store.FindDuplicateEntryID(userID, feed.ID, entry.URL)
+	if err != nil {
+		slog.Warn("Unable to check if the entry is a duplicate",
+			slog.Int64("user_id", userID),
+			slog.String("entry_url", entry.URL),
+			slog.Int64("feed_id", feed.ID),
+			slog.Any("error", err),
+		)
+		return
+	}
+
+	if originalEntryID > 0 {
+		slog.Debug("Entry is a duplicate of an entry from another feed",
+			slog.Int64("user_id", userID),
+			slog.String("entry_url", entry.URL),
+			slog.Int64("feed_id", feed.ID),
+			slog.Int64("original_entry_id", originalEntryID),
+		)
+		entry.DuplicateOfID = originalEntryID
+		entry.Status = model.EntryStatusRead
+	}
+}
+
+// assignEntryStory groups the entry with a recent entry of another feed telling the same story.
+func assignEntryStory(store *storage.Storage, userID int64, feed *model.Feed, entry *model.Entry) {
+	storyID, err := store.FindStoryID(userID, feed.ID, entry.Fingerprint, fingerprint.MaxDistance)
+	if err != nil {
+		slog.Warn("Unable to find the story of the entry",
+			slog.Int64("user_id", userID),
+			slog.String("entry_url", entry.URL),
+			slog.Int64("feed_id", feed.ID),
+			slog.Any("error", err),
+		)
+		return
+	}
+
+	entry.StoryID = storyID
+}
+
 // ProcessEntryWebPage downloads the entry web page and apply rewrite rules.
 func ProcessEntryWebPage(feed *model.Feed, entry *model.Entry, user *model.User) error {
 	startTime := time.Now()
 	entry.URL = rewrite.RewriteEntryURL(feed, entry)
 
-	requestBuilder := fetcher.NewRequestBuilder().
-		WithUserAgent(feed.UserAgent, config.Opts.HTTPClientUserAgent()).
-		WithCookie(feed.Cookie).
-		WithTimeout(config.Opts.HTTPClientTimeout()).
-		WithProxyRotator(proxyrotator.ProxyRotatorInstance).
-		WithCustomFeedProxyURL(feed.ProxyURL).
-		WithCustomApplicationProxyURL(config.Opts.HTTPClientProxyURL()).
-		UseCustomApplicationProxyURL(feed.FetchViaProxy).
-		IgnoreTLSErrors(feed.AllowSelfSignedCertificates).
-		DisableHTTP2(feed.DisableHTTP2)
+	requestBuilder := newFeedRequestBuilder(feed)
 
-	webpageBaseURL, extractedContent, scraperErr := scraper.ScrapeWebsite(
+	webpageBaseURL, extractedContent, webpageImage, scraperErr := scraper.ScrapeWebsite(
 		requestBuilder,
 		entry.URL,
 		feed.ScraperRules,
@@ -219,6 +310,21 @@ func ProcessEntryWebPage(feed *model.Feed, entry *model.Entry, user *model.User)
 
 	rewrite.ApplyContentRewriteRules(entry, entry.Feed.RewriteRules)
 	entry.Content = sanitizer.SanitizeHTML(webpageBaseURL, entry.Content, &sanitizer.SanitizerOptions{OpenLinksInNewTab: user.OpenExternalLinksInNewTab})
+	updateEntryThumbnail(entry, webpageImage)
 
 	return nil
 }
+
+// newFeedRequestBuilder returns a request builder configured with the HTTP settings of the feed.
+func newFeedRequestBuilder(feed *model.Feed) *fetcher.RequestBuilder {
+	return fetcher.NewRequestBuilder().
+		WithUserAgent(feed.UserAgent, config.Opts.HTTPClientUserAgent()).
+		WithCookie(feed.Cookie).
+		WithTimeout(config.Opts.HTTPClientTimeout()).
+		WithProxyRotator(proxyrotator.ProxyRotatorInstance).
+		WithCustomFeedProxyURL(feed.ProxyURL).
+		WithCustomApplicationProxyURL(config.Opts.HTTPClientProxyURL()).
+		UseCustomApplicationProxyURL(feed.FetchViaProxy).
+		IgnoreTLSErrors(feed.AllowSelfSignedCertificates).
+		DisableHTTP2(feed.DisableHTTP2)
+}
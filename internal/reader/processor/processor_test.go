@@ -0,0 +1,52 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package processor // import "miniflux.app/v2/internal/reader/processor"
+
+import (
+	"strings"
+	"testing"
+
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/reader/filter"
+)
+
+func TestIncomingEntryMatcherEstimatesReadingTime(t *testing.T) {
+	defer filter.FlushRuleHits()
+
+	user := &model.User{ID: 1, BlockFilterEntryRules: "reading_time >= 4", DefaultReadingSpeed: 200, CJKReadingSpeed: 500}
+	feed := &model.Feed{ID: 1, UserID: 1}
+	isBlockedEntry := newIncomingEntryMatcher(user, feed)
+
+	longEntry := &model.Entry{Title: "Long read", Content: strings.Repeat("word ", 1000)}
+	if !isBlockedEntry(longEntry) {
+		t.Error(`The entry with a long reading time should be blocked`)
+	}
+
+	if longEntry.ReadingTime != 5 {
+		t.Errorf(`Unexpected reading time, got %d instead of 5`, longEntry.ReadingTime)
+	}
+
+	shortEntry := &model.Entry{Title: "Short read", Content: strings.Repeat("word ", 100)}
+	if isBlockedEntry(shortEntry) {
+		t.Error(`The entry with a short reading time should not be blocked`)
+	}
+}
+
+func TestIncomingEntryMatcherUsesTheCurrentContent(t *testing.T) {
+	defer filter.FlushRuleHits()
+
+	user := &model.User{ID: 1, KeepFilterEntryRules: "reading_time > 2", DefaultReadingSpeed: 200, CJKReadingSpeed: 500}
+	feed := &model.Feed{ID: 1, UserID: 1}
+	isBlockedEntry := newIncomingEntryMatcher(user, feed)
+
+	entry := &model.Entry{Title: "Summary", Content: "A short summary."}
+	if !isBlockedEntry(entry) {
+		t.Error(`The entry should be blocked before its content is scraped`)
+	}
+
+	entry.Content = strings.Repeat("word ", 1000)
+	if isBlockedEntry(entry) {
+		t.Error(`The entry should be kept once the scraped content is long enough`)
+	}
+}
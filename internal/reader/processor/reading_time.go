@@ -64,6 +64,9 @@ func updateEntryReadingTime(store *storage.Storage, feed *model.Feed, entry *mod
 		return
 	}
 
+	// The estimate used by the filter rules is replaced by the watch time of videos or by an estimate of the final content.
+	entry.ReadingTime = 0
+
 	// Define watch time fetching scenarios
 	watchTimeScenarios := [...]struct {
 		shouldFetch func(*model.Entry) bool
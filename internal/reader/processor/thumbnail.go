@@ -0,0 +1,69 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package processor // import "miniflux.app/v2/internal/reader/processor"
+
+import (
+	"strconv"
+	"strings"
+
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/reader/scraper"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// Images smaller than this size are usually icons, emojis or tracking pixels.
+const minThumbnailSize = 48
+
+// updateEntryThumbnail defines the entry thumbnail when the feed does not provide one.
+// The image advertised by the web page is preferred to the first image of the content.
+func updateEntryThumbnail(entry *model.Entry, webpageImage *scraper.PageImage) {
+	if entry.ThumbnailURL != "" {
+		return
+	}
+
+	if webpageImage != nil && entry.SetThumbnail(webpageImage.URL, webpageImage.Width, webpageImage.Height) {
+		return
+	}
+
+	for _, enclosure := range entry.Enclosures {
+		if enclosure.IsImage() && entry.SetThumbnail(enclosure.URL, 0, 0) {
+			return
+		}
+	}
+
+	if imageURL, width, height := findContentThumbnail(entry.Content); imageURL != "" {
+		entry.SetThumbnail(imageURL, width, height)
+	}
+}
+
+// findContentThumbnail returns the first suitable image of the sanitized content.
+func findContentThumbnail(content string) (imageURL string, width, height int) {
+	if !strings.Contains(content, "<img") {
+		return "", 0, 0
+	}
+
+	document, err := goquery.NewDocumentFromReader(strings.NewReader(content))
+	if err != nil {
+		return "", 0, 0
+	}
+
+	document.Find("img[src]").EachWithBreak(func(_ int, img *goquery.Selection) bool {
+		src := strings.TrimSpace(img.AttrOr("src", ""))
+		if !strings.HasPrefix(src, "http://") && !strings.HasPrefix(src, "https://") {
+			return true
+		}
+
+		imageWidth, _ := strconv.Atoi(img.AttrOr("width", ""))
+		imageHeight, _ := strconv.Atoi(img.AttrOr("height", ""))
+		if (imageWidth > 0 && imageWidth < minThumbnailSize) || (imageHeight > 0 && imageHeight < minThumbnailSize) {
+			return true
+		}
+
+		imageURL, width, height = src, max(imageWidth, 0), max(imageHeight, 0)
+		return false
+	})
+
+	return imageURL, width, height
+}
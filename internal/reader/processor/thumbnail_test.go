@@ -0,0 +1,53 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package processor // import "miniflux.app/v2/internal/reader/processor"
+
+import (
+	"testing"
+
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/reader/scraper"
+)
+
+func TestFindContentThumbnail(t *testing.T) {
+	scenarios := []struct {
+		content        string
+		expectedURL    string
+		expectedWidth  int
+		expectedHeight int
+	}{
+		{`<p>No image</p>`, "", 0, 0},
+		{`<img src="https://example.org/image.jpg">`, "https://example.org/image.jpg", 0, 0},
+		{`<img src="data:image/png;base64,AAAA"><img src="https://example.org/image.jpg" width="640" height="480">`, "https://example.org/image.jpg", 640, 480},
+		{`<img src="https://example.org/pixel.gif" width="1" height="1"><img src="https://example.org/image.jpg">`, "https://example.org/image.jpg", 0, 0},
+		{`<img src="https://example.org/emoji.png" width="16"><img src="/relative.jpg">`, "", 0, 0},
+	}
+
+	for _, scenario := range scenarios {
+		imageURL, width, height := findContentThumbnail(scenario.content)
+		if imageURL != scenario.expectedURL || width != scenario.expectedWidth || height != scenario.expectedHeight {
+			t.Errorf(`Unexpected thumbnail for %q, got %q (%dx%d)`, scenario.content, imageURL, width, height)
+		}
+	}
+}
+
+func TestUpdateEntryThumbnail(t *testing.T) {
+	entry := &model.Entry{Content: `<img src="https://example.org/content.jpg">`}
+	updateEntryThumbnail(entry, &scraper.PageImage{URL: "https://example.org/og.jpg", Width: 1200, Height: 630})
+	if entry.ThumbnailURL != "https://example.org/og.jpg" || entry.ThumbnailWidth != 1200 || entry.ThumbnailHeight != 630 {
+		t.Errorf(`The web page image should be used, got %q`, entry.ThumbnailURL)
+	}
+
+	entry = &model.Entry{Content: `<img src="https://example.org/content.jpg">`}
+	updateEntryThumbnail(entry, nil)
+	if entry.ThumbnailURL != "https://example.org/content.jpg" {
+		t.Errorf(`The content image should be used, got %q`, entry.ThumbnailURL)
+	}
+
+	entry = &model.Entry{ThumbnailURL: "https://example.org/feed.jpg", Content: `<img src="https://example.org/content.jpg">`}
+	updateEntryThumbnail(entry, &scraper.PageImage{URL: "https://example.org/og.jpg"})
+	if entry.ThumbnailURL != "https://example.org/feed.jpg" {
+		t.Errorf(`The feed thumbnail should be kept, got %q`, entry.ThumbnailURL)
+	}
+}
@@ -38,6 +38,9 @@ func (r *rdfAdapter) buildFeed(baseURL string) *model.Feed {
 		feed.SiteURL = siteURL
 	}
 
+	// Get the update period declared with the Syndication module if defined.
+	feed.UpdatePeriod = r.rdf.Channel.SyndicationUpdateInterval()
+
 	for _, item := range r.rdf.Items {
 		entry := model.NewEntry()
 		itemLink := strings.TrimSpace(item.Link)
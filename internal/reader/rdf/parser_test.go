@@ -285,6 +285,30 @@ func TestParseRDFFeedWithEmptyLink(t *testing.T) {
 	}
 }
 
+func TestParseRDFFeedWithSyndicationUpdatePeriod(t *testing.T) {
+	data := `<?xml version="1.0" encoding="utf-8"?>
+	<rdf:RDF
+		xmlns:rdf="http://www.w3.org/1999/02/22-rdf-syntax-ns#"
+		xmlns:sy="http://purl.org/rss/1.0/modules/syndication/"
+		xmlns="http://purl.org/rss/1.0/">
+		<channel>
+			<title>Example Feed</title>
+			<link>http://example.org/</link>
+			<sy:updatePeriod>hourly</sy:updatePeriod>
+			<sy:updateFrequency>2</sy:updateFrequency>
+		</channel>
+	</rdf:RDF>`
+
+	feed, err := Parse("http://example.org/feed", bytes.NewReader([]byte(data)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if feed.UpdatePeriod != 30*time.Minute {
+		t.Errorf(`Incorrect update period, got: %v`, feed.UpdatePeriod)
+	}
+}
+
 func TestParseRDFFeedWithRelativeLink(t *testing.T) {
 	data := `<?xml version="1.0" encoding="utf-8"?>
 	<rdf:RDF
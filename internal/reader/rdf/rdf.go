@@ -7,6 +7,7 @@ import (
 	"encoding/xml"
 
 	"miniflux.app/v2/internal/reader/dublincore"
+	"miniflux.app/v2/internal/reader/syndication"
 )
 
 // rdf sepcs: https://web.resource.org/rss/1.0/spec
@@ -21,6 +22,7 @@ type rdfChannel struct {
 	Link        string `xml:"link"`
 	Description string `xml:"description"`
 	dublincore.DublinCoreChannelElement
+	syndication.SyndicationChannelElement
 }
 
 type rdfItem struct {
@@ -4,13 +4,17 @@
 package rewrite // import "miniflux.app/v2/internal/reader/rewrite"
 
 import (
+	"fmt"
 	"log/slog"
+	"regexp"
 	"strconv"
 	"strings"
 	"text/scanner"
 
 	"miniflux.app/v2/internal/model"
 	"miniflux.app/v2/internal/urllib"
+
+	"github.com/andybalholm/cascadia"
 )
 
 type rule struct {
@@ -18,86 +22,118 @@ type rule struct {
 	args []string
 }
 
-func (rule rule) applyRule(entryURL string, entry *model.Entry) {
-	switch rule.name {
-	case "add_image_title":
-		entry.Content = addImageTitle(entry.Content)
-	case "add_mailto_subject":
-		entry.Content = addMailtoSubject(entry.Content)
-	case "add_dynamic_image":
-		entry.Content = addDynamicImage(entry.Content)
-	case "add_dynamic_iframe":
-		entry.Content = addDynamicIframe(entry.Content)
-	case "add_youtube_video":
-		entry.Content = addYoutubeVideoRewriteRule(entryURL, entry.Content)
-	case "add_invidious_video":
-		entry.Content = addInvidiousVideo(entryURL, entry.Content)
-	case "add_youtube_video_using_invidious_player":
-		entry.Content = addYoutubeVideoUsingInvidiousPlayer(entryURL, entry.Content)
-	case "add_youtube_video_from_id":
-		entry.Content = addYoutubeVideoFromId(entry.Content)
-	case "add_pdf_download_link":
-		entry.Content = addPDFLink(entryURL, entry.Content)
-	case "nl2br":
+// contentRewriteRule applies a rewrite rule to the entry, validate checks its arguments and is nil when it takes none.
+type contentRewriteRule struct {
+	apply    func(rule rule, entryURL string, entry *model.Entry)
+	validate func(rule rule) error
+}
+
+func contentRule(rewrite func(content string) string) contentRewriteRule {
+	return contentRewriteRule{apply: func(_ rule, _ string, entry *model.Entry) { entry.Content = rewrite(entry.Content) }}
+}
+
+func contentRuleWithURL(rewrite func(entryURL, content string) string) contentRewriteRule {
+	return contentRewriteRule{apply: func(_ rule, entryURL string, entry *model.Entry) { entry.Content = rewrite(entryURL, entry.Content) }}
+}
+
+// contentRewriteRules is the list of rules accepted in the feed rewrite rules, indexed by name.
+var contentRewriteRules = map[string]contentRewriteRule{
+	"add_image_title":                          contentRule(addImageTitle),
+	"add_mailto_subject":                       contentRule(addMailtoSubject),
+	"add_dynamic_image":                        contentRule(addDynamicImage),
+	"add_dynamic_iframe":                       contentRule(addDynamicIframe),
+	"add_youtube_video":                        contentRuleWithURL(addYoutubeVideoRewriteRule),
+	"add_invidious_video":                      contentRuleWithURL(addInvidiousVideo),
+	"add_youtube_video_using_invidious_player": contentRuleWithURL(addYoutubeVideoUsingInvidiousPlayer),
+	"add_youtube_video_from_id":                contentRule(addYoutubeVideoFromId),
+	"add_pdf_download_link":                    contentRuleWithURL(addPDFLink),
+	"nl2br": {apply: func(_ rule, _ string, entry *model.Entry) {
 		entry.Content = strings.ReplaceAll(entry.Content, "\n", "<br>")
-	case "convert_text_link", "convert_text_links":
-		entry.Content = replaceTextLinks(entry.Content)
-	case "fix_medium_images":
-		entry.Content = fixMediumImages(entry.Content)
-	case "use_noscript_figure_images":
-		entry.Content = useNoScriptImages(entry.Content)
-	case "replace":
+	}},
+	"convert_text_link":          contentRule(replaceTextLinks),
+	"convert_text_links":         contentRule(replaceTextLinks),
+	"fix_medium_images":          contentRule(fixMediumImages),
+	"use_noscript_figure_images": contentRule(useNoScriptImages),
+	"replace": {
 		// Format: replace("search-term"|"replace-term")
-		if len(rule.args) >= 2 {
-			entry.Content = replaceCustom(entry.Content, rule.args[0], rule.args[1])
-		} else {
-			slog.Warn("Cannot find search and replace terms for replace rule",
-				slog.Any("rule", rule),
-				slog.String("entry_url", entryURL),
-			)
-		}
-	case "replace_title":
+		apply: func(rule rule, entryURL string, entry *model.Entry) {
+			if len(rule.args) >= 2 {
+				entry.Content = replaceCustom(entry.Content, rule.args[0], rule.args[1])
+			} else {
+				slog.Warn("Cannot find search and replace terms for replace rule",
+					slog.Any("rule", rule),
+					slog.String("entry_url", entryURL),
+				)
+			}
+		},
+		validate: validateReplaceRule,
+	},
+	"replace_title": {
 		// Format: replace_title("search-term"|"replace-term")
-		if len(rule.args) >= 2 {
-			entry.Title = replaceCustom(entry.Title, rule.args[0], rule.args[1])
-		} else {
-			slog.Warn("Cannot find search and replace terms for replace_title rule",
-				slog.Any("rule", rule),
-				slog.String("entry_url", entryURL),
-			)
-		}
-	case "remove":
+		apply: func(rule rule, entryURL string, entry *model.Entry) {
+			if len(rule.args) >= 2 {
+				entry.Title = replaceCustom(entry.Title, rule.args[0], rule.args[1])
+			} else {
+				slog.Warn("Cannot find search and replace terms for replace_title rule",
+					slog.Any("rule", rule),
+					slog.String("entry_url", entryURL),
+				)
+			}
+		},
+		validate: validateReplaceRule,
+	},
+	"remove": {
 		// Format: remove("#selector > .element, .another")
-		if len(rule.args) >= 1 {
-			entry.Content = removeCustom(entry.Content, rule.args[0])
-		} else {
-			slog.Warn("Cannot find selector for remove rule",
-				slog.Any("rule", rule),
-				slog.String("entry_url", entryURL),
-			)
-		}
-	case "add_enclosure_links":
-		entry.Content = addEnclosureLinks(entry)
-	case "add_castopod_episode":
-		entry.Content = addCastopodEpisode(entryURL, entry.Content)
-	case "base64_decode":
-		selector := "body"
-		if len(rule.args) >= 1 {
-			selector = rule.args[0]
-		}
-		entry.Content = applyFuncOnTextContent(entry.Content, selector, decodeBase64Content)
-	case "add_hn_links_using_hack":
+		apply: func(rule rule, entryURL string, entry *model.Entry) {
+			if len(rule.args) >= 1 {
+				entry.Content = removeCustom(entry.Content, rule.args[0])
+			} else {
+				slog.Warn("Cannot find selector for remove rule",
+					slog.Any("rule", rule),
+					slog.String("entry_url", entryURL),
+				)
+			}
+		},
+		validate: func(rule rule) error {
+			if len(rule.args) < 1 {
+				return fmt.Errorf(`rule %q requires a CSS selector`, rule.name)
+			}
+			return validateSelectorArgument(rule)
+		},
+	},
+	"add_enclosure_links":  {apply: func(_ rule, _ string, entry *model.Entry) { entry.Content = addEnclosureLinks(entry) }},
+	"add_castopod_episode": contentRuleWithURL(addCastopodEpisode),
+	"base64_decode": {
+		apply: func(rule rule, _ string, entry *model.Entry) {
+			selector := "body"
+			if len(rule.args) >= 1 {
+				selector = rule.args[0]
+			}
+			entry.Content = applyFuncOnTextContent(entry.Content, selector, decodeBase64Content)
+		},
+		// The selector is optional, the whole body is decoded by default.
+		validate: func(rule rule) error {
+			if len(rule.args) >= 1 {
+				return validateSelectorArgument(rule)
+			}
+			return nil
+		},
+	},
+	"add_hn_links_using_hack": {apply: func(_ rule, _ string, entry *model.Entry) {
 		entry.Content = addHackerNewsLinksUsing(entry.Content, "hack")
-	case "add_hn_links_using_opener":
+	}},
+	"add_hn_links_using_opener": {apply: func(_ rule, _ string, entry *model.Entry) {
 		entry.Content = addHackerNewsLinksUsing(entry.Content, "opener")
-	case "remove_tables":
-		entry.Content = removeTables(entry.Content)
-	case "remove_clickbait":
-		entry.Title = titlelize(entry.Title)
-	case "fix_ghost_cards":
-		entry.Content = fixGhostCards(entry.Content)
-	case "remove_img_blur_params":
-		entry.Content = removeImgBlurParams(entry.Content)
+	}},
+	"remove_tables":          contentRule(removeTables),
+	"remove_clickbait":       {apply: func(_ rule, _ string, entry *model.Entry) { entry.Title = titlelize(entry.Title) }},
+	"fix_ghost_cards":        contentRule(fixGhostCards),
+	"remove_img_blur_params": contentRule(removeImgBlurParams),
+}
+
+func (rule rule) applyRule(entryURL string, entry *model.Entry) {
+	if contentRewriteRule, ok := contentRewriteRules[rule.name]; ok {
+		contentRewriteRule.apply(rule, entryURL, entry)
 	}
 }
 
@@ -120,6 +156,47 @@ func ApplyContentRewriteRules(entry *model.Entry, customRewriteRules string) {
 	}
 }
 
+// ValidateContentRewriteRules returns an error for each unknown rule or rule with invalid arguments.
+func ValidateContentRewriteRules(rulesText string) []error {
+	var errs []error
+	for _, rule := range parseRules(rulesText) {
+		if err := rule.validate(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errs
+}
+
+func (rule rule) validate() error {
+	contentRewriteRule, ok := contentRewriteRules[rule.name]
+	if !ok {
+		return fmt.Errorf(`unknown rule %q`, rule.name)
+	}
+
+	if contentRewriteRule.validate == nil {
+		return nil
+	}
+
+	return contentRewriteRule.validate(rule)
+}
+
+func validateReplaceRule(rule rule) error {
+	if len(rule.args) < 2 {
+		return fmt.Errorf(`rule %q requires a search term and a replacement`, rule.name)
+	}
+	if _, err := regexp.Compile(rule.args[0]); err != nil {
+		return fmt.Errorf(`rule %q has an invalid regular expression: %v`, rule.name, err)
+	}
+	return nil
+}
+
+func validateSelectorArgument(rule rule) error {
+	if _, err := cascadia.Compile(rule.args[0]); err != nil {
+		return fmt.Errorf(`rule %q has an invalid CSS selector: %v`, rule.name, err)
+	}
+	return nil
+}
+
 func parseRules(rulesText string) (rules []rule) {
 	scan := scanner.Scanner{Mode: scanner.ScanIdents | scanner.ScanStrings}
 	scan.Init(strings.NewReader(rulesText))
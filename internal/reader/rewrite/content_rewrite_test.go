@@ -28,6 +28,37 @@ func TestParseRules(t *testing.T) {
 	}
 }
 
+func TestValidateContentRewriteRules(t *testing.T) {
+	scenarios := map[string]int{
+		``:                          0,
+		`add_dynamic_image,nl2br`:   0,
+		`replace("a(.*)"|"b$1")`:    0,
+		`remove(".ads, #spam")`:     0,
+		`base64_decode`:             0,
+		`unknown_rule`:              1,
+		`replace("a(.*"|"b")`:       1,
+		`replace("only search")`:    1,
+		`remove`:                    1,
+		`remove("div[")`:            1,
+		`base64_decode("div[")`:     1,
+		`unknown,nl2br,remove("[")`: 2,
+	}
+
+	for rulesText, expectedErrors := range scenarios {
+		if errs := ValidateContentRewriteRules(rulesText); len(errs) != expectedErrors {
+			t.Errorf(`Unexpected errors for %q, got %v`, rulesText, errs)
+		}
+	}
+}
+
+func TestPredefinedRulesAreValid(t *testing.T) {
+	for domain, rulesText := range predefinedRules {
+		if errs := ValidateContentRewriteRules(rulesText); len(errs) > 0 {
+			t.Errorf(`Invalid predefined rules for %q: %v`, domain, errs)
+		}
+	}
+}
+
 func TestReplaceTextLinks(t *testing.T) {
 	scenarios := map[string]string{
 		`This is a link to example.org`:                                              `This is a link to example.org`,
@@ -4,6 +4,8 @@
 package rewrite // import "miniflux.app/v2/internal/reader/rewrite"
 
 import (
+	"errors"
+	"fmt"
 	"log/slog"
 	"regexp"
 
@@ -12,6 +14,25 @@ import (
 
 var customReplaceRuleRegex = regexp.MustCompile(`^rewrite\("([^"]+)"\|"([^"]+)"\)$`)
 
+// ValidateURLRewriteRules returns an error when the rule is not in the rewrite("search"|"replace") format
+// or when the search term is not a valid regular expression.
+func ValidateURLRewriteRules(rules string) error {
+	if rules == "" {
+		return nil
+	}
+
+	parts := customReplaceRuleRegex.FindStringSubmatch(rules)
+	if len(parts) != 3 {
+		return errors.New(`the rule must be in the format rewrite("search"|"replace")`)
+	}
+
+	if _, err := regexp.Compile(parts[1]); err != nil {
+		return fmt.Errorf(`invalid regular expression: %v`, err)
+	}
+
+	return nil
+}
+
 func RewriteEntryURL(feed *model.Feed, entry *model.Entry) string {
 	if feed.UrlRewriteRules == "" {
 		return entry.URL
@@ -230,6 +230,22 @@ func TestRewriteEntryURLWithNilValues(t *testing.T) {
 	})
 }
 
+func TestValidateURLRewriteRules(t *testing.T) {
+	scenarios := map[string]bool{
+		``: true,
+		`rewrite("^https://example.com/(.+)"|"https://rewritten.com/$1")`: true,
+		`rewrite("invalid")`:                        false,
+		`rewrite("^https://example.com/(.+"|"$1")`:  false,
+		`replace("^https://example.com/(.+)"|"$1")`: false,
+	}
+
+	for rules, valid := range scenarios {
+		if err := ValidateURLRewriteRules(rules); (err == nil) != valid {
+			t.Errorf(`Unexpected result for %q, got %v`, rules, err)
+		}
+	}
+}
+
 func TestCustomReplaceRuleRegex(t *testing.T) {
 	scenarios := []struct {
 		name     string
@@ -55,6 +55,20 @@ func (r *rssAdapter) buildFeed(baseURL string) *model.Feed {
 
 		if absoluteFeedURL, err := urllib.ResolveToAbsoluteURL(feed.FeedURL, href); err == nil {
 			feed.FeedURL = absoluteFeedURL
+			feed.SelfURL = absoluteFeedURL
+			break
+		}
+	}
+
+	// Try to find the WebSub hub from the Channel links.
+	for _, link := range r.rss.Channel.Links {
+		href := strings.TrimSpace(link.Href)
+		if href == "" || link.Rel != "hub" {
+			continue
+		}
+
+		if absoluteHubURL, err := urllib.ResolveToAbsoluteURL(feed.FeedURL, href); err == nil {
+			feed.HubURL = absoluteHubURL
 			break
 		}
 	}
@@ -71,6 +85,11 @@ func (r *rssAdapter) buildFeed(baseURL string) *model.Feed {
 		}
 	}
 
+	// Get the scheduling hints if defined.
+	feed.SkipHours = findFeedSkipHours(&r.rss.Channel)
+	feed.SkipDays = findFeedSkipDays(&r.rss.Channel)
+	feed.UpdatePeriod = r.rss.Channel.SyndicationUpdateInterval()
+
 	// Get the feed icon URL if defined.
 	if r.rss.Channel.Image != nil {
 		if absoluteIconURL, err := urllib.ResolveToAbsoluteURL(feed.SiteURL, r.rss.Channel.Image.URL); err == nil {
@@ -165,6 +184,18 @@ func (r *rssAdapter) buildFeed(baseURL string) *model.Feed {
 			}
 		}
 
+		// Populate the entry thumbnail.
+		if imageURL, width, height := item.FirstMediaImage(); imageURL != "" {
+			entry.SetThumbnail(findAbsoluteHTTPURL(feed.SiteURL, imageURL), width, height)
+		}
+
+		if entry.ThumbnailURL == "" {
+			entry.SetThumbnail(findAbsoluteHTTPURL(feed.SiteURL, item.ItunesImage.Href), 0, 0)
+		}
+
+		// Populate the Podcasting 2.0 metadata.
+		entry.Podcast = findEntryPodcastMetadata(&item, &r.rss.Channel, feed.SiteURL)
+
 		// Populate entry categories.
 		entry.Tags = findEntryTags(&item)
 		if len(entry.Tags) == 0 {
@@ -197,6 +228,42 @@ func findFeedAuthor(rssChannel *rssChannel) string {
 	return sanitizer.StripTags(author)
 }
 
+// findFeedSkipHours returns the sorted and deduplicated hours of the day (0-23 in GMT) listed in <skipHours>.
+// Some feeds use 24 for midnight.
+func findFeedSkipHours(rssChannel *rssChannel) []int64 {
+	var hours []int64
+	for _, value := range rssChannel.SkipHours {
+		hour, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+		if err != nil || hour < 0 || hour > 24 {
+			continue
+		}
+
+		hour %= 24
+		if !slices.Contains(hours, hour) {
+			hours = append(hours, hour)
+		}
+	}
+
+	slices.Sort(hours)
+	return hours
+}
+
+// findFeedSkipDays returns the sorted and deduplicated days listed in <skipDays>, using the time.Weekday numbering.
+func findFeedSkipDays(rssChannel *rssChannel) []int64 {
+	var days []int64
+	for _, value := range rssChannel.SkipDays {
+		value = strings.TrimSpace(value)
+		for weekday := time.Sunday; weekday <= time.Saturday; weekday++ {
+			if strings.EqualFold(value, weekday.String()) && !slices.Contains(days, int64(weekday)) {
+				days = append(days, int64(weekday))
+			}
+		}
+	}
+
+	slices.Sort(days)
+	return days
+}
+
 func findFeedTags(rssChannel *rssChannel) []string {
 	tags := make([]string, 0, len(rssChannel.Categories)+2*len(rssChannel.ItunesCategories)+1)
 
@@ -315,7 +382,7 @@ func findEntryEnclosures(rssItem *rssItem, siteURL string) model.EnclosureList {
 	mediaThumbnails := rssItem.AllMediaThumbnails()
 	mediaContents := rssItem.AllMediaContents()
 	mediaPeerLinks := rssItem.AllMediaPeerLinks()
-	capacity := len(mediaThumbnails) + len(rssItem.Enclosures) + len(mediaContents) + len(mediaPeerLinks)
+	capacity := len(mediaThumbnails) + len(rssItem.Enclosures) + len(mediaContents) + len(mediaPeerLinks) + len(rssItem.PodcastAlternateEnclosures)
 	enclosures := make(model.EnclosureList, 0, capacity)
 	duplicates := make(map[string]bool, capacity)
 
@@ -438,9 +505,130 @@ func findEntryEnclosures(rssItem *rssItem, siteURL string) model.EnclosureList {
 		})
 	}
 
+	for _, alternateEnclosure := range rssItem.PodcastAlternateEnclosures {
+		size, _ := strconv.ParseInt(strings.TrimSpace(alternateEnclosure.Length), 10, 64)
+
+		for _, source := range alternateEnclosure.Sources {
+			// Sources can use other protocols like IPFS or BitTorrent, only HTTP URLs can be played in the browser.
+			sourceURL := findAbsoluteHTTPURL(siteURL, source.URI)
+			if sourceURL == "" {
+				continue
+			}
+
+			if _, found := duplicates[sourceURL]; found {
+				continue
+			}
+
+			duplicates[sourceURL] = true
+
+			mimeType := strings.TrimSpace(source.ContentType)
+			if mimeType == "" {
+				mimeType = strings.TrimSpace(alternateEnclosure.Type)
+			}
+
+			enclosures = append(enclosures, &model.Enclosure{
+				URL:       sourceURL,
+				MimeType:  mimeType,
+				Size:      size,
+				Title:     strings.TrimSpace(alternateEnclosure.Title),
+				Alternate: true,
+			})
+		}
+	}
+
 	return enclosures
 }
 
+func findEntryPodcastMetadata(rssItem *rssItem, rssChannel *rssChannel, siteURL string) *model.PodcastMetadata {
+	metadata := &model.PodcastMetadata{}
+
+	if number, err := strconv.Atoi(strings.TrimSpace(rssItem.PodcastSeason.Number)); err == nil {
+		metadata.Season = &model.PodcastSeason{Number: number, Name: strings.TrimSpace(rssItem.PodcastSeason.Name)}
+	}
+
+	if number, err := strconv.ParseFloat(strings.TrimSpace(rssItem.PodcastEpisode.Number), 64); err == nil {
+		metadata.Episode = &model.PodcastEpisode{Number: number, Display: strings.TrimSpace(rssItem.PodcastEpisode.Display)}
+	}
+
+	for _, transcript := range rssItem.PodcastTranscripts {
+		if transcriptURL := findAbsoluteHTTPURL(siteURL, transcript.URL); transcriptURL != "" {
+			metadata.Transcripts = append(metadata.Transcripts, &model.PodcastTranscript{
+				URL:      transcriptURL,
+				MimeType: strings.ToLower(strings.TrimSpace(transcript.Type)),
+				Language: strings.TrimSpace(transcript.Language),
+				Rel:      strings.TrimSpace(transcript.Rel),
+			})
+		}
+	}
+
+	metadata.ChaptersURL = findAbsoluteHTTPURL(siteURL, rssItem.PodcastChapters.URL)
+
+	persons := rssItem.PodcastPersons
+	if len(persons) == 0 {
+		persons = rssChannel.PodcastPersons
+	}
+
+	for _, person := range persons {
+		if name := strings.TrimSpace(person.Name); name != "" {
+			metadata.Persons = append(metadata.Persons, &model.PodcastPerson{
+				Name:     name,
+				Role:     strings.ToLower(strings.TrimSpace(person.Role)),
+				Group:    strings.ToLower(strings.TrimSpace(person.Group)),
+				ImageURL: findAbsoluteHTTPURL(siteURL, person.Image),
+				URL:      findAbsoluteHTTPURL(siteURL, person.Href),
+			})
+		}
+	}
+
+	funding := rssItem.PodcastFunding
+	if len(funding) == 0 {
+		funding = rssChannel.PodcastFunding
+	}
+
+	for _, element := range funding {
+		if fundingURL := findAbsoluteHTTPURL(siteURL, element.URL); fundingURL != "" {
+			metadata.Funding = append(metadata.Funding, &model.PodcastFunding{
+				URL:   fundingURL,
+				Title: strings.TrimSpace(element.Title),
+			})
+		}
+	}
+
+	for _, soundbite := range rssItem.PodcastSoundbites {
+		startTime, startTimeErr := strconv.ParseFloat(strings.TrimSpace(soundbite.StartTime), 64)
+		duration, durationErr := strconv.ParseFloat(strings.TrimSpace(soundbite.Duration), 64)
+		if startTimeErr != nil || durationErr != nil || startTime < 0 || duration <= 0 {
+			continue
+		}
+
+		metadata.Soundbites = append(metadata.Soundbites, &model.PodcastSoundbite{
+			StartTime: startTime,
+			Duration:  duration,
+			Title:     strings.TrimSpace(soundbite.Title),
+		})
+	}
+
+	if metadata.IsEmpty() {
+		return nil
+	}
+
+	return metadata
+}
+
+func findAbsoluteHTTPURL(siteURL, link string) string {
+	link = strings.TrimSpace(link)
+	if link == "" {
+		return ""
+	}
+
+	absoluteURL, err := urllib.ResolveToAbsoluteURL(siteURL, link)
+	if err != nil || !urllib.IsAbsoluteURL(absoluteURL) {
+		return ""
+	}
+
+	return absoluteURL
+}
+
 // appendSorted is identical to [appendSortedSeq] except receives variadic values rather than [iter.Seq].
 func appendSorted[I any, O cmp.Ordered](sorted []O, fn func(I) O, values ...I) []O {
 	sorted = slices.Grow(sorted, len(values))
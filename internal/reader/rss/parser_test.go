@@ -5,6 +5,7 @@ package rss // import "miniflux.app/v2/internal/reader/rss"
 
 import (
 	"bytes"
+	"slices"
 	"testing"
 	"time"
 )
@@ -684,6 +685,112 @@ func TestParseFeedURLWithAtomLink(t *testing.T) {
 	}
 }
 
+func TestParseFeedWithWebSubHubLink(t *testing.T) {
+	data := `<?xml version="1.0" encoding="utf-8"?>
+		<rss xmlns:atom="http://www.w3.org/2005/Atom" version="2.0">
+		<channel>
+			<title>Example</title>
+			<link>https://example.org/</link>
+			<atom:link href="https://example.org/rss" type="application/rss+xml" rel="self"></atom:link>
+			<atom:link href="/hub" rel="hub"></atom:link>
+		</channel>
+		</rss>`
+
+	feed, err := Parse("https://example.org/", bytes.NewReader([]byte(data)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if feed.SelfURL != "https://example.org/rss" {
+		t.Errorf("Incorrect self URL, got: %s", feed.SelfURL)
+	}
+
+	if feed.HubURL != "https://example.org/hub" {
+		t.Errorf("Incorrect hub URL, got: %s", feed.HubURL)
+	}
+}
+
+func TestParseFeedWithoutWebSubHubLink(t *testing.T) {
+	data := `<?xml version="1.0" encoding="utf-8"?>
+		<rss version="2.0">
+		<channel>
+			<title>Example</title>
+			<link>https://example.org/</link>
+		</channel>
+		</rss>`
+
+	feed, err := Parse("https://example.org/rss", bytes.NewReader([]byte(data)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if feed.SelfURL != "" {
+		t.Errorf("Expected an empty self URL, got: %s", feed.SelfURL)
+	}
+
+	if feed.HubURL != "" {
+		t.Errorf("Expected an empty hub URL, got: %s", feed.HubURL)
+	}
+}
+
+func TestParseFeedWithSkipHoursAndSkipDays(t *testing.T) {
+	data := `<?xml version="1.0" encoding="utf-8"?>
+		<rss version="2.0">
+		<channel>
+			<title>Example</title>
+			<link>https://example.org/</link>
+			<skipHours>
+				<hour>23</hour>
+				<hour>0</hour>
+				<hour>24</hour>
+				<hour> 5 </hour>
+				<hour>25</hour>
+				<hour>invalid</hour>
+			</skipHours>
+			<skipDays>
+				<day>Sunday</day>
+				<day>saturday</day>
+				<day>Sunday</day>
+				<day>Someday</day>
+			</skipDays>
+		</channel>
+		</rss>`
+
+	feed, err := Parse("https://example.org/", bytes.NewReader([]byte(data)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !slices.Equal(feed.SkipHours, []int64{0, 5, 23}) {
+		t.Errorf("Incorrect skip hours, got: %v", feed.SkipHours)
+	}
+
+	if !slices.Equal(feed.SkipDays, []int64{int64(time.Sunday), int64(time.Saturday)}) {
+		t.Errorf("Incorrect skip days, got: %v", feed.SkipDays)
+	}
+}
+
+func TestParseFeedWithSyndicationUpdatePeriod(t *testing.T) {
+	data := `<?xml version="1.0" encoding="utf-8"?>
+		<rss version="2.0" xmlns:sy="http://purl.org/rss/1.0/modules/syndication/">
+		<channel>
+			<title>Example</title>
+			<link>https://example.org/</link>
+			<sy:updatePeriod>daily</sy:updatePeriod>
+			<sy:updateFrequency>4</sy:updateFrequency>
+		</channel>
+		</rss>`
+
+	feed, err := Parse("https://example.org/", bytes.NewReader([]byte(data)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if feed.UpdatePeriod != 6*time.Hour {
+		t.Errorf("Incorrect update period, got: %v", feed.UpdatePeriod)
+	}
+}
+
 func TestParseFeedWithWebmaster(t *testing.T) {
 	data := `<?xml version="1.0" encoding="utf-8"?>
 		<rss version="2.0">
@@ -2434,3 +2541,165 @@ func TestParseItemWithoutDublinCoreLanguageInheritsChannelLanguage(t *testing.T)
 		t.Errorf("Expected entry to inherit channel language, got: %q", feed.Entries[0].Language)
 	}
 }
+
+func TestParseEntryWithPodcastNamespace(t *testing.T) {
+	data := `<?xml version="1.0" encoding="UTF-8"?>
+		<rss version="2.0" xmlns:podcast="https://podcastindex.org/namespace/1.0">
+		<channel>
+			<title>Podcast Example</title>
+			<link>https://example.org/</link>
+			<podcast:funding url="https://example.org/donate">Support the show</podcast:funding>
+			<item>
+				<title>Episode 3</title>
+				<guid>episode-3</guid>
+				<enclosure url="https://example.org/episode3.mp3" length="1000" type="audio/mpeg"/>
+				<podcast:season name="Road trip">2</podcast:season>
+				<podcast:episode display="Ch. 3">3</podcast:episode>
+				<podcast:transcript url="/episode3.vtt" type="text/vtt" language="en"/>
+				<podcast:transcript url="https://example.org/episode3.json" type="application/json"/>
+				<podcast:chapters url="https://example.org/episode3-chapters.json" type="application/json+chapters"/>
+				<podcast:person role="Host" img="https://example.org/alice.jpg" href="https://example.org/alice">Alice</podcast:person>
+				<podcast:person group="Writing" role="guest">Bob</podcast:person>
+				<podcast:soundbite startTime="73.0" duration="60.0">Best part</podcast:soundbite>
+				<podcast:soundbite startTime="invalid" duration="60.0"/>
+				<podcast:alternateEnclosure type="audio/opus" length="500" title="Opus">
+					<podcast:source uri="https://example.org/episode3.opus"/>
+					<podcast:source uri="ipfs://QmdwGqd3d2gFPGeJNLLCshdiPert45fMu84552Y4XHTy4y"/>
+				</podcast:alternateEnclosure>
+				<podcast:alternateEnclosure type="audio/mpeg" length="1000">
+					<podcast:source uri="https://example.org/episode3.mp3"/>
+				</podcast:alternateEnclosure>
+			</item>
+		</channel>
+		</rss>`
+
+	feed, err := Parse("https://example.org/", bytes.NewReader([]byte(data)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	podcast := feed.Entries[0].Podcast
+	if podcast == nil {
+		t.Fatal(`The podcast metadata should be parsed`)
+	}
+
+	if podcast.Season == nil || podcast.Season.Number != 2 || podcast.Season.Name != "Road trip" {
+		t.Errorf(`Unexpected season: %+v`, podcast.Season)
+	}
+
+	if podcast.Episode == nil || podcast.Episode.Number != 3 || podcast.Episode.Display != "Ch. 3" {
+		t.Errorf(`Unexpected episode: %+v`, podcast.Episode)
+	}
+
+	if len(podcast.Transcripts) != 2 {
+		t.Fatalf(`Unexpected number of transcripts, got %d`, len(podcast.Transcripts))
+	}
+
+	if transcript := podcast.FindTextTranscript(); transcript == nil || transcript.URL != "https://example.org/episode3.vtt" || transcript.Language != "en" {
+		t.Errorf(`Unexpected text transcript: %+v`, transcript)
+	}
+
+	if podcast.ChaptersURL != "https://example.org/episode3-chapters.json" {
+		t.Errorf(`Unexpected chapters URL: %q`, podcast.ChaptersURL)
+	}
+
+	if len(podcast.Persons) != 2 {
+		t.Fatalf(`Unexpected number of persons, got %d`, len(podcast.Persons))
+	}
+
+	if person := podcast.Persons[0]; person.Name != "Alice" || person.Role != "host" || person.URL != "https://example.org/alice" || person.ImageURL != "https://example.org/alice.jpg" {
+		t.Errorf(`Unexpected person: %+v`, person)
+	}
+
+	if person := podcast.Persons[1]; person.Name != "Bob" || person.Role != "guest" || person.Group != "writing" {
+		t.Errorf(`Unexpected person: %+v`, person)
+	}
+
+	if len(podcast.Funding) != 1 || podcast.Funding[0].URL != "https://example.org/donate" || podcast.Funding[0].Title != "Support the show" {
+		t.Errorf(`The channel funding should be used, got %+v`, podcast.Funding)
+	}
+
+	if len(podcast.Soundbites) != 1 || podcast.Soundbites[0].StartTime != 73 || podcast.Soundbites[0].Duration != 60 || podcast.Soundbites[0].Title != "Best part" {
+		t.Errorf(`Unexpected soundbites: %+v`, podcast.Soundbites)
+	}
+
+	enclosures := feed.Entries[0].Enclosures
+	if len(enclosures) != 2 {
+		t.Fatalf(`Unexpected number of enclosures, got %d`, len(enclosures))
+	}
+
+	if enclosures[0].URL != "https://example.org/episode3.mp3" || enclosures[0].Alternate {
+		t.Errorf(`Unexpected main enclosure: %+v`, enclosures[0])
+	}
+
+	if enclosures[1].URL != "https://example.org/episode3.opus" || enclosures[1].MimeType != "audio/opus" || enclosures[1].Size != 500 || enclosures[1].Title != "Opus" || !enclosures[1].Alternate {
+		t.Errorf(`Unexpected alternate enclosure: %+v`, enclosures[1])
+	}
+}
+
+func TestParseEntryWithoutPodcastNamespace(t *testing.T) {
+	data := `<?xml version="1.0" encoding="UTF-8"?>
+		<rss version="2.0">
+		<channel>
+			<title>Example</title>
+			<link>https://example.org/</link>
+			<item>
+				<title>Item</title>
+				<link>https://example.org/item</link>
+			</item>
+		</channel>
+		</rss>`
+
+	feed, err := Parse("https://example.org/", bytes.NewReader([]byte(data)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if feed.Entries[0].Podcast != nil {
+		t.Errorf(`The podcast metadata should be nil, got %+v`, feed.Entries[0].Podcast)
+	}
+}
+
+func TestParseEntryThumbnail(t *testing.T) {
+	data := `<?xml version="1.0" encoding="UTF-8"?>
+		<rss version="2.0" xmlns:media="http://search.yahoo.com/mrss/" xmlns:itunes="http://www.itunes.com/dtds/podcast-1.0.dtd">
+		<channel>
+			<title>Example</title>
+			<link>https://example.org/</link>
+			<item>
+				<title>Media content</title>
+				<link>https://example.org/1</link>
+				<media:content url="https://example.org/1.mp4" type="video/mp4"/>
+				<media:content url="/1.jpg" medium="image" width="800" height="600"/>
+				<itunes:image href="https://example.org/cover.jpg"/>
+			</item>
+			<item>
+				<title>iTunes image</title>
+				<link>https://example.org/2</link>
+				<itunes:image href="https://example.org/cover.jpg"/>
+			</item>
+			<item>
+				<title>Invalid image</title>
+				<link>https://example.org/3</link>
+				<media:thumbnail url="ftp://example.org/3.jpg"/>
+			</item>
+		</channel>
+		</rss>`
+
+	feed, err := Parse("https://example.org/", bytes.NewReader([]byte(data)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if entry := feed.Entries[0]; entry.ThumbnailURL != "https://example.org/1.jpg" || entry.ThumbnailWidth != 800 || entry.ThumbnailHeight != 600 {
+		t.Errorf(`Unexpected thumbnail: %q %dx%d`, entry.ThumbnailURL, entry.ThumbnailWidth, entry.ThumbnailHeight)
+	}
+
+	if entry := feed.Entries[1]; entry.ThumbnailURL != "https://example.org/cover.jpg" {
+		t.Errorf(`Unexpected thumbnail: %q`, entry.ThumbnailURL)
+	}
+
+	if entry := feed.Entries[2]; entry.ThumbnailURL != "" {
+		t.Errorf(`Unexpected thumbnail: %q`, entry.ThumbnailURL)
+	}
+}
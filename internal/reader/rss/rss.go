@@ -12,6 +12,8 @@ import (
 	"miniflux.app/v2/internal/reader/googleplay"
 	"miniflux.app/v2/internal/reader/itunes"
 	"miniflux.app/v2/internal/reader/media"
+	"miniflux.app/v2/internal/reader/podcast"
+	"miniflux.app/v2/internal/reader/syndication"
 )
 
 // Specs: https://www.rssboard.org/rss-specification
@@ -92,6 +94,8 @@ type rssChannel struct {
 	atomLinks
 	itunes.ItunesChannelElement
 	googleplay.GooglePlayChannelElement
+	podcast.PodcastChannelElement
+	syndication.SyndicationChannelElement
 }
 
 type rssCloud struct {
@@ -171,6 +175,7 @@ type rssItem struct {
 	atomLinks
 	itunes.ItunesItemElement
 	googleplay.GooglePlayItemElement
+	podcast.PodcastItemElement
 }
 
 type rssAuthor struct {
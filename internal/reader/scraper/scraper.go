@@ -4,9 +4,11 @@
 package scraper // import "miniflux.app/v2/internal/reader/scraper"
 
 import (
+	"bytes"
 	"fmt"
 	"io"
 	"log/slog"
+	"strconv"
 	"strings"
 
 	"miniflux.app/v2/internal/config"
@@ -16,19 +18,29 @@ import (
 	"miniflux.app/v2/internal/urllib"
 
 	"github.com/PuerkitoBio/goquery"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
 )
 
-func ScrapeWebsite(requestBuilder *fetcher.RequestBuilder, pageURL, rules string) (baseURL string, extractedContent string, err error) {
+// PageImage represents the lead image advertised by a web page with the Open Graph protocol.
+type PageImage struct {
+	URL    string
+	Width  int
+	Height int
+}
+
+// ScrapeWebsite downloads the web page and extracts its content, the page image is nil when the page does not define any.
+func ScrapeWebsite(requestBuilder *fetcher.RequestBuilder, pageURL, rules string) (baseURL string, extractedContent string, pageImage *PageImage, err error) {
 	responseHandler := fetcher.NewResponseHandler(requestBuilder.ExecuteRequest(pageURL))
 	defer responseHandler.Close()
 
 	if localizedError := responseHandler.LocalizedError(); localizedError != nil {
 		slog.Warn("Unable to scrape website", slog.String("website_url", pageURL), slog.Any("error", localizedError.Error()))
-		return "", "", localizedError.Error()
+		return "", "", nil, localizedError.Error()
 	}
 
 	if !isAllowedContentType(responseHandler.ContentType()) {
-		return "", "", fmt.Errorf("scraper: this resource is not a HTML document (%s)", responseHandler.ContentType())
+		return "", "", nil, fmt.Errorf("scraper: this resource is not a HTML document (%s)", responseHandler.ContentType())
 	}
 
 	// The entry URL could redirect somewhere else.
@@ -45,20 +57,28 @@ func ScrapeWebsite(requestBuilder *fetcher.RequestBuilder, pageURL, rules string
 	)
 
 	if err != nil {
-		return "", "", fmt.Errorf("scraper: unable to read HTML document with charset reader: %v", err)
+		return "", "", nil, fmt.Errorf("scraper: unable to read HTML document with charset reader: %v", err)
+	}
+
+	// The document is parsed twice: once for the page image and once for the content.
+	htmlDocument, err := io.ReadAll(htmlDocumentReader)
+	if err != nil {
+		return "", "", nil, fmt.Errorf("scraper: unable to read HTML document: %v", err)
 	}
 
+	pageImage = findOpenGraphImage(bytes.NewReader(htmlDocument), pageURL)
+
 	if sameSite && rules != "" {
 		slog.Debug("Extracting content with custom rules",
 			"url", pageURL,
 			"rules", rules,
 		)
-		baseURL, extractedContent, err = findContentUsingCustomRules(htmlDocumentReader, rules)
+		baseURL, extractedContent, err = findContentUsingCustomRules(bytes.NewReader(htmlDocument), rules)
 	} else {
 		slog.Debug("Extracting content with readability",
 			"url", pageURL,
 		)
-		baseURL, extractedContent, err = readability.ExtractContent(htmlDocumentReader)
+		baseURL, extractedContent, err = readability.ExtractContent(bytes.NewReader(htmlDocument))
 	}
 
 	if baseURL == "" {
@@ -67,7 +87,58 @@ func ScrapeWebsite(requestBuilder *fetcher.RequestBuilder, pageURL, rules string
 		slog.Debug("Using base URL from HTML document", "base_url", baseURL)
 	}
 
-	return baseURL, extractedContent, nil
+	return baseURL, extractedContent, pageImage, nil
+}
+
+// findOpenGraphImage returns the "og:image" of the page, only the document head is read.
+func findOpenGraphImage(page io.Reader, pageURL string) *PageImage {
+	var pageImage *PageImage
+	tokenizer := html.NewTokenizer(page)
+
+	for {
+		switch tokenizer.Next() {
+		case html.ErrorToken:
+			return pageImage
+		case html.StartTagToken, html.SelfClosingTagToken:
+			token := tokenizer.Token()
+			if token.DataAtom == atom.Body {
+				return pageImage
+			}
+
+			if token.DataAtom != atom.Meta {
+				continue
+			}
+
+			var property, content string
+			for _, attribute := range token.Attr {
+				switch attribute.Key {
+				case "property":
+					property = strings.ToLower(strings.TrimSpace(attribute.Val))
+				case "content":
+					content = strings.TrimSpace(attribute.Val)
+				}
+			}
+
+			switch property {
+			case "og:image", "og:image:url", "og:image:secure_url":
+				if pageImage != nil || content == "" {
+					continue
+				}
+
+				if imageURL, err := urllib.ResolveToAbsoluteURL(pageURL, content); err == nil && urllib.IsAbsoluteURL(imageURL) {
+					pageImage = &PageImage{URL: imageURL}
+				}
+			case "og:image:width":
+				if pageImage != nil && pageImage.Width == 0 {
+					pageImage.Width, _ = strconv.Atoi(content)
+				}
+			case "og:image:height":
+				if pageImage != nil && pageImage.Height == 0 {
+					pageImage.Height, _ = strconv.Atoi(content)
+				}
+			}
+		}
+	}
 }
 
 func findContentUsingCustomRules(page io.Reader, rules string) (baseURL string, extractedContent string, err error) {
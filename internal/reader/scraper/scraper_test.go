@@ -137,3 +137,30 @@ func TestParseMissingBaseURLWithCustomRules(t *testing.T) {
 		t.Errorf(`Unexpected base URL, got %q instead of ""`, baseURL)
 	}
 }
+
+func TestFindOpenGraphImage(t *testing.T) {
+	html := `<html><head>
+		<meta property="og:title" content="Title">
+		<meta property="og:image" content="/images/lead.jpg">
+		<meta property="og:image:width" content="1200">
+		<meta property="og:image:height" content="630">
+		<meta property="og:image" content="https://example.org/images/other.jpg">
+	</head><body><meta property="og:image" content="https://example.org/body.jpg"></body></html>`
+
+	pageImage := findOpenGraphImage(strings.NewReader(html), "https://example.org/articles/1")
+	if pageImage == nil {
+		t.Fatal(`The page image should be found`)
+	}
+
+	if pageImage.URL != "https://example.org/images/lead.jpg" || pageImage.Width != 1200 || pageImage.Height != 630 {
+		t.Errorf(`Unexpected page image: %+v`, pageImage)
+	}
+}
+
+func TestFindOpenGraphImageOutsideHead(t *testing.T) {
+	html := `<html><head><title>Test</title></head><body><meta property="og:image" content="https://example.org/body.jpg"></body></html>`
+
+	if pageImage := findOpenGraphImage(strings.NewReader(html), "https://example.org/"); pageImage != nil {
+		t.Errorf(`No page image should be found, got %+v`, pageImage)
+	}
+}
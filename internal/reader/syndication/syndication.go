@@ -0,0 +1,58 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package syndication // import "miniflux.app/v2/internal/reader/syndication"
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Specs: https://web.resource.org/rss/1.0/modules/syndication/
+type SyndicationChannelElement struct {
+	// SyndicationUpdatePeriod describes the period over which the channel format is updated.
+	// Acceptable values are: hourly, daily, weekly, monthly, yearly. If omitted, daily is assumed.
+	SyndicationUpdatePeriod string `xml:"http://purl.org/rss/1.0/modules/syndication/ updatePeriod"`
+
+	// SyndicationUpdateFrequency is used to describe the frequency of updates in relation to the update period.
+	// A positive integer indicates how many times in that period the channel is updated. If omitted, 1 is assumed.
+	SyndicationUpdateFrequency string `xml:"http://purl.org/rss/1.0/modules/syndication/ updateFrequency"`
+}
+
+// SyndicationUpdateInterval returns the expected delay between two updates of the channel,
+// or zero when the channel does not use the Syndication module.
+func (s *SyndicationChannelElement) SyndicationUpdateInterval() time.Duration {
+	updatePeriod := strings.ToLower(strings.TrimSpace(s.SyndicationUpdatePeriod))
+	updateFrequency := strings.TrimSpace(s.SyndicationUpdateFrequency)
+	if updatePeriod == "" && updateFrequency == "" {
+		return 0
+	}
+
+	var period time.Duration
+	switch updatePeriod {
+	case "hourly":
+		period = time.Hour
+	case "", "daily":
+		period = 24 * time.Hour
+	case "weekly":
+		period = 7 * 24 * time.Hour
+	case "monthly":
+		period = 30 * 24 * time.Hour
+	case "yearly":
+		period = 365 * 24 * time.Hour
+	default:
+		return 0
+	}
+
+	frequency := 1
+	if updateFrequency != "" {
+		value, err := strconv.Atoi(updateFrequency)
+		if err != nil || value < 1 {
+			return 0
+		}
+		frequency = value
+	}
+
+	return period / time.Duration(frequency)
+}
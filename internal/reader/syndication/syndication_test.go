@@ -0,0 +1,37 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package syndication // import "miniflux.app/v2/internal/reader/syndication"
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSyndicationUpdateInterval(t *testing.T) {
+	scenarios := []struct {
+		period    string
+		frequency string
+		expected  time.Duration
+	}{
+		{"", "", 0},
+		{"hourly", "", time.Hour},
+		{"hourly", "2", 30 * time.Minute},
+		{" Daily ", "", 24 * time.Hour},
+		{"", "4", 6 * time.Hour},
+		{"weekly", "1", 7 * 24 * time.Hour},
+		{"monthly", "", 30 * 24 * time.Hour},
+		{"yearly", "", 365 * 24 * time.Hour},
+		{"fortnightly", "", 0},
+		{"daily", "zero", 0},
+		{"daily", "0", 0},
+		{"daily", "-1", 0},
+	}
+
+	for _, scenario := range scenarios {
+		element := &SyndicationChannelElement{SyndicationUpdatePeriod: scenario.period, SyndicationUpdateFrequency: scenario.frequency}
+		if result := element.SyndicationUpdateInterval(); result != scenario.expected {
+			t.Errorf(`Unexpected interval for period=%q frequency=%q: got %v instead of %v`, scenario.period, scenario.frequency, result, scenario.expected)
+		}
+	}
+}
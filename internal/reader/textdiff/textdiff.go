@@ -0,0 +1,154 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package textdiff compares two versions of a text word by word.
+package textdiff // import "miniflux.app/v2/internal/reader/textdiff"
+
+import "strings"
+
+// maxTableSize limits the memory used by the longest common subsequence table.
+// The words that differ beyond this size are reported as deleted and inserted.
+const maxTableSize = 4 * 1024 * 1024
+
+// Operation is the kind of change of a chunk.
+type Operation int
+
+const (
+	Equal Operation = iota
+	Delete
+	Insert
+)
+
+// Chunk is a sequence of words with the same operation.
+type Chunk struct {
+	Operation Operation
+	Text      string
+}
+
+// Diff returns the chunks transforming the words of before into the words of after.
+// The whitespace between words is normalized to a single space.
+func Diff(before, after string) []Chunk {
+	var chunks []Chunk
+	for op, word := range diffWords(strings.Fields(before), strings.Fields(after)) {
+		if last := len(chunks) - 1; last >= 0 && chunks[last].Operation == op {
+			chunks[last].Text += " " + word
+			continue
+		}
+		chunks = append(chunks, Chunk{Operation: op, Text: word})
+	}
+	return chunks
+}
+
+// ChangeRatio returns the percentage of words deleted or inserted between both versions.
+func ChangeRatio(before, after string) int {
+	beforeWords, afterWords := strings.Fields(before), strings.Fields(after)
+	total := len(beforeWords) + len(afterWords)
+	if total == 0 {
+		return 0
+	}
+
+	changed := 0
+	for op := range diffWords(beforeWords, afterWords) {
+		if op != Equal {
+			changed++
+		}
+	}
+	return changed * 100 / total
+}
+
+// diffWords yields the operation of each word, using the longest common subsequence of both lists.
+func diffWords(a, b []string) func(yield func(Operation, string) bool) {
+	return func(yield func(Operation, string) bool) {
+		prefix := 0
+		for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+			prefix++
+		}
+
+		suffix := 0
+		for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+			suffix++
+		}
+
+		for _, word := range a[:prefix] {
+			if !yield(Equal, word) {
+				return
+			}
+		}
+
+		if !yieldMiddle(a[prefix:len(a)-suffix], b[prefix:len(b)-suffix], yield) {
+			return
+		}
+
+		for _, word := range a[len(a)-suffix:] {
+			if !yield(Equal, word) {
+				return
+			}
+		}
+	}
+}
+
+func yieldMiddle(a, b []string, yield func(Operation, string) bool) bool {
+	n, m := len(a), len(b)
+
+	if n*m > maxTableSize {
+		for _, word := range a {
+			if !yield(Delete, word) {
+				return false
+			}
+		}
+		for _, word := range b {
+			if !yield(Insert, word) {
+				return false
+			}
+		}
+		return true
+	}
+
+	// lengths[i*(m+1)+j] is the length of the longest common subsequence of a[i:] and b[j:].
+	lengths := make([]int32, (n+1)*(m+1))
+	for i := n - 1; i >= 0; i-- {
+		for j := m - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lengths[i*(m+1)+j] = lengths[(i+1)*(m+1)+j+1] + 1
+			} else {
+				lengths[i*(m+1)+j] = max(lengths[(i+1)*(m+1)+j], lengths[i*(m+1)+j+1])
+			}
+		}
+	}
+
+	i, j := 0, 0
+	for i < n && j < m {
+		switch {
+		case a[i] == b[j]:
+			if !yield(Equal, a[i]) {
+				return false
+			}
+			i++
+			j++
+		case lengths[(i+1)*(m+1)+j] >= lengths[i*(m+1)+j+1]:
+			if !yield(Delete, a[i]) {
+				return false
+			}
+			i++
+		default:
+			if !yield(Insert, b[j]) {
+				return false
+			}
+			j++
+		}
+	}
+
+	for ; i < n; i++ {
+		if !yield(Delete, a[i]) {
+			return false
+		}
+	}
+
+	for ; j < m; j++ {
+		if !yield(Insert, b[j]) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,77 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package textdiff // import "miniflux.app/v2/internal/reader/textdiff"
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestDiff(t *testing.T) {
+	chunks := Diff("The quick  brown fox jumps", "The quick red fox jumps high")
+	expected := []Chunk{
+		{Operation: Equal, Text: "The quick"},
+		{Operation: Delete, Text: "brown"},
+		{Operation: Insert, Text: "red"},
+		{Operation: Equal, Text: "fox jumps"},
+		{Operation: Insert, Text: "high"},
+	}
+
+	if !slices.Equal(chunks, expected) {
+		t.Errorf(`Unexpected chunks, got %+v`, chunks)
+	}
+}
+
+func TestDiffWithIdenticalTexts(t *testing.T) {
+	chunks := Diff("Same text", " Same\ntext ")
+	if !slices.Equal(chunks, []Chunk{{Operation: Equal, Text: "Same text"}}) {
+		t.Errorf(`Unexpected chunks, got %+v`, chunks)
+	}
+}
+
+func TestDiffWithEmptyTexts(t *testing.T) {
+	if chunks := Diff("", ""); len(chunks) != 0 {
+		t.Errorf(`No chunks were expected, got %+v`, chunks)
+	}
+
+	chunks := Diff("", "New text")
+	if !slices.Equal(chunks, []Chunk{{Operation: Insert, Text: "New text"}}) {
+		t.Errorf(`Unexpected chunks, got %+v`, chunks)
+	}
+}
+
+func TestDiffWithLargeTexts(t *testing.T) {
+	before := make([]string, 3000)
+	after := make([]string, 3000)
+	for i := range before {
+		before[i] = "a"
+		after[i] = "b"
+	}
+
+	chunks := Diff("start "+strings.Join(before, " ")+" end", "start "+strings.Join(after, " ")+" end")
+	if len(chunks) != 4 || chunks[1].Operation != Delete || chunks[2].Operation != Insert {
+		t.Errorf(`The different words should be deleted then inserted, got %d chunks`, len(chunks))
+	}
+}
+
+func TestChangeRatio(t *testing.T) {
+	scenarios := []struct {
+		before   string
+		after    string
+		expected int
+	}{
+		{"", "", 0},
+		{"one two three four", "one two three four", 0},
+		{"one two three four", "one two three five", 25},
+		{"one two", "three four", 100},
+		{"", "new content", 100},
+	}
+
+	for _, scenario := range scenarios {
+		if ratio := ChangeRatio(scenario.before, scenario.after); ratio != scenario.expected {
+			t.Errorf(`Unexpected ratio for %q => %q, got %d instead of %d`, scenario.before, scenario.after, ratio, scenario.expected)
+		}
+	}
+}
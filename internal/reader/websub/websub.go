@@ -0,0 +1,56 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package websub // import "miniflux.app/v2/internal/reader/websub"
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"miniflux.app/v2/internal/config"
+	"miniflux.app/v2/internal/http/client"
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/version"
+)
+
+// Subscribe asks the hub to push the updates of the subscription topic to the callback URL.
+//
+// Specs: https://www.w3.org/TR/websub/#subscriber-sends-subscription-request
+func Subscribe(subscription *model.WebSubSubscription, leaseDuration time.Duration) error {
+	form := url.Values{}
+	form.Set("hub.mode", "subscribe")
+	form.Set("hub.topic", subscription.TopicURL)
+	form.Set("hub.callback", subscription.CallbackURL())
+	form.Set("hub.secret", subscription.Secret)
+	form.Set("hub.lease_seconds", strconv.Itoa(int(leaseDuration.Seconds())))
+
+	request, err := http.NewRequest(http.MethodPost, subscription.HubURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return fmt.Errorf("websub: unable to create request: %v", err)
+	}
+
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	request.Header.Set("User-Agent", "Miniflux/"+version.Version)
+
+	httpClient := client.NewClientWithOptions(client.Options{
+		Timeout:              config.Opts.HTTPClientTimeout(),
+		BlockPrivateNetworks: !config.Opts.FetcherAllowPrivateNetworks(),
+	})
+
+	response, err := httpClient.Do(request)
+	if err != nil {
+		return fmt.Errorf("websub: unable to send request: %v", err)
+	}
+	defer response.Body.Close()
+
+	// The hub answers with 202 Accepted and verifies the intent of the subscriber asynchronously.
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return fmt.Errorf("websub: incorrect response status code %d for hub %s", response.StatusCode, subscription.HubURL)
+	}
+
+	return nil
+}
@@ -0,0 +1,95 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package websub // import "miniflux.app/v2/internal/reader/websub"
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"miniflux.app/v2/internal/config"
+	"miniflux.app/v2/internal/model"
+)
+
+func configureTestOptions(t *testing.T) {
+	t.Helper()
+
+	t.Setenv("BASE_URL", "https://miniflux.example.org/reader")
+	t.Setenv("FETCHER_ALLOW_PRIVATE_NETWORKS", "1")
+
+	configParser := config.NewConfigParser()
+	parsedOptions, err := configParser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf("Unable to configure test options: %v", err)
+	}
+
+	previousOptions := config.Opts
+	config.Opts = parsedOptions
+	t.Cleanup(func() {
+		config.Opts = previousOptions
+	})
+}
+
+func TestSubscribe(t *testing.T) {
+	configureTestOptions(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("Expected POST, got %s", r.Method)
+		}
+
+		if contentType := r.Header.Get("Content-Type"); contentType != "application/x-www-form-urlencoded" {
+			t.Errorf("Unexpected content type: %s", contentType)
+		}
+
+		expectedValues := map[string]string{
+			"hub.mode":          "subscribe",
+			"hub.topic":         "https://example.org/feed.xml",
+			"hub.callback":      "https://miniflux.example.org/reader/websub/token",
+			"hub.secret":        "secret",
+			"hub.lease_seconds": "86400",
+		}
+
+		for key, expected := range expectedValues {
+			if value := r.FormValue(key); value != expected {
+				t.Errorf("Unexpected value for %s: got %q instead of %q", key, value, expected)
+			}
+		}
+
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	subscription := &model.WebSubSubscription{
+		HubURL:        server.URL,
+		TopicURL:      "https://example.org/feed.xml",
+		Secret:        "secret",
+		CallbackToken: "token",
+	}
+
+	if err := Subscribe(subscription, 24*time.Hour); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+}
+
+func TestSubscribeWithHubError(t *testing.T) {
+	configureTestOptions(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	subscription := &model.WebSubSubscription{
+		HubURL:        server.URL,
+		TopicURL:      "https://example.org/feed.xml",
+		Secret:        "secret",
+		CallbackToken: "token",
+	}
+
+	if err := Subscribe(subscription, 24*time.Hour); err == nil {
+		t.Fatal("Expected an error when the hub rejects the request")
+	}
+}
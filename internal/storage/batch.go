@@ -25,6 +25,8 @@ type batchBuilder struct {
 func (s *Storage) NewBatchBuilder() *batchBuilder {
 	return &batchBuilder{
 		db: s.db,
+		// Newsletter feeds receive their entries by email, there is nothing to fetch.
+		conditions: []string{"feed_url NOT LIKE 'mailto:%'"},
 	}
 }
 
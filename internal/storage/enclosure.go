@@ -24,7 +24,9 @@ func (s *Storage) EnclosuresByEntryID(entryID int64) (model.EnclosureList, error
 			url,
 			size,
 			mime_type,
-		    media_progression
+		    media_progression,
+			title,
+			alternate
 		FROM
 			enclosures
 		WHERE
@@ -49,6 +51,8 @@ func (s *Storage) EnclosuresByEntryID(entryID int64) (model.EnclosureList, error
 			&enclosure.Size,
 			&enclosure.MimeType,
 			&enclosure.MediaProgression,
+			&enclosure.Title,
+			&enclosure.Alternate,
 		)
 
 		if err != nil {
@@ -71,7 +75,9 @@ func (s *Storage) EnclosuresByEntryIDs(entryIDs []int64) (map[int64]model.Enclos
 			url,
 			size,
 			mime_type,
-		    media_progression
+		    media_progression,
+			title,
+			alternate
 		FROM
 			enclosures
 		WHERE
@@ -96,6 +102,8 @@ func (s *Storage) EnclosuresByEntryIDs(entryIDs []int64) (map[int64]model.Enclos
 			&enclosure.Size,
 			&enclosure.MimeType,
 			&enclosure.MediaProgression,
+			&enclosure.Title,
+			&enclosure.Alternate,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("store: unable to scan enclosure row: %w", err)
@@ -117,7 +125,9 @@ func (s *Storage) EnclosureByID(userID, enclosureID int64) (*model.Enclosure, er
 			url,
 			size,
 			mime_type,
-		    media_progression
+		    media_progression,
+			title,
+			alternate
 		FROM
 			enclosures
 		WHERE
@@ -135,6 +145,8 @@ func (s *Storage) EnclosureByID(userID, enclosureID int64) (*model.Enclosure, er
 		&enclosure.Size,
 		&enclosure.MimeType,
 		&enclosure.MediaProgression,
+		&enclosure.Title,
+		&enclosure.Alternate,
 	)
 
 	if errors.Is(err, sql.ErrNoRows) {
@@ -154,9 +166,9 @@ func (s *Storage) createEnclosure(tx *sql.Tx, enclosure *model.Enclosure) error
 
 	query := `
 		INSERT INTO enclosures
-			(url, size, mime_type, entry_id, user_id, media_progression)
+			(url, size, mime_type, entry_id, user_id, media_progression, title, alternate)
 		VALUES
-			($1, $2, $3, $4, $5, $6)
+			($1, $2, $3, $4, $5, $6, $7, $8)
 		ON CONFLICT (user_id, entry_id, encode(sha256(url::bytea), 'hex')) DO NOTHING
 		RETURNING
 			id
@@ -169,6 +181,8 @@ func (s *Storage) createEnclosure(tx *sql.Tx, enclosure *model.Enclosure) error
 		enclosure.EntryID,
 		enclosure.UserID,
 		enclosure.MediaProgression,
+		enclosure.Title,
+		enclosure.Alternate,
 	).Scan(&enclosure.ID); err != nil && err != sql.ErrNoRows {
 		return fmt.Errorf(`store: unable to create enclosure: %w`, err)
 	}
@@ -4,6 +4,7 @@
 package storage // import "miniflux.app/v2/internal/storage"
 
 import (
+	"cmp"
 	"database/sql"
 	"errors"
 	"fmt"
@@ -12,6 +13,7 @@ import (
 
 	"miniflux.app/v2/internal/crypto"
 	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/urllib"
 
 	"github.com/lib/pq"
 )
@@ -57,7 +59,13 @@ func (s *Storage) UpdateEntryTitleAndContent(entry *model.Entry) error {
 			title=$1,
 			content=$2,
 			reading_time=$3,
-			document_vectors = setweight(to_tsvector($4), 'A') || setweight(to_tsvector($5), 'B')
+			search_config=nullif($12, ''),
+			document_vectors = setweight(to_tsvector(coalesce(nullif($12, '')::regconfig, get_current_ts_config()), $4), 'A') ||
+				setweight(to_tsvector(coalesce(nullif($12, '')::regconfig, get_current_ts_config()), $5), 'B') ||
+				setweight(to_tsvector(coalesce(nullif($12, '')::regconfig, get_current_ts_config()), left(transcript, $8)), 'D'),
+			thumbnail_url=$9,
+			thumbnail_width=$10,
+			thumbnail_height=$11
 		WHERE
 			id=$6 AND user_id=$7
 	`
@@ -70,7 +78,12 @@ func (s *Storage) UpdateEntryTitleAndContent(entry *model.Entry) error {
 		truncatedTitle,
 		truncatedContent,
 		entry.ID,
-		entry.UserID); err != nil {
+		entry.UserID,
+		transcriptMaxSizeForTSVectorField,
+		entry.ThumbnailURL,
+		entry.ThumbnailWidth,
+		entry.ThumbnailHeight,
+		entryTextSearchConfig(entry)); err != nil {
 		return fmt.Errorf(`store: unable to update entry #%d: %v`, entry.ID, err)
 	}
 
@@ -80,6 +93,13 @@ func (s *Storage) UpdateEntryTitleAndContent(entry *model.Entry) error {
 // createEntry add a new entry.
 func (s *Storage) createEntry(tx *sql.Tx, entry *model.Entry) error {
 	truncatedTitle, truncatedContent := truncateTitleAndContentForTSVectorField(entry.Title, entry.Content)
+	truncatedTranscript := truncateStringForTSVectorField(entry.Transcript, transcriptMaxSizeForTSVectorField)
+
+	podcastMetadata, err := model.MarshalPodcastMetadata(entry.Podcast)
+	if err != nil {
+		return fmt.Errorf(`store: unable to serialize podcast metadata: %v`, err)
+	}
+
 	// The WHERE NOT EXISTS guard makes the tombstone check atomic with the insert, so a
 	// concurrent archive committing between an earlier existence check and this statement
 	// cannot bring a deleted entry back as unread.
@@ -98,8 +118,20 @@ func (s *Storage) createEntry(tx *sql.Tx, entry *model.Entry) error {
 				reading_time,
 				changed_at,
 				document_vectors,
+				search_config,
 				tags,
-				language
+				language,
+				podcast,
+				transcript,
+				thumbnail_url,
+				thumbnail_width,
+				thumbnail_height,
+				normalized_url,
+				duplicate_of_id,
+				status,
+				fingerprint,
+				story_id,
+				starred
 			)
 		SELECT
 			$1,
@@ -113,16 +145,30 @@ func (s *Storage) createEntry(tx *sql.Tx, entry *model.Entry) error {
 			$9,
 			$10,
 			now(),
-			setweight(to_tsvector($11), 'A') || setweight(to_tsvector($12), 'B'),
+			setweight(to_tsvector(coalesce(nullif($27, '')::regconfig, get_current_ts_config()), $11), 'A') ||
+				setweight(to_tsvector(coalesce(nullif($27, '')::regconfig, get_current_ts_config()), $12), 'B') ||
+				setweight(to_tsvector(coalesce(nullif($27, '')::regconfig, get_current_ts_config()), $17), 'D'),
+			nullif($27, ''),
 			$13,
-			$14
+			$14,
+			$15,
+			$16,
+			$18,
+			$19,
+			$20,
+			$21,
+			NULLIF($22::bigint, 0),
+			$23::entry_status,
+			$24,
+			NULLIF($25::bigint, 0),
+			$26
 		WHERE NOT EXISTS (
 			SELECT 1 FROM entry_tombstones WHERE feed_id=$9 AND hash=$2
 		)
 		RETURNING
 			id, status, created_at, changed_at
 	`
-	err := tx.QueryRow(
+	err = tx.QueryRow(
 		query,
 		entry.Title,
 		entry.Hash,
@@ -138,6 +184,19 @@ func (s *Storage) createEntry(tx *sql.Tx, entry *model.Entry) error {
 		truncatedContent,
 		pq.Array(entry.Tags),
 		entry.Language,
+		podcastMetadata,
+		entry.Transcript,
+		truncatedTranscript,
+		entry.ThumbnailURL,
+		entry.ThumbnailWidth,
+		entry.ThumbnailHeight,
+		urllib.NormalizeURL(entry.URL),
+		entry.DuplicateOfID,
+		cmp.Or(entry.Status, model.EntryStatusUnread),
+		entry.Fingerprint,
+		entry.StoryID,
+		entry.Starred,
+		entryTextSearchConfig(entry),
 	).Scan(
 		&entry.ID,
 		&entry.Status,
@@ -166,11 +225,28 @@ func (s *Storage) createEntry(tx *sql.Tx, entry *model.Entry) error {
 // updateEntry updates an entry when a feed is refreshed.
 // Note: we do not update the published date because some feeds do not contains any date,
 // it default to time.Now() which could change the order of items on the history page.
-func (s *Storage) updateEntry(tx *sql.Tx, entry *model.Entry) error {
+// The previous title and content are kept as a revision when the text of the entry changed.
+func (s *Storage) updateEntry(tx *sql.Tx, entry *model.Entry, markUnreadOnChangeThreshold int) error {
 	truncatedTitle, truncatedContent := truncateTitleAndContentForTSVectorField(entry.Title, entry.Content)
+
+	podcastMetadata, err := model.MarshalPodcastMetadata(entry.Podcast)
+	if err != nil {
+		return fmt.Errorf(`store: unable to serialize podcast metadata: %v`, err)
+	}
+
+	// The transcript and the chapters are only fetched for new entries, the stored ones are kept.
 	query := `
+		WITH previous AS (
+			SELECT
+				id, title, content
+			FROM
+				entries
+			WHERE
+				user_id=$9 AND feed_id=$10 AND hash=$11
+			FOR UPDATE
+		)
 		UPDATE
-			entries
+			entries e
 		SET
 			title=$1,
 			url=$2,
@@ -178,15 +254,31 @@ func (s *Storage) updateEntry(tx *sql.Tx, entry *model.Entry) error {
 			content=$4,
 			author=$5,
 			reading_time=$6,
-			document_vectors = setweight(to_tsvector($7), 'A') || setweight(to_tsvector($8), 'B'),
+			search_config=nullif($21, ''),
+			document_vectors = setweight(to_tsvector(coalesce(nullif($21, '')::regconfig, get_current_ts_config()), $7), 'A') ||
+				setweight(to_tsvector(coalesce(nullif($21, '')::regconfig, get_current_ts_config()), $8), 'B') ||
+				setweight(to_tsvector(coalesce(nullif($21, '')::regconfig, get_current_ts_config()), left(transcript, $15)), 'D'),
 			tags=$12,
-			language=$13
+			language=$13,
+			podcast=CASE
+				WHEN podcast ? 'chapters' AND podcast->>'chapters_url' = $14::jsonb->>'chapters_url'
+				THEN $14::jsonb || jsonb_build_object('chapters', podcast->'chapters')
+				ELSE $14::jsonb
+			END,
+			thumbnail_url=$16,
+			thumbnail_width=$17,
+			thumbnail_height=$18,
+			normalized_url=$19,
+			fingerprint=$20
+		FROM
+			previous p
 		WHERE
-			user_id=$9 AND feed_id=$10 AND hash=$11
+			e.id=p.id
 		RETURNING
-			id
+			e.id, p.title, p.content
 	`
-	err := tx.QueryRow(
+	var previousTitle, previousContent string
+	err = tx.QueryRow(
 		query,
 		entry.Title,
 		entry.URL,
@@ -201,11 +293,23 @@ func (s *Storage) updateEntry(tx *sql.Tx, entry *model.Entry) error {
 		entry.Hash,
 		pq.Array(entry.Tags),
 		entry.Language,
-	).Scan(&entry.ID)
+		podcastMetadata,
+		transcriptMaxSizeForTSVectorField,
+		entry.ThumbnailURL,
+		entry.ThumbnailWidth,
+		entry.ThumbnailHeight,
+		urllib.NormalizeURL(entry.URL),
+		entry.Fingerprint,
+		entryTextSearchConfig(entry),
+	).Scan(&entry.ID, &previousTitle, &previousContent)
 	if err != nil {
 		return fmt.Errorf(`store: unable to update entry %q: %v`, entry.URL, err)
 	}
 
+	if err := s.recordEntryChange(tx, entry, previousTitle, previousContent, markUnreadOnChangeThreshold); err != nil {
+		return err
+	}
+
 	for _, enclosure := range entry.Enclosures {
 		enclosure.UserID = entry.UserID
 		enclosure.EntryID = entry.ID
@@ -214,6 +318,159 @@ func (s *Storage) updateEntry(tx *sql.Tx, entry *model.Entry) error {
 	return s.updateEnclosures(tx, entry)
 }
 
+// FindDuplicateEntryID returns the ID of a recent entry with the same normalized URL in another feed of the user.
+// Entries that are already duplicates are ignored to always link to the original entry.
+func (s *Storage) FindDuplicateEntryID(userID, feedID int64, entryURL string) (int64, error) {
+	normalizedURL := urllib.NormalizeURL(entryURL)
+	if normalizedURL == "" {
+		return 0, nil
+	}
+
+	query := `
+		SELECT
+			id
+		FROM
+			entries
+		WHERE
+			user_id=$1 AND
+			feed_id <> $2 AND
+			normalized_url=$3 AND
+			duplicate_of_id IS NULL AND
+			created_at > now() - interval '30 days'
+		ORDER BY
+			created_at ASC, id ASC
+		LIMIT 1
+	`
+
+	var entryID int64
+	err := s.db.QueryRow(query, userID, feedID, normalizedURL).Scan(&entryID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf(`store: unable to find duplicate entry: %v`, err)
+	}
+
+	return entryID, nil
+}
+
+// EntryDuplicates returns the other entries of the user with the same normalized URL.
+// Nothing is returned when the user did not enable the duplicate detection.
+func (s *Storage) EntryDuplicates(userID, entryID int64) ([]*model.EntryDuplicate, error) {
+	query := `
+		SELECT
+			d.id,
+			d.feed_id,
+			f.title,
+			d.status
+		FROM
+			entries e
+		INNER JOIN
+			users u ON u.id=e.user_id
+		INNER JOIN
+			entries d ON d.user_id=e.user_id AND d.normalized_url=e.normalized_url AND d.id <> e.id
+		INNER JOIN
+			feeds f ON f.id=d.feed_id
+		WHERE
+			e.id=$1 AND e.user_id=$2 AND e.normalized_url <> '' AND u.mark_duplicate_entries_as_read
+		ORDER BY
+			d.created_at ASC, d.id ASC
+		LIMIT 20
+	`
+
+	rows, err := s.db.Query(query, entryID, userID)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch duplicates of entry #%d: %v`, entryID, err)
+	}
+	defer rows.Close()
+
+	var duplicates []*model.EntryDuplicate
+	for rows.Next() {
+		var duplicate model.EntryDuplicate
+		if err := rows.Scan(&duplicate.EntryID, &duplicate.FeedID, &duplicate.FeedTitle, &duplicate.Status); err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch duplicate entry row: %v`, err)
+		}
+		duplicates = append(duplicates, &duplicate)
+	}
+
+	return duplicates, nil
+}
+
+// FindStoryID returns the story of a recent entry from another feed with a fingerprint differing by at most maxDistance bits.
+// The first entry of a story has no story ID, its own ID identifies the story.
+func (s *Storage) FindStoryID(userID, feedID, fingerprint int64, maxDistance int) (int64, error) {
+	if fingerprint == 0 {
+		return 0, nil
+	}
+
+	query := `
+		SELECT
+			coalesce(story_id, id)
+		FROM
+			entries
+		WHERE
+			user_id=$1 AND
+			feed_id <> $2 AND
+			fingerprint <> 0 AND
+			created_at > now() - interval '3 days' AND
+			length(replace((fingerprint # $3::bigint)::bit(64)::text, '0', '')) <= $4
+		ORDER BY
+			created_at ASC, id ASC
+		LIMIT 1
+	`
+
+	var storyID int64
+	err := s.db.QueryRow(query, userID, feedID, fingerprint, maxDistance).Scan(&storyID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf(`store: unable to find story: %v`, err)
+	}
+
+	return storyID, nil
+}
+
+// storyEntriesByEntryIDs returns the other entries of the story of each given entry.
+func (s *Storage) storyEntriesByEntryIDs(entryIDs []int64) (map[int64][]*model.EntryDuplicate, error) {
+	query := `
+		SELECT
+			e.id,
+			o.id,
+			o.feed_id,
+			f.title,
+			o.status
+		FROM
+			entries e
+		INNER JOIN
+			entries o ON o.user_id=e.user_id AND o.id <> e.id AND (o.story_id=coalesce(e.story_id, e.id) OR o.id=e.story_id)
+		INNER JOIN
+			feeds f ON f.id=o.feed_id
+		WHERE
+			e.id = ANY($1)
+		ORDER BY
+			o.created_at ASC, o.id ASC
+	`
+
+	rows, err := s.db.Query(query, pq.Array(entryIDs))
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch story entries: %v`, err)
+	}
+	defer rows.Close()
+
+	storyEntries := make(map[int64][]*model.EntryDuplicate)
+	for rows.Next() {
+		var entryID int64
+		var storyEntry model.EntryDuplicate
+		if err := rows.Scan(&entryID, &storyEntry.EntryID, &storyEntry.FeedID, &storyEntry.FeedTitle, &storyEntry.Status); err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch story entry row: %v`, err)
+		}
+		storyEntries[entryID] = append(storyEntries[entryID], &storyEntry)
+	}
+
+	return storyEntries, nil
+}
+
 // entryExists checks if an entry already exists based on its hash when refreshing a feed.
 func (s *Storage) entryExists(tx *sql.Tx, entry *model.Entry) (bool, error) {
 	var result bool
@@ -317,27 +574,32 @@ func (s *Storage) GetReadTime(feedID int64, entryHash string) int {
 }
 
 // RefreshFeedEntries updates feed entries while refreshing a feed.
-func (s *Storage) RefreshFeedEntries(userID, feedID int64, entries model.Entries, updateExistingEntries bool) (newEntries model.Entries, err error) {
+// It returns the newly created entries and the number of existing entries that were updated.
+// Updated entries are marked as unread when their text changed by at least the given percentage, zero disables it.
+func (s *Storage) RefreshFeedEntries(userID, feedID int64, entries model.Entries, updateExistingEntries bool, markUnreadOnChangeThreshold int) (newEntries model.Entries, updatedEntriesCount int, err error) {
 	for _, entry := range entries {
 		entry.UserID = userID
 		entry.FeedID = feedID
 
 		tx, err := s.db.Begin()
 		if err != nil {
-			return nil, fmt.Errorf(`store: unable to start transaction: %v`, err)
+			return nil, 0, fmt.Errorf(`store: unable to start transaction: %v`, err)
 		}
 
 		entryExists, err := s.entryExists(tx, entry)
 		if err != nil {
 			if rollbackErr := tx.Rollback(); rollbackErr != nil {
-				return nil, fmt.Errorf(`store: unable to rollback transaction: %v (rolled back due to: %v)`, rollbackErr, err)
+				return nil, 0, fmt.Errorf(`store: unable to rollback transaction: %v (rolled back due to: %v)`, rollbackErr, err)
 			}
-			return nil, err
+			return nil, 0, err
 		}
 
 		if entryExists {
 			if updateExistingEntries {
-				err = s.updateEntry(tx, entry)
+				err = s.updateEntry(tx, entry, markUnreadOnChangeThreshold)
+				if err == nil {
+					updatedEntriesCount++
+				}
 			}
 		} else {
 			err = s.createEntry(tx, entry)
@@ -351,17 +613,17 @@ func (s *Storage) RefreshFeedEntries(userID, feedID int64, entries model.Entries
 
 		if err != nil {
 			if rollbackErr := tx.Rollback(); rollbackErr != nil {
-				return nil, fmt.Errorf(`store: unable to rollback transaction: %v (rolled back due to: %v)`, rollbackErr, err)
+				return nil, 0, fmt.Errorf(`store: unable to rollback transaction: %v (rolled back due to: %v)`, rollbackErr, err)
 			}
-			return nil, err
+			return nil, 0, err
 		}
 
 		if err := tx.Commit(); err != nil {
-			return nil, fmt.Errorf(`store: unable to commit transaction: %v`, err)
+			return nil, 0, fmt.Errorf(`store: unable to commit transaction: %v`, err)
 		}
 	}
 
-	return newEntries, nil
+	return newEntries, updatedEntriesCount, nil
 }
 
 // ArchiveEntries deletes entries older than the given interval and records tombstones so they are not re-ingested.
@@ -378,6 +640,7 @@ func (s *Storage) ArchiveEntries(status string, interval time.Duration, limit in
 				status=$1 AND
 				starred is false AND
 				share_code='' AND
+				NOT EXISTS (SELECT 1 FROM highlights WHERE entry_id = entries.id) AND
 				created_at < now() - $2::interval
 			ORDER BY created_at ASC
 			FOR UPDATE SKIP LOCKED
@@ -502,6 +765,46 @@ func (s *Storage) FlushHistory(userID int64) error {
 	return nil
 }
 
+// RemoveEntries deletes the given entries, except starred and shared ones, and records tombstones so they are not re-ingested.
+// It returns the number of removed entries.
+func (s *Storage) RemoveEntries(userID int64, entryIDs []int64) (int64, error) {
+	query := `
+		WITH deleted AS (
+			DELETE FROM entries
+			WHERE user_id=$1 AND id=ANY($2) AND starred is false AND share_code=''
+			RETURNING feed_id, hash
+		), tombstones AS (
+			INSERT INTO entry_tombstones (feed_id, hash)
+			SELECT feed_id, hash FROM deleted WHERE hash <> ''
+			ON CONFLICT (feed_id, hash) DO NOTHING
+		)
+		SELECT count(*) FROM deleted
+	`
+	var count int64
+	if err := s.db.QueryRow(query, userID, pq.Array(entryIDs)).Scan(&count); err != nil {
+		return 0, fmt.Errorf(`store: unable to remove entries %v: %v`, entryIDs, err)
+	}
+
+	return count, nil
+}
+
+// DeleteEntries deletes the given entries, except starred and shared ones, and returns the number of deleted entries.
+// Without tombstones, the entries are fetched again if they are still present in the feed.
+func (s *Storage) DeleteEntries(userID int64, entryIDs []int64) (int64, error) {
+	query := `DELETE FROM entries WHERE user_id=$1 AND id=ANY($2) AND starred is false AND share_code=''`
+	result, err := s.db.Exec(query, userID, pq.Array(entryIDs))
+	if err != nil {
+		return 0, fmt.Errorf(`store: unable to delete entries %v: %v`, entryIDs, err)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf(`store: unable to get the number of rows affected: %v`, err)
+	}
+
+	return count, nil
+}
+
 // MarkAllAsRead updates all user entries to the read status.
 func (s *Storage) MarkAllAsRead(userID int64) error {
 	query := `UPDATE entries SET status=$1, changed_at=now() WHERE user_id=$2 AND status=$3`
@@ -675,6 +978,24 @@ func (s *Storage) UnshareEntry(userID int64, entryID int64) (err error) {
 	return
 }
 
+// transcriptMaxSizeForTSVectorField is the part of the podcast transcript that is indexed.
+// It is kept small enough to fit in the tsvector with the title and content.
+// The transcript gets the lowest weight, a match in a long transcript should not rank above the title or content.
+const transcriptMaxSizeForTSVectorField = 100000
+
+// EntryTranscript returns the podcast transcript of the given entry.
+func (s *Storage) EntryTranscript(entryID int64) (string, error) {
+	var transcript string
+	err := s.db.QueryRow(`SELECT transcript FROM entries WHERE id=$1`, entryID).Scan(&transcript)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf(`store: unable to fetch transcript for entry #%d: %v`, entryID, err)
+	}
+	return transcript, nil
+}
+
 func truncateTitleAndContentForTSVectorField(title, content string) (string, string) {
 	// The length of a tsvector (lexemes + positions) must be less than 1 megabyte.
 	// We don't need to index the entire content, and we need to keep a buffer for the positions.
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/lib/pq"
 	"miniflux.app/v2/internal/model"
@@ -16,24 +17,80 @@ import (
 
 // entryPaginationBuilder is a builder for entry prev/next queries.
 type entryPaginationBuilder struct {
-	db         *sql.DB
-	conditions []string
-	args       []any
-	entryID    int64
-	order      string
-	direction  string
+	store               *Storage
+	db                  *sql.DB
+	conditions          []string
+	args                []any
+	entryID             int64
+	order               string
+	direction           string
+	fuzzyArgIndex       int
+	fuzzyConditionIndex int
 }
 
-// WithSearchQuery adds full-text search query to the condition.
+// WithSearchQuery adds full-text search query and the search operators to the condition.
 func (e *entryPaginationBuilder) WithSearchQuery(query string) *entryPaginationBuilder {
-	if query != "" {
-		e.conditions = append(e.conditions, fmt.Sprintf("e.document_vectors @@ websearch_to_tsquery($%d)", len(e.args)+1))
-		e.args = append(e.args, query)
-	}
+	e.withSearchQuery(query, false)
+	return e
+}
 
+// WithFuzzySearchQuery is like WithSearchQuery, but the entries with a title or a feed title similar to the query are matched too
+// when the full-text search finds fewer than fuzzySearchMinEntries entries, like the entry lists.
+func (e *entryPaginationBuilder) WithFuzzySearchQuery(query string) *entryPaginationBuilder {
+	e.withSearchQuery(query, true)
 	return e
 }
 
+func (e *entryPaginationBuilder) withSearchQuery(query string, fuzzy bool) {
+	if query == "" {
+		return
+	}
+
+	searchQuery := parseSearchQuery(query)
+	conditions, args := searchQuery.conditions(len(e.args))
+	e.conditions = append(e.conditions, conditions...)
+	e.args = append(e.args, args...)
+
+	if searchQuery.text == "" {
+		return
+	}
+
+	nArgs := len(e.args) + 1
+	e.conditions = append(e.conditions, textSearchCondition(nArgs))
+	e.args = append(e.args, searchQuery.text)
+
+	if fuzzy {
+		e.fuzzyArgIndex = nArgs
+		e.fuzzyConditionIndex = len(e.conditions) - 1
+	}
+}
+
+// applyFuzzySearch adds the entries with a similar title to the condition if the full-text search finds too few entries.
+// It must run once all the conditions are added.
+func (e *entryPaginationBuilder) applyFuzzySearch(tx *sql.Tx) error {
+	if e.fuzzyArgIndex == 0 || !e.store.hasTrigramIndexes() {
+		return nil
+	}
+
+	query := `
+		SELECT count(*)
+		FROM entries e
+			JOIN feeds f ON f.id = e.feed_id
+			JOIN categories c ON c.id = f.category_id
+		WHERE ` + strings.Join(e.conditions, " AND ")
+
+	var count int
+	if err := tx.QueryRow(query, e.args...).Scan(&count); err != nil {
+		return fmt.Errorf("entry pagination: unable to count the full-text search results: %v", err)
+	}
+
+	if count < fuzzySearchMinEntries {
+		e.conditions[e.fuzzyConditionIndex] = fuzzySearchCondition(e.conditions[e.fuzzyConditionIndex], e.fuzzyArgIndex)
+	}
+
+	return nil
+}
+
 // WithStarred adds starred to the condition.
 func (e *entryPaginationBuilder) WithStarred() *entryPaginationBuilder {
 	e.conditions = append(e.conditions, "e.starred is true")
@@ -61,6 +118,49 @@ func (e *entryPaginationBuilder) WithCategoryID(categoryID int64) *entryPaginati
 	return e
 }
 
+// WithLabelID adds a label to the condition.
+func (e *entryPaginationBuilder) WithLabelID(labelID int64) *entryPaginationBuilder {
+	if labelID != 0 {
+		e.conditions = append(e.conditions, "e.id IN (SELECT entry_id FROM entry_labels WHERE label_id = $"+strconv.Itoa(len(e.args)+1)+")")
+		e.args = append(e.args, labelID)
+	}
+
+	return e
+}
+
+// WithSavedSearch adds the filters of a saved search to the condition.
+// The current entry is kept when it no longer matches the statuses, e.g. after being marked as read.
+func (e *entryPaginationBuilder) WithSavedSearch(savedSearch *model.SavedSearch) *entryPaginationBuilder {
+	e.WithSearchQuery(savedSearch.SearchQuery)
+
+	if savedSearch.Starred {
+		e.WithStarred()
+	}
+
+	if len(savedSearch.CategoryIDs) > 0 {
+		e.conditions = append(e.conditions, fmt.Sprintf("f.category_id = ANY($%d)", len(e.args)+1))
+		e.args = append(e.args, pq.Int64Array(savedSearch.CategoryIDs))
+	}
+
+	if len(savedSearch.FeedIDs) > 0 {
+		e.conditions = append(e.conditions, fmt.Sprintf("e.feed_id = ANY($%d)", len(e.args)+1))
+		e.args = append(e.args, pq.Int64Array(savedSearch.FeedIDs))
+	}
+
+	if publishedAfter := savedSearch.PublishedAfter(time.Now()); !publishedAfter.IsZero() {
+		e.conditions = append(e.conditions, fmt.Sprintf("e.published_at > $%d", len(e.args)+1))
+		e.args = append(e.args, publishedAfter)
+	}
+
+	if len(savedSearch.Statuses) > 0 {
+		e.conditions = append(e.conditions, fmt.Sprintf("(e.status = ANY($%d) OR e.id = $%d)", len(e.args)+1, len(e.args)+2))
+		e.args = append(e.args, pq.StringArray(savedSearch.Statuses), e.entryID)
+	}
+
+	e.WithTags(savedSearch.Tags)
+	return e
+}
+
 // WithStatus adds status to the condition.
 func (e *entryPaginationBuilder) WithStatus(status string) *entryPaginationBuilder {
 	if status != "" {
@@ -114,6 +214,11 @@ func (e *entryPaginationBuilder) Entries() (*model.Entry, *model.Entry, error) {
 		return nil, nil, fmt.Errorf("begin transaction for entry pagination: %v", err)
 	}
 
+	if err := e.applyFuzzySearch(tx); err != nil {
+		tx.Rollback()
+		return nil, nil, err
+	}
+
 	prevID, nextID, err := e.getPrevNextID(tx)
 	if err != nil {
 		tx.Rollback()
@@ -203,6 +308,7 @@ func (e *entryPaginationBuilder) getEntry(tx *sql.Tx, entryID int64) (*model.Ent
 // NewEntryPaginationBuilder returns a new EntryPaginationBuilder.
 func (s *Storage) NewEntryPaginationBuilder(userID, entryID int64, order, direction string) *entryPaginationBuilder {
 	return &entryPaginationBuilder{
+		store:      s,
 		db:         s.db,
 		args:       []any{userID},
 		conditions: []string{"e.user_id = $1"},
@@ -25,7 +25,26 @@ type EntryQueryBuilder struct {
 	limit           int
 	offset          int
 	fetchEnclosures bool
+	fetchLabels     bool
+	fetchHighlights bool
+	fetchTranscript bool
+	fetchDuplicates bool
+	fetchRevisions  bool
 	excludeContent  bool
+	groupByStory    bool
+	fetchSnippets   bool
+	snippetArgIndex int
+	fuzzySearch     *fuzzySearch
+}
+
+// fuzzySearch locates the full-text search condition and sort expression,
+// they are replaced when the full-text search finds too few entries.
+type fuzzySearch struct {
+	argIndex       int
+	conditionIndex int
+	sortIndex      int
+	applied        bool
+	fullTextCount  int
 }
 
 // WithEnclosures fetches enclosures for each entry.
@@ -34,6 +53,42 @@ func (e *EntryQueryBuilder) WithEnclosures() *EntryQueryBuilder {
 	return e
 }
 
+// WithTranscript fetches the podcast transcript of the entry returned by GetEntry.
+func (e *EntryQueryBuilder) WithTranscript() *EntryQueryBuilder {
+	e.fetchTranscript = true
+	return e
+}
+
+// WithEntryDetails fetches what the entry page shows along with the entry returned by GetEntry:
+// the labels, highlights, podcast transcript, copies received from other feeds and previous versions.
+func (e *EntryQueryBuilder) WithEntryDetails() *EntryQueryBuilder {
+	e.fetchLabels = true
+	e.fetchHighlights = true
+	e.fetchTranscript = true
+	e.fetchDuplicates = true
+	e.fetchRevisions = true
+	return e
+}
+
+// WithLabels fetches the user labels of each entry.
+func (e *EntryQueryBuilder) WithLabels() *EntryQueryBuilder {
+	e.fetchLabels = true
+	return e
+}
+
+// WithHighlights fetches the highlights of each entry.
+func (e *EntryQueryBuilder) WithHighlights() *EntryQueryBuilder {
+	e.fetchHighlights = true
+	return e
+}
+
+// WithStoryGrouping collapses the entries telling the same story into the first one received.
+// The other entries of the story are listed in the StoryEntries field.
+func (e *EntryQueryBuilder) WithStoryGrouping(groupByStory bool) *EntryQueryBuilder {
+	e.groupByStory = groupByStory
+	return e
+}
+
 // WithoutContent excludes the content column from the query results,
 // replacing it with an empty string. This significantly reduces data
 // transfer from PostgreSQL on list pages where content is not displayed.
@@ -43,21 +98,88 @@ func (e *EntryQueryBuilder) WithoutContent() *EntryQueryBuilder {
 }
 
 // WithSearchQuery adds full-text search query to the condition.
+// The search operators such as feed: or is:unread are parsed out of the query first.
+// The fetched entries are sorted by rank.
 func (e *EntryQueryBuilder) WithSearchQuery(query string) *EntryQueryBuilder {
-	if query != "" {
-		nArgs := len(e.args) + 1
-		e.conditions = append(e.conditions, fmt.Sprintf("e.document_vectors @@ websearch_to_tsquery($%d)", nArgs))
-		e.args = append(e.args, query)
+	e.withSearchQuery(query, true)
+	return e
+}
 
-		// 0.0000001 = 0.1 / (seconds_in_a_day)
+// WithSearchSnippets fetches the title and content fragments matching the full-text search query.
+// The snippets are only computed for the pages listing the search results.
+func (e *EntryQueryBuilder) WithSearchSnippets() *EntryQueryBuilder {
+	e.fetchSnippets = true
+	return e
+}
 
-		e.sortExpressions = append(e.sortExpressions,
-			fmt.Sprintf("ts_rank(document_vectors, websearch_to_tsquery($%d)) - extract (epoch from now() - published_at)::float * 0.0000001 DESC", nArgs),
-		)
+// WithFuzzySearchQuery is like WithSearchQuery, but when the full-text search finds fewer than fuzzySearchMinEntries entries,
+// the entries with a title or a feed title similar to the query are matched too, and sorted by similarity.
+// The fuzzy search requires the pg_trgm extension and the trigram indexes, it is ignored otherwise.
+func (e *EntryQueryBuilder) WithFuzzySearchQuery(query string) *EntryQueryBuilder {
+	if argIndex := e.withSearchQuery(query, true); argIndex > 0 {
+		e.fuzzySearch = &fuzzySearch{
+			argIndex:       argIndex,
+			conditionIndex: len(e.conditions) - 1,
+			sortIndex:      len(e.sortExpressions) - 1,
+		}
 	}
 	return e
 }
 
+// withSearchQuery returns the placeholder index of the full-text part of the query, zero if there is none.
+func (e *EntryQueryBuilder) withSearchQuery(query string, searchResults bool) int {
+	if query == "" {
+		return 0
+	}
+
+	searchQuery := parseSearchQuery(query)
+	conditions, args := searchQuery.conditions(len(e.args))
+	e.conditions = append(e.conditions, conditions...)
+	e.args = append(e.args, args...)
+
+	if searchQuery.text == "" {
+		return 0
+	}
+
+	nArgs := len(e.args) + 1
+	e.conditions = append(e.conditions, textSearchCondition(nArgs))
+	e.args = append(e.args, searchQuery.text)
+
+	if searchResults {
+		e.sortExpressions = append(e.sortExpressions, searchRankExpression(nArgs, false))
+		e.snippetArgIndex = nArgs
+	}
+
+	return nArgs
+}
+
+// applyFuzzySearch adds the entries with a similar title to the condition if the full-text search finds too few entries.
+func (e *EntryQueryBuilder) applyFuzzySearch() error {
+	if e.fuzzySearch == nil || e.fuzzySearch.applied {
+		return nil
+	}
+	e.fuzzySearch.applied = true
+
+	if !e.store.hasTrigramIndexes() {
+		return nil
+	}
+
+	count, err := e.countEntries()
+	if err != nil {
+		return err
+	}
+
+	e.fuzzySearch.fullTextCount = count
+	if count >= fuzzySearchMinEntries {
+		return nil
+	}
+
+	e.conditions[e.fuzzySearch.conditionIndex] = fuzzySearchCondition(e.conditions[e.fuzzySearch.conditionIndex], e.fuzzySearch.argIndex)
+	e.sortExpressions[e.fuzzySearch.sortIndex] = searchRankExpression(e.fuzzySearch.argIndex, true)
+
+	return nil
+}
+
 // WithStarred adds starred filter.
 func (e *EntryQueryBuilder) WithStarred(starred bool) *EntryQueryBuilder {
 	if starred {
@@ -144,6 +266,42 @@ func (e *EntryQueryBuilder) WithCategoryID(categoryID int64) *EntryQueryBuilder
 	return e
 }
 
+// WithLabelID filter by label ID.
+func (e *EntryQueryBuilder) WithLabelID(labelID int64) *EntryQueryBuilder {
+	if labelID > 0 {
+		e.conditions = append(e.conditions, "e.id IN (SELECT entry_id FROM entry_labels WHERE label_id = $"+strconv.Itoa(len(e.args)+1)+")")
+		e.args = append(e.args, labelID)
+	}
+	return e
+}
+
+// WithSavedSearch applies the filters of a saved search, the sorting is left to the caller.
+func (e *EntryQueryBuilder) WithSavedSearch(savedSearch *model.SavedSearch) *EntryQueryBuilder {
+	e.withSearchQuery(savedSearch.SearchQuery, false)
+
+	if savedSearch.Starred {
+		e.WithStarred(true)
+	}
+
+	if len(savedSearch.CategoryIDs) > 0 {
+		e.conditions = append(e.conditions, fmt.Sprintf("f.category_id = ANY($%d)", len(e.args)+1))
+		e.args = append(e.args, pq.Int64Array(savedSearch.CategoryIDs))
+	}
+
+	if len(savedSearch.FeedIDs) > 0 {
+		e.conditions = append(e.conditions, fmt.Sprintf("e.feed_id = ANY($%d)", len(e.args)+1))
+		e.args = append(e.args, pq.Int64Array(savedSearch.FeedIDs))
+	}
+
+	if publishedAfter := savedSearch.PublishedAfter(time.Now()); !publishedAfter.IsZero() {
+		e.AfterPublishedDate(publishedAfter)
+	}
+
+	e.WithStatuses(savedSearch.Statuses...)
+	e.WithTags(savedSearch.Tags...)
+	return e
+}
+
 // WithStatuses filter by a list of entry statuses.
 func (e *EntryQueryBuilder) WithStatuses(statuses ...string) *EntryQueryBuilder {
 	if len(statuses) == 1 {
@@ -231,6 +389,19 @@ func (e *EntryQueryBuilder) WithGloballyVisible() *EntryQueryBuilder {
 
 // CountEntries count the number of entries that match the condition.
 func (e *EntryQueryBuilder) CountEntries() (count int, err error) {
+	if err := e.applyFuzzySearch(); err != nil {
+		return 0, err
+	}
+
+	// The full-text search results were already counted when they are enough to skip the fuzzy search.
+	if e.fuzzySearch != nil && e.fuzzySearch.fullTextCount >= fuzzySearchMinEntries {
+		return e.fuzzySearch.fullTextCount, nil
+	}
+
+	return e.countEntries()
+}
+
+func (e *EntryQueryBuilder) countEntries() (count int, err error) {
 	query := `
 		SELECT count(*)
 		FROM entries e
@@ -263,6 +434,27 @@ func (e *EntryQueryBuilder) GetEntry() (*model.Entry, error) {
 		return nil, err
 	}
 
+	if e.fetchTranscript {
+		entries[0].Transcript, err = e.store.EntryTranscript(entries[0].ID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if e.fetchDuplicates {
+		entries[0].Duplicates, err = e.store.EntryDuplicates(entries[0].UserID, entries[0].ID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if e.fetchRevisions {
+		entries[0].Revisions, err = e.store.EntryRevisions(entries[0].ID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return entries[0], nil
 }
 
@@ -295,6 +487,10 @@ func (e *EntryQueryBuilder) GetEntriesWithCount() (model.Entries, int, error) {
 // When withCount is true, count(*) OVER() is included in the SELECT and the total
 // count of matching rows is returned; otherwise the returned count is 0.
 func (e *EntryQueryBuilder) fetchEntries(withCount bool) (model.Entries, int, error) {
+	if err := e.applyFuzzySearch(); err != nil {
+		return nil, 0, err
+	}
+
 	countColumn := ""
 	if withCount {
 		countColumn = "count(*) OVER(),"
@@ -321,6 +517,12 @@ func (e *EntryQueryBuilder) fetchEntries(withCount bool) (model.Entries, int, er
 			e.changed_at,
 			e.tags,
 			e.language,
+			e.podcast,
+			e.thumbnail_url,
+			e.thumbnail_width,
+			e.thumbnail_height,
+			coalesce(e.duplicate_of_id, 0),
+			coalesce(e.story_id, 0),
 			f.title as feed_title,
 			f.feed_url,
 			f.site_url,
@@ -341,6 +543,7 @@ func (e *EntryQueryBuilder) fetchEntries(withCount bool) (model.Entries, int, er
 			fi.icon_id,
 			i.external_id AS icon_external_id,
 			u.timezone
+			` + e.snippetColumns() + `
 		FROM
 			entries e
 		INNER JOIN
@@ -371,6 +574,8 @@ func (e *EntryQueryBuilder) fetchEntries(withCount bool) (model.Entries, int, er
 		var iconID sql.NullInt64
 		var externalIconID sql.NullString
 		var tz string
+		var podcastMetadata []byte
+		var titleSnippet, contentSnippet string
 
 		entry := model.NewEntry()
 
@@ -393,6 +598,12 @@ func (e *EntryQueryBuilder) fetchEntries(withCount bool) (model.Entries, int, er
 			&entry.ChangedAt,
 			pq.Array(&entry.Tags),
 			&entry.Language,
+			&podcastMetadata,
+			&entry.ThumbnailURL,
+			&entry.ThumbnailWidth,
+			&entry.ThumbnailHeight,
+			&entry.DuplicateOfID,
+			&entry.StoryID,
 			&entry.Feed.Title,
 			&entry.Feed.FeedURL,
 			&entry.Feed.SiteURL,
@@ -419,11 +630,20 @@ func (e *EntryQueryBuilder) fetchEntries(withCount bool) (model.Entries, int, er
 			dest = append([]any{&totalCount}, dest...)
 		}
 
+		if e.hasSnippets() {
+			dest = append(dest, &titleSnippet, &contentSnippet)
+		}
+
 		err := rows.Scan(dest...)
 		if err != nil {
 			return nil, 0, fmt.Errorf("store: unable to fetch entry row: %v", err)
 		}
 
+		entry.Podcast, err = model.UnmarshalPodcastMetadata(podcastMetadata)
+		if err != nil {
+			return nil, 0, fmt.Errorf("store: unable to deserialize podcast metadata: %v", err)
+		}
+
 		if iconID.Valid && externalIconID.Valid && externalIconID.String != "" {
 			entry.Feed.Icon.FeedID = entry.FeedID
 			entry.Feed.Icon.IconID = iconID.Int64
@@ -432,6 +652,13 @@ func (e *EntryQueryBuilder) fetchEntries(withCount bool) (model.Entries, int, er
 			entry.Feed.Icon.IconID = 0
 		}
 
+		if e.hasSnippets() {
+			entry.SearchSnippet = &model.SearchSnippet{
+				Title:   formatSearchHeadline(titleSnippet, false),
+				Content: formatSearchHeadline(contentSnippet, true),
+			}
+		}
+
 		// Make sure that timestamp fields contain timezone information (API)
 		entry.Date = timezone.Convert(tz, entry.Date)
 		entry.CreatedAt = timezone.Convert(tz, entry.CreatedAt)
@@ -461,11 +688,54 @@ func (e *EntryQueryBuilder) fetchEntries(withCount bool) (model.Entries, int, er
 		}
 	}
 
+	if e.fetchLabels && len(entryIDs) > 0 {
+		labels, err := e.store.labelsByEntryIDs(entryIDs)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		for entryID, entryLabels := range labels {
+			if entry, exists := entryMap[entryID]; exists {
+				entry.Labels = entryLabels
+			}
+		}
+	}
+
+	if e.fetchHighlights && len(entryIDs) > 0 {
+		highlights, err := e.store.highlightsByEntryIDs(entryIDs)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		for entryID, entryHighlights := range highlights {
+			if entry, exists := entryMap[entryID]; exists {
+				entry.Highlights = entryHighlights
+			}
+		}
+	}
+
+	if e.groupByStory && len(entryIDs) > 0 {
+		storyEntries, err := e.store.storyEntriesByEntryIDs(entryIDs)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		for entryID, entries := range storyEntries {
+			if entry, exists := entryMap[entryID]; exists {
+				entry.StoryEntries = entries
+			}
+		}
+	}
+
 	return entries, totalCount, nil
 }
 
 // GetEntryIDs returns a list of entry IDs that match the condition.
 func (e *EntryQueryBuilder) GetEntryIDs() ([]int64, error) {
+	if err := e.applyFuzzySearch(); err != nil {
+		return nil, err
+	}
+
 	query := `
 		SELECT
 			e.id
@@ -519,8 +789,46 @@ func (e *EntryQueryBuilder) contentColumn() string {
 	return "e.content"
 }
 
+func (e *EntryQueryBuilder) hasSnippets() bool {
+	return e.fetchSnippets && e.snippetArgIndex > 0
+}
+
+// snippetColumns returns the title and content headlines of the search query, if requested.
+// The HTML tags are removed from the content before looking for the best fragments.
+func (e *EntryQueryBuilder) snippetColumns() string {
+	if !e.hasSnippets() {
+		return ""
+	}
+
+	return fmt.Sprintf(`,
+		ts_headline(%[3]s, e.title, websearch_to_tsquery(%[3]s, $%[1]d), %[2]s || ', HighlightAll=true'),
+		ts_headline(%[3]s, regexp_replace(e.content, '<[^>]*>', ' ', 'g'), websearch_to_tsquery(%[3]s, $%[1]d), %[2]s || ', MaxFragments=2, MinWords=15, MaxWords=35')`,
+		e.snippetArgIndex,
+		searchHeadlineSelectors,
+		textSearchConfigExpression,
+	)
+}
+
 func (e *EntryQueryBuilder) buildCondition() string {
-	return strings.Join(e.conditions, " AND ")
+	condition := strings.Join(e.conditions, " AND ")
+
+	// The first entry of each story is selected among the entries matching the same conditions.
+	if e.groupByStory {
+		condition += ` AND e.id IN (
+			SELECT ranked.id FROM (
+				SELECT
+					e.id,
+					row_number() OVER (PARTITION BY coalesce(e.story_id, e.id) ORDER BY e.created_at ASC, e.id ASC) AS story_rank
+				FROM entries e
+					JOIN feeds f ON f.id = e.feed_id
+					JOIN categories c ON c.id = f.category_id
+				WHERE ` + condition + `
+			) ranked
+			WHERE ranked.story_rank = 1
+		)`
+	}
+
+	return condition
 }
 
 func (e *EntryQueryBuilder) buildSorting() string {
@@ -0,0 +1,120 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package storage // import "miniflux.app/v2/internal/storage"
+
+import (
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"miniflux.app/v2/internal/config"
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/reader/sanitizer"
+	"miniflux.app/v2/internal/reader/textdiff"
+)
+
+// EntryRevisions returns the previous versions of an entry, the most recent first.
+func (s *Storage) EntryRevisions(entryID int64) (model.EntryRevisions, error) {
+	query := `
+		SELECT
+			id,
+			entry_id,
+			title,
+			content,
+			created_at
+		FROM
+			entry_revisions
+		WHERE
+			entry_id=$1
+		ORDER BY
+			created_at DESC, id DESC
+	`
+
+	rows, err := s.db.Query(query, entryID)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch entry revisions: %v`, err)
+	}
+	defer rows.Close()
+
+	var revisions model.EntryRevisions
+	for rows.Next() {
+		var revision model.EntryRevision
+		if err := rows.Scan(&revision.ID, &revision.EntryID, &revision.Title, &revision.Content, &revision.CreatedAt); err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch entry revision row: %v`, err)
+		}
+		revisions = append(revisions, &revision)
+	}
+
+	return revisions, nil
+}
+
+// recordEntryChange keeps the previous version of an updated entry when its text changed,
+// and marks the entry as unread when the change reaches the threshold of the feed.
+// Changes limited to the markup of the content are ignored.
+func (s *Storage) recordEntryChange(tx *sql.Tx, entry *model.Entry, previousTitle, previousContent string, markUnreadOnChangeThreshold int) error {
+	previousText := entryRevisionText(previousTitle, previousContent)
+	currentText := entryRevisionText(entry.Title, entry.Content)
+	if previousText == currentText {
+		return nil
+	}
+
+	if limit := config.Opts.EntryRevisionsLimit(); limit > 0 {
+		if _, err := tx.Exec(
+			`INSERT INTO entry_revisions (entry_id, title, content) VALUES ($1, $2, $3)`,
+			entry.ID,
+			previousTitle,
+			previousContent,
+		); err != nil {
+			return fmt.Errorf(`store: unable to create revision of entry #%d: %v`, entry.ID, err)
+		}
+
+		query := `
+			DELETE FROM
+				entry_revisions
+			WHERE
+				entry_id=$1 AND id NOT IN (
+					SELECT id FROM entry_revisions WHERE entry_id=$1 ORDER BY created_at DESC, id DESC LIMIT $2
+				)
+		`
+		if _, err := tx.Exec(query, entry.ID, limit); err != nil {
+			return fmt.Errorf(`store: unable to remove old revisions of entry #%d: %v`, entry.ID, err)
+		}
+	}
+
+	if markUnreadOnChangeThreshold <= 0 {
+		return nil
+	}
+
+	changeRatio := textdiff.ChangeRatio(previousText, currentText)
+	if changeRatio < markUnreadOnChangeThreshold {
+		return nil
+	}
+
+	result, err := tx.Exec(
+		`UPDATE entries SET status=$1, changed_at=now() WHERE id=$2 AND status=$3`,
+		model.EntryStatusUnread,
+		entry.ID,
+		model.EntryStatusRead,
+	)
+	if err != nil {
+		return fmt.Errorf(`store: unable to mark entry #%d as unread: %v`, entry.ID, err)
+	}
+
+	if count, _ := result.RowsAffected(); count > 0 {
+		slog.Debug("Entry marked as unread after an update",
+			slog.Int64("user_id", entry.UserID),
+			slog.Int64("feed_id", entry.FeedID),
+			slog.Int64("entry_id", entry.ID),
+			slog.Int("change_ratio", changeRatio),
+		)
+	}
+
+	return nil
+}
+
+// entryRevisionText returns the text compared between two versions of an entry, with normalized whitespace.
+func entryRevisionText(title, content string) string {
+	return strings.Join(strings.Fields(title+" "+sanitizer.StripTags(content)), " ")
+}
@@ -0,0 +1,17 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import "testing"
+
+func TestEntryRevisionText(t *testing.T) {
+	text := entryRevisionText("Security  advisory", "<p>Update <a href=\"https://example.org/?utm=1\">now</a>.</p>\n<p>Thanks</p>")
+	if text != "Security advisory Update now. Thanks" {
+		t.Errorf(`Unexpected text, got %q`, text)
+	}
+
+	if entryRevisionText("Title", `<img src="a.png">Text`) != entryRevisionText("Title", `<img src="b.png"> Text`) {
+		t.Error(`Changes limited to the markup should not change the text`)
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"sort"
 	"time"
 
+	"github.com/lib/pq"
 	"miniflux.app/v2/internal/config"
 	"miniflux.app/v2/internal/model"
 )
@@ -256,10 +257,19 @@ func (s *Storage) CreateFeed(feed *model.Feed) error {
 			description,
 			proxy_url,
 			ignore_entry_updates,
-			language
+			language,
+			skip_hours,
+			skip_days,
+			update_period,
+			page_item_selector,
+			page_title_selector,
+			page_link_selector,
+			page_date_selector,
+			page_content_selector,
+			unsubscribe_url
 		)
 		VALUES
-			($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24, $25, $26, $27, $28, $29, $30, $31, $32)
+			($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24, $25, $26, $27, $28, $29, $30, $31, $32, $33, $34, $35, $36, $37, $38, $39, $40, $41)
 		RETURNING
 			id
 	`
@@ -297,6 +307,15 @@ func (s *Storage) CreateFeed(feed *model.Feed) error {
 		feed.ProxyURL,
 		feed.IgnoreEntryUpdates,
 		feed.Language,
+		int64ArrayOrEmpty(feed.SkipHours),
+		int64ArrayOrEmpty(feed.SkipDays),
+		int(feed.UpdatePeriod.Minutes()),
+		feed.PageItemSelector,
+		feed.PageTitleSelector,
+		feed.PageLinkSelector,
+		feed.PageDateSelector,
+		feed.PageContentSelector,
+		feed.UnsubscribeURL,
 	).Scan(&feed.ID)
 	if err != nil {
 		return fmt.Errorf(`store: unable to create feed %q: %v`, feed.FeedURL, err)
@@ -381,9 +400,21 @@ func (s *Storage) UpdateFeed(feed *model.Feed) (err error) {
 			pushover_priority=$37,
 			proxy_url=$38,
 			ignore_entry_updates=$39,
-			language=$40
+			language=$40,
+			skip_hours=$41,
+			skip_days=$42,
+			update_period=$43,
+			permanent_redirect_url=$44,
+			permanent_redirect_count=$45,
+			page_item_selector=$46,
+			page_title_selector=$47,
+			page_link_selector=$48,
+			page_date_selector=$49,
+			page_content_selector=$50,
+			action_entry_rules=$51,
+			mark_unread_on_change_threshold=$52
 		WHERE
-			id=$41 AND user_id=$42
+			id=$53 AND user_id=$54
 	`
 	_, err = s.db.Exec(query,
 		feed.FeedURL,
@@ -426,6 +457,18 @@ func (s *Storage) UpdateFeed(feed *model.Feed) (err error) {
 		feed.ProxyURL,
 		feed.IgnoreEntryUpdates,
 		feed.Language,
+		int64ArrayOrEmpty(feed.SkipHours),
+		int64ArrayOrEmpty(feed.SkipDays),
+		int(feed.UpdatePeriod.Minutes()),
+		feed.PermanentRedirectURL,
+		feed.PermanentRedirectCount,
+		feed.PageItemSelector,
+		feed.PageTitleSelector,
+		feed.PageLinkSelector,
+		feed.PageDateSelector,
+		feed.PageContentSelector,
+		feed.ActionEntryRules,
+		feed.MarkUnreadOnChangeThreshold,
 		feed.ID,
 		feed.UserID,
 	)
@@ -433,7 +476,7 @@ func (s *Storage) UpdateFeed(feed *model.Feed) (err error) {
 		return fmt.Errorf(`store: unable to update feed #%d (%s): %v`, feed.ID, feed.FeedURL, err)
 	}
 
-	return nil
+	return s.removeStaleFeedFilterRuleStats(feed)
 }
 
 // UpdateFeedError persists the parsing error fields for the given feed.
@@ -483,3 +526,11 @@ func (s *Storage) ResetNextCheckAt() error {
 	_, err := s.db.Exec(`UPDATE feeds SET next_check_at=now()`)
 	return err
 }
+
+// int64ArrayOrEmpty returns an empty array for nil slices, lib/pq would send NULL to the non-nullable array columns.
+func int64ArrayOrEmpty(values []int64) any {
+	if values == nil {
+		values = []int64{}
+	}
+	return pq.Array(values)
+}
@@ -0,0 +1,119 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package storage // import "miniflux.app/v2/internal/storage"
+
+import (
+	"fmt"
+	"time"
+
+	"miniflux.app/v2/internal/model"
+)
+
+// CreateFeedFetch records a refresh attempt of a feed.
+func (s *Storage) CreateFeedFetch(fetch *model.FeedFetch) error {
+	query := `
+		INSERT INTO feed_fetches
+			(user_id, feed_id, status_code, duration, response_size, cache_hit, new_entries_count, updated_entries_count, error_msg, redirect_url, feed_url_updated)
+		VALUES
+			($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		RETURNING
+			id, fetched_at
+	`
+	err := s.db.QueryRow(
+		query,
+		fetch.UserID,
+		fetch.FeedID,
+		fetch.StatusCode,
+		fetch.Duration,
+		fetch.ResponseSize,
+		fetch.CacheHit,
+		fetch.NewEntriesCount,
+		fetch.UpdatedEntriesCount,
+		fetch.ErrorMsg,
+		fetch.RedirectURL,
+		fetch.FeedURLUpdated,
+	).Scan(&fetch.ID, &fetch.FetchedAt)
+	if err != nil {
+		return fmt.Errorf(`store: unable to create feed fetch for feed #%d: %v`, fetch.FeedID, err)
+	}
+
+	return nil
+}
+
+// FeedFetches returns the most recent refresh attempts of a feed.
+func (s *Storage) FeedFetches(userID, feedID int64, limit int) (model.FeedFetches, error) {
+	query := `
+		SELECT
+			id,
+			user_id,
+			feed_id,
+			fetched_at,
+			status_code,
+			duration,
+			response_size,
+			cache_hit,
+			new_entries_count,
+			updated_entries_count,
+			error_msg,
+			redirect_url,
+			feed_url_updated
+		FROM
+			feed_fetches
+		WHERE
+			user_id=$1 AND feed_id=$2
+		ORDER BY fetched_at DESC, id DESC
+		LIMIT $3
+	`
+	rows, err := s.db.Query(query, userID, feedID, limit)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch feed fetches: %v`, err)
+	}
+	defer rows.Close()
+
+	fetches := make(model.FeedFetches, 0)
+	for rows.Next() {
+		var fetch model.FeedFetch
+		if err := rows.Scan(
+			&fetch.ID,
+			&fetch.UserID,
+			&fetch.FeedID,
+			&fetch.FetchedAt,
+			&fetch.StatusCode,
+			&fetch.Duration,
+			&fetch.ResponseSize,
+			&fetch.CacheHit,
+			&fetch.NewEntriesCount,
+			&fetch.UpdatedEntriesCount,
+			&fetch.ErrorMsg,
+			&fetch.RedirectURL,
+			&fetch.FeedURLUpdated,
+		); err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch feed fetch row: %v`, err)
+		}
+
+		fetches = append(fetches, &fetch)
+	}
+
+	return fetches, nil
+}
+
+// CleanOldFeedFetches removes the feed refresh attempts older than the given interval.
+func (s *Storage) CleanOldFeedFetches(interval time.Duration) (int64, error) {
+	query := `
+		DELETE FROM
+			feed_fetches
+		WHERE
+			fetched_at < now() - $1::interval
+	`
+
+	days := max(int(interval/(24*time.Hour)), 1)
+
+	result, err := s.db.Exec(query, fmt.Sprintf("%d days", days))
+	if err != nil {
+		return 0, fmt.Errorf(`store: unable to clean old feed fetches: %v`, err)
+	}
+
+	n, _ := result.RowsAffected()
+	return n, nil
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/lib/pq"
 	"miniflux.app/v2/internal/model"
@@ -159,6 +160,7 @@ func (f *feedQueryBuilder) GetFeeds() (model.Feeds, error) {
 			f.keeplist_rules,
 			f.block_filter_entry_rules,
 			f.keep_filter_entry_rules,
+			f.action_entry_rules,
 			f.crawler,
 			f.user_agent,
 			f.cookie,
@@ -185,7 +187,19 @@ func (f *feedQueryBuilder) GetFeeds() (model.Feeds, error) {
 			f.pushover_enabled,
 			f.pushover_priority,
 			f.proxy_url,
-			f.ignore_entry_updates
+			f.ignore_entry_updates,
+			f.mark_unread_on_change_threshold,
+			f.skip_hours,
+			f.skip_days,
+			f.update_period,
+			f.permanent_redirect_url,
+			f.permanent_redirect_count,
+			f.page_item_selector,
+			f.page_title_selector,
+			f.page_link_selector,
+			f.page_date_selector,
+			f.page_content_selector,
+			f.unsubscribe_url
 		FROM
 			feeds f
 		LEFT JOIN
@@ -219,6 +233,7 @@ func (f *feedQueryBuilder) GetFeeds() (model.Feeds, error) {
 		var iconID sql.NullInt64
 		var externalIconID sql.NullString
 		var tz string
+		var updatePeriodInMinutes int
 		feed.Category = &model.Category{}
 
 		err := rows.Scan(
@@ -242,6 +257,7 @@ func (f *feedQueryBuilder) GetFeeds() (model.Feeds, error) {
 			&feed.KeeplistRules,
 			&feed.BlockFilterEntryRules,
 			&feed.KeepFilterEntryRules,
+			&feed.ActionEntryRules,
 			&feed.Crawler,
 			&feed.UserAgent,
 			&feed.Cookie,
@@ -269,11 +285,25 @@ func (f *feedQueryBuilder) GetFeeds() (model.Feeds, error) {
 			&feed.PushoverPriority,
 			&feed.ProxyURL,
 			&feed.IgnoreEntryUpdates,
+			&feed.MarkUnreadOnChangeThreshold,
+			pq.Array(&feed.SkipHours),
+			pq.Array(&feed.SkipDays),
+			&updatePeriodInMinutes,
+			&feed.PermanentRedirectURL,
+			&feed.PermanentRedirectCount,
+			&feed.PageItemSelector,
+			&feed.PageTitleSelector,
+			&feed.PageLinkSelector,
+			&feed.PageDateSelector,
+			&feed.PageContentSelector,
+			&feed.UnsubscribeURL,
 		)
 		if err != nil {
 			return nil, fmt.Errorf(`store: unable to fetch feeds row: %w`, err)
 		}
 
+		feed.UpdatePeriod = time.Duration(updatePeriodInMinutes) * time.Minute
+
 		if iconID.Valid && externalIconID.Valid {
 			feed.Icon = &model.FeedIcon{FeedID: feed.ID, IconID: iconID.Int64, ExternalIconID: externalIconID.String}
 		} else {
@@ -0,0 +1,31 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"database/sql/driver"
+	"testing"
+)
+
+func TestInt64ArrayOrEmpty(t *testing.T) {
+	scenarios := []struct {
+		values   []int64
+		expected string
+	}{
+		{nil, "{}"},
+		{[]int64{}, "{}"},
+		{[]int64{1, 23}, "{1,23}"},
+	}
+
+	for _, scenario := range scenarios {
+		value, err := int64ArrayOrEmpty(scenario.values).(driver.Valuer).Value()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if value != scenario.expected {
+			t.Errorf(`Unexpected value for %v, got %v instead of %q`, scenario.values, value, scenario.expected)
+		}
+	}
+}
@@ -0,0 +1,159 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package storage // import "miniflux.app/v2/internal/storage"
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"miniflux.app/v2/internal/model"
+)
+
+const filterApplyJobColumns = `
+	id,
+	user_id,
+	feed_id,
+	category_id,
+	outcome,
+	status,
+	entries_count,
+	blocked_count,
+	error_msg,
+	created_at,
+	finished_at
+`
+
+// CreateFilterApplyJob records a new running job applying the filter rules.
+// Only the latest job of each scope is kept, the finished jobs of the same scope are removed.
+func (s *Storage) CreateFilterApplyJob(userID int64, request *model.FilterApplyRequest) (*model.FilterApplyJob, error) {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to start transaction: %v`, err)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.Exec(
+		`DELETE FROM filter_apply_jobs WHERE user_id=$1 AND feed_id=$2 AND category_id=$3 AND status<>$4`,
+		userID,
+		request.FeedID,
+		request.CategoryID,
+		model.FilterApplyJobRunning,
+	)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to remove previous filter apply jobs: %v`, err)
+	}
+
+	query := `
+		INSERT INTO filter_apply_jobs
+			(user_id, feed_id, category_id, outcome, status)
+		VALUES
+			($1, $2, $3, $4, $5)
+		RETURNING
+	` + filterApplyJobColumns
+
+	job, err := scanFilterApplyJob(tx.QueryRow(query, userID, request.FeedID, request.CategoryID, request.Outcome, model.FilterApplyJobRunning))
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to create filter apply job: %v`, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf(`store: unable to commit transaction: %v`, err)
+	}
+
+	return job, nil
+}
+
+// UpdateFilterApplyJob stores the progress and the status of the job.
+func (s *Storage) UpdateFilterApplyJob(job *model.FilterApplyJob) error {
+	query := `
+		UPDATE
+			filter_apply_jobs
+		SET
+			status=$1,
+			entries_count=$2,
+			blocked_count=$3,
+			error_msg=$4,
+			finished_at=CASE WHEN $1 = 'running' THEN NULL ELSE now() END
+		WHERE
+			id=$5
+		RETURNING
+			finished_at
+	`
+	err := s.db.QueryRow(query, job.Status, job.EntriesCount, job.BlockedCount, job.Error, job.ID).Scan(&job.FinishedAt)
+	if err != nil {
+		return fmt.Errorf(`store: unable to update filter apply job #%d: %v`, job.ID, err)
+	}
+
+	return nil
+}
+
+// FilterApplyJobByID returns the job with the given ID, or nil if the user has no such job.
+func (s *Storage) FilterApplyJobByID(userID, jobID int64) (*model.FilterApplyJob, error) {
+	query := `SELECT ` + filterApplyJobColumns + ` FROM filter_apply_jobs WHERE user_id=$1 AND id=$2`
+	return s.fetchFilterApplyJob(query, userID, jobID)
+}
+
+// RunningFilterApplyJob returns the job currently applying the filter rules for the user, or nil if there is none.
+func (s *Storage) RunningFilterApplyJob(userID int64) (*model.FilterApplyJob, error) {
+	query := `SELECT ` + filterApplyJobColumns + ` FROM filter_apply_jobs WHERE user_id=$1 AND status=$2 ORDER BY id DESC LIMIT 1`
+	return s.fetchFilterApplyJob(query, userID, model.FilterApplyJobRunning)
+}
+
+// LatestFilterApplyJob returns the latest job of the given scope, a feed, a category or the whole account when both IDs are zero.
+func (s *Storage) LatestFilterApplyJob(userID, feedID, categoryID int64) (*model.FilterApplyJob, error) {
+	query := `
+		SELECT ` + filterApplyJobColumns + `
+		FROM filter_apply_jobs
+		WHERE user_id=$1 AND feed_id=$2 AND category_id=$3
+		ORDER BY id DESC
+		LIMIT 1
+	`
+	return s.fetchFilterApplyJob(query, userID, feedID, categoryID)
+}
+
+// InterruptFilterApplyJobs marks the jobs left running by a previous process as failed.
+func (s *Storage) InterruptFilterApplyJobs() error {
+	query := `UPDATE filter_apply_jobs SET status=$1, error_msg=$2, finished_at=now() WHERE status=$3`
+	if _, err := s.db.Exec(query, model.FilterApplyJobFailed, "interrupted", model.FilterApplyJobRunning); err != nil {
+		return fmt.Errorf(`store: unable to interrupt filter apply jobs: %v`, err)
+	}
+
+	return nil
+}
+
+func (s *Storage) fetchFilterApplyJob(query string, args ...any) (*model.FilterApplyJob, error) {
+	job, err := scanFilterApplyJob(s.db.QueryRow(query, args...))
+
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf(`store: unable to fetch filter apply job: %v`, err)
+	default:
+		return job, nil
+	}
+}
+
+func scanFilterApplyJob(row *sql.Row) (*model.FilterApplyJob, error) {
+	var job model.FilterApplyJob
+	err := row.Scan(
+		&job.ID,
+		&job.UserID,
+		&job.FeedID,
+		&job.CategoryID,
+		&job.Outcome,
+		&job.Status,
+		&job.EntriesCount,
+		&job.BlockedCount,
+		&job.Error,
+		&job.CreatedAt,
+		&job.FinishedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &job, nil
+}
@@ -0,0 +1,109 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package storage // import "miniflux.app/v2/internal/storage"
+
+import (
+	"fmt"
+
+	"github.com/lib/pq"
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/reader/filter"
+)
+
+// UpdateFilterRuleStats adds the given hit counts to the stored filter rule statistics.
+// The counts of users and feeds removed in the meantime are ignored.
+func (s *Storage) UpdateFilterRuleStats(stats model.FilterRuleStats) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf(`store: unable to start transaction: %v`, err)
+	}
+
+	query := `
+		INSERT INTO filter_rule_stats
+			(user_id, feed_id, rule, hit_count, last_matched_at)
+		SELECT
+			$1, NULLIF($2::bigint, 0), $3, $4, $5
+		WHERE
+			EXISTS (SELECT 1 FROM users WHERE id=$1) AND
+			($2::bigint = 0 OR EXISTS (SELECT 1 FROM feeds WHERE id=$2 AND user_id=$1))
+		ON CONFLICT (user_id, (coalesce(feed_id, 0)), rule) DO UPDATE SET
+			hit_count=filter_rule_stats.hit_count + EXCLUDED.hit_count,
+			last_matched_at=greatest(filter_rule_stats.last_matched_at, EXCLUDED.last_matched_at)
+	`
+
+	for _, stat := range stats {
+		if _, err := tx.Exec(query, stat.UserID, stat.FeedID, stat.Rule, stat.HitCount, stat.LastMatchedAt); err != nil {
+			tx.Rollback()
+			return fmt.Errorf(`store: unable to update filter rule statistics: %v`, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf(`store: unable to commit filter rule statistics: %v`, err)
+	}
+
+	return nil
+}
+
+// FilterRuleStats returns the statistics of the user and feed filter rules of a user.
+func (s *Storage) FilterRuleStats(userID int64) (model.FilterRuleStats, error) {
+	query := `
+		SELECT
+			user_id,
+			coalesce(feed_id, 0),
+			rule,
+			hit_count,
+			last_matched_at
+		FROM
+			filter_rule_stats
+		WHERE
+			user_id=$1
+		ORDER BY
+			feed_id NULLS FIRST, hit_count DESC, rule ASC
+	`
+
+	rows, err := s.db.Query(query, userID)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch filter rule statistics: %v`, err)
+	}
+	defer rows.Close()
+
+	stats := make(model.FilterRuleStats, 0)
+	for rows.Next() {
+		var stat model.FilterRuleStat
+		if err := rows.Scan(&stat.UserID, &stat.FeedID, &stat.Rule, &stat.HitCount, &stat.LastMatchedAt); err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch filter rule statistics row: %v`, err)
+		}
+		stats = append(stats, &stat)
+	}
+
+	return stats, nil
+}
+
+// removeStaleUserFilterRuleStats deletes the statistics of the user filter rules that were edited or removed.
+func (s *Storage) removeStaleUserFilterRuleStats(user *model.User) error {
+	rules := filter.NormalizeRules(user.BlockFilterEntryRules, user.KeepFilterEntryRules)
+	query := `DELETE FROM filter_rule_stats WHERE user_id=$1 AND feed_id IS NULL AND rule <> ALL($2)`
+	if _, err := s.db.Exec(query, user.ID, pq.Array(rules)); err != nil {
+		return fmt.Errorf(`store: unable to remove stale filter rule statistics: %v`, err)
+	}
+	return nil
+}
+
+// removeStaleFeedFilterRuleStats deletes the statistics of the feed filter rules that were edited or removed.
+// The regex rules are recorded as a whole.
+func (s *Storage) removeStaleFeedFilterRuleStats(feed *model.Feed) error {
+	rules := filter.NormalizeRules(feed.BlockFilterEntryRules, feed.KeepFilterEntryRules)
+	for _, regexRule := range []string{feed.BlocklistRules, feed.KeeplistRules} {
+		if regexRule != "" {
+			rules = append(rules, regexRule)
+		}
+	}
+
+	query := `DELETE FROM filter_rule_stats WHERE user_id=$1 AND feed_id=$2 AND rule <> ALL($3)`
+	if _, err := s.db.Exec(query, feed.UserID, feed.ID, pq.Array(rules)); err != nil {
+		return fmt.Errorf(`store: unable to remove stale filter rule statistics: %v`, err)
+	}
+	return nil
+}
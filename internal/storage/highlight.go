@@ -0,0 +1,255 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package storage // import "miniflux.app/v2/internal/storage"
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/lib/pq"
+	"miniflux.app/v2/internal/model"
+)
+
+const highlightColumns = `
+	h.id,
+	h.user_id,
+	h.entry_id,
+	h.quote,
+	h.prefix,
+	h.suffix,
+	h.start_offset,
+	h.end_offset,
+	h.note,
+	h.created_at,
+	h.changed_at
+`
+
+// Highlight returns a highlight from the database.
+func (s *Storage) Highlight(userID, highlightID int64) (*model.Highlight, error) {
+	query := `SELECT ` + highlightColumns + ` FROM highlights h WHERE h.user_id=$1 AND h.id=$2`
+	highlight, err := scanHighlight(s.db.QueryRow(query, userID, highlightID))
+
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf(`store: unable to fetch highlight: %v`, err)
+	default:
+		return highlight, nil
+	}
+}
+
+// EntryHighlights returns the highlights of an entry, in the order of the text.
+func (s *Storage) EntryHighlights(userID, entryID int64) (model.Highlights, error) {
+	query := `
+		SELECT ` + highlightColumns + `
+		FROM
+			highlights h
+		WHERE
+			h.user_id=$1 AND h.entry_id=$2
+		ORDER BY
+			h.start_offset ASC, h.id ASC
+	`
+
+	rows, err := s.db.Query(query, userID, entryID)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch entry highlights: %v`, err)
+	}
+	defer rows.Close()
+
+	highlights := make(model.Highlights, 0)
+	for rows.Next() {
+		highlight, err := scanHighlight(rows)
+		if err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch highlight row: %v`, err)
+		}
+		highlights = append(highlights, highlight)
+	}
+
+	return highlights, nil
+}
+
+// Highlights returns the highlights of the user across all entries, the most recent first.
+// A zero limit returns all the highlights.
+func (s *Storage) Highlights(userID int64, limit, offset int) (model.Highlights, error) {
+	query := `
+		SELECT ` + highlightColumns + `,
+			e.title,
+			e.url,
+			f.id,
+			f.title
+		FROM
+			highlights h
+		INNER JOIN
+			entries e ON e.id=h.entry_id
+		INNER JOIN
+			feeds f ON f.id=e.feed_id
+		WHERE
+			h.user_id=$1
+		ORDER BY
+			h.created_at DESC, h.id DESC
+		OFFSET $2
+	`
+	args := []any{userID, offset}
+	if limit > 0 {
+		query += ` LIMIT $3`
+		args = append(args, limit)
+	}
+
+	rows, err := s.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch highlights: %v`, err)
+	}
+	defer rows.Close()
+
+	highlights := make(model.Highlights, 0)
+	for rows.Next() {
+		var highlight model.Highlight
+		err := rows.Scan(
+			&highlight.ID,
+			&highlight.UserID,
+			&highlight.EntryID,
+			&highlight.Quote,
+			&highlight.Prefix,
+			&highlight.Suffix,
+			&highlight.StartOffset,
+			&highlight.EndOffset,
+			&highlight.Note,
+			&highlight.CreatedAt,
+			&highlight.ChangedAt,
+			&highlight.EntryTitle,
+			&highlight.EntryURL,
+			&highlight.FeedID,
+			&highlight.FeedTitle,
+		)
+		if err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch highlight row: %v`, err)
+		}
+		highlights = append(highlights, &highlight)
+	}
+
+	return highlights, nil
+}
+
+// CountHighlights returns the number of highlights of the user.
+func (s *Storage) CountHighlights(userID int64) (int, error) {
+	var count int
+	if err := s.db.QueryRow(`SELECT count(*) FROM highlights WHERE user_id=$1`, userID).Scan(&count); err != nil {
+		return 0, fmt.Errorf(`store: unable to count highlights: %v`, err)
+	}
+	return count, nil
+}
+
+// CreateHighlight creates a new highlight on an entry of the user.
+func (s *Storage) CreateHighlight(userID, entryID int64, request *model.HighlightCreationRequest) (*model.Highlight, error) {
+	query := `
+		INSERT INTO highlights AS h
+			(user_id, entry_id, quote, prefix, suffix, start_offset, end_offset, note)
+		VALUES
+			($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING ` + highlightColumns
+
+	highlight, err := scanHighlight(s.db.QueryRow(
+		query,
+		userID,
+		entryID,
+		request.Quote,
+		request.Prefix,
+		request.Suffix,
+		request.StartOffset,
+		request.EndOffset,
+		request.Note,
+	))
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to create highlight on entry #%d for user ID %d: %v`, entryID, userID, err)
+	}
+
+	return highlight, nil
+}
+
+// UpdateHighlight updates the note of an existing highlight.
+func (s *Storage) UpdateHighlight(highlight *model.Highlight) error {
+	query := `UPDATE highlights SET note=$1, changed_at=now() WHERE id=$2 AND user_id=$3 RETURNING changed_at`
+	if err := s.db.QueryRow(query, highlight.Note, highlight.ID, highlight.UserID).Scan(&highlight.ChangedAt); err != nil {
+		return fmt.Errorf(`store: unable to update highlight: %v`, err)
+	}
+
+	return nil
+}
+
+// RemoveHighlight deletes a highlight.
+func (s *Storage) RemoveHighlight(userID, highlightID int64) error {
+	query := `DELETE FROM highlights WHERE id=$1 AND user_id=$2`
+	result, err := s.db.Exec(query, highlightID, userID)
+	if err != nil {
+		return fmt.Errorf(`store: unable to remove this highlight: %v`, err)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf(`store: unable to remove this highlight: %v`, err)
+	}
+
+	if count == 0 {
+		return errors.New(`store: no highlight has been removed`)
+	}
+
+	return nil
+}
+
+// highlightsByEntryIDs returns the highlights of the given entries, indexed by entry ID.
+func (s *Storage) highlightsByEntryIDs(entryIDs []int64) (map[int64]model.Highlights, error) {
+	query := `
+		SELECT ` + highlightColumns + `
+		FROM
+			highlights h
+		WHERE
+			h.entry_id = ANY($1)
+		ORDER BY
+			h.start_offset ASC, h.id ASC
+	`
+
+	rows, err := s.db.Query(query, pq.Array(entryIDs))
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch entry highlights: %v`, err)
+	}
+	defer rows.Close()
+
+	highlightsMap := make(map[int64]model.Highlights)
+	for rows.Next() {
+		highlight, err := scanHighlight(rows)
+		if err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch entry highlight row: %v`, err)
+		}
+		highlightsMap[highlight.EntryID] = append(highlightsMap[highlight.EntryID], highlight)
+	}
+
+	return highlightsMap, nil
+}
+
+type highlightScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanHighlight(scanner highlightScanner) (*model.Highlight, error) {
+	var highlight model.Highlight
+	err := scanner.Scan(
+		&highlight.ID,
+		&highlight.UserID,
+		&highlight.EntryID,
+		&highlight.Quote,
+		&highlight.Prefix,
+		&highlight.Suffix,
+		&highlight.StartOffset,
+		&highlight.EndOffset,
+		&highlight.Note,
+		&highlight.CreatedAt,
+		&highlight.ChangedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &highlight, nil
+}
@@ -0,0 +1,346 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package storage // import "miniflux.app/v2/internal/storage"
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/lib/pq"
+	"miniflux.app/v2/internal/model"
+)
+
+// AnotherLabelExists checks if another label exists with the same title.
+func (s *Storage) AnotherLabelExists(userID, labelID int64, title string) bool {
+	var result bool
+	query := `SELECT true FROM labels WHERE user_id=$1 AND id != $2 AND lower(title)=lower($3) LIMIT 1`
+	s.db.QueryRow(query, userID, labelID, title).Scan(&result)
+	return result
+}
+
+// LabelTitleExists checks if the given label exists into the database.
+func (s *Storage) LabelTitleExists(userID int64, title string) bool {
+	var result bool
+	query := `SELECT true FROM labels WHERE user_id=$1 AND lower(title)=lower($2) LIMIT 1`
+	s.db.QueryRow(query, userID, title).Scan(&result)
+	return result
+}
+
+// Label returns a label from the database.
+func (s *Storage) Label(userID, labelID int64) (*model.Label, error) {
+	var label model.Label
+
+	query := `SELECT id, user_id, title FROM labels WHERE user_id=$1 AND id=$2`
+	err := s.db.QueryRow(query, userID, labelID).Scan(&label.ID, &label.UserID, &label.Title)
+
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf(`store: unable to fetch label: %v`, err)
+	default:
+		return &label, nil
+	}
+}
+
+// LabelByTitle finds a label by the title, ignoring the case.
+func (s *Storage) LabelByTitle(userID int64, title string) (*model.Label, error) {
+	var label model.Label
+
+	query := `SELECT id, user_id, title FROM labels WHERE user_id=$1 AND lower(title)=lower($2)`
+	err := s.db.QueryRow(query, userID, title).Scan(&label.ID, &label.UserID, &label.Title)
+
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf(`store: unable to fetch label: %v`, err)
+	default:
+		return &label, nil
+	}
+}
+
+// Labels returns all labels that belongs to the given user.
+func (s *Storage) Labels(userID int64) (model.Labels, error) {
+	query := `SELECT id, user_id, title FROM labels WHERE user_id=$1 ORDER BY lower(title) ASC`
+	rows, err := s.db.Query(query, userID)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch labels: %v`, err)
+	}
+	defer rows.Close()
+
+	labels := make(model.Labels, 0)
+	for rows.Next() {
+		var label model.Label
+		if err := rows.Scan(&label.ID, &label.UserID, &label.Title); err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch label row: %v`, err)
+		}
+
+		labels = append(labels, &label)
+	}
+
+	return labels, nil
+}
+
+// LabelsWithEntryCount returns all labels with the number of labeled entries and the number of unread ones.
+func (s *Storage) LabelsWithEntryCount(userID int64) (model.Labels, error) {
+	query := `
+		SELECT
+			l.id,
+			l.user_id,
+			l.title,
+			count(e.id),
+			count(e.id) FILTER (WHERE e.status=$2)
+		FROM
+			labels l
+		LEFT JOIN
+			entry_labels el ON el.label_id=l.id
+		LEFT JOIN
+			entries e ON e.id=el.entry_id
+		WHERE
+			l.user_id=$1
+		GROUP BY
+			l.id
+		ORDER BY
+			lower(l.title) ASC
+	`
+
+	rows, err := s.db.Query(query, userID, model.EntryStatusUnread)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch labels: %v`, err)
+	}
+	defer rows.Close()
+
+	labels := make(model.Labels, 0)
+	for rows.Next() {
+		var label model.Label
+		if err := rows.Scan(&label.ID, &label.UserID, &label.Title, &label.EntryCount, &label.TotalUnread); err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch label row: %v`, err)
+		}
+
+		labels = append(labels, &label)
+	}
+
+	return labels, nil
+}
+
+// CreateLabel creates a new label.
+func (s *Storage) CreateLabel(userID int64, request *model.LabelCreationRequest) (*model.Label, error) {
+	var label model.Label
+
+	query := `
+		INSERT INTO labels
+			(user_id, title)
+		VALUES
+			($1, $2)
+		RETURNING
+			id,
+			user_id,
+			title
+	`
+	err := s.db.QueryRow(query, userID, request.Title).Scan(&label.ID, &label.UserID, &label.Title)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to create label %q for user ID %d: %v`, request.Title, userID, err)
+	}
+
+	return &label, nil
+}
+
+// UpdateLabel updates an existing label.
+func (s *Storage) UpdateLabel(label *model.Label) error {
+	query := `UPDATE labels SET title=$1 WHERE id=$2 AND user_id=$3`
+	if _, err := s.db.Exec(query, label.Title, label.ID, label.UserID); err != nil {
+		return fmt.Errorf(`store: unable to update label: %v`, err)
+	}
+
+	return nil
+}
+
+// RemoveLabel deletes a label, the entries are only detached from it.
+func (s *Storage) RemoveLabel(userID, labelID int64) error {
+	query := `DELETE FROM labels WHERE id = $1 AND user_id = $2`
+	result, err := s.db.Exec(query, labelID, userID)
+	if err != nil {
+		return fmt.Errorf(`store: unable to remove this label: %v`, err)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf(`store: unable to remove this label: %v`, err)
+	}
+
+	if count == 0 {
+		return errors.New(`store: no label has been removed`)
+	}
+
+	return nil
+}
+
+// AttachLabel attaches a label to an entry, both must belong to the user.
+func (s *Storage) AttachLabel(userID, entryID, labelID int64) error {
+	query := `
+		INSERT INTO entry_labels
+			(entry_id, label_id)
+		SELECT
+			e.id, l.id
+		FROM
+			entries e, labels l
+		WHERE
+			e.id=$2 AND e.user_id=$1 AND l.id=$3 AND l.user_id=$1
+		ON CONFLICT DO NOTHING
+	`
+	if _, err := s.db.Exec(query, userID, entryID, labelID); err != nil {
+		return fmt.Errorf(`store: unable to attach label #%d to entry #%d: %v`, labelID, entryID, err)
+	}
+
+	return nil
+}
+
+// DetachLabel removes a label from an entry.
+func (s *Storage) DetachLabel(userID, entryID, labelID int64) error {
+	query := `
+		DELETE FROM entry_labels
+		WHERE
+			entry_id=$2 AND
+			label_id=(SELECT id FROM labels WHERE id=$3 AND user_id=$1)
+	`
+	if _, err := s.db.Exec(query, userID, entryID, labelID); err != nil {
+		return fmt.Errorf(`store: unable to detach label #%d from entry #%d: %v`, labelID, entryID, err)
+	}
+
+	return nil
+}
+
+// AttachLabelsByTitle attaches the labels to the entries of the user, missing labels are created.
+func (s *Storage) AttachLabelsByTitle(userID int64, entryIDs []int64, titles []string) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf(`store: unable to start transaction: %v`, err)
+	}
+
+	createQuery := `
+		INSERT INTO labels
+			(user_id, title)
+		SELECT
+			$1, title
+		FROM
+			unnest($2::text[]) AS title
+		ON CONFLICT (user_id, lower(title)) DO NOTHING
+	`
+	if _, err := tx.Exec(createQuery, userID, pq.Array(titles)); err != nil {
+		tx.Rollback()
+		return fmt.Errorf(`store: unable to create labels: %v`, err)
+	}
+
+	attachQuery := `
+		INSERT INTO entry_labels
+			(entry_id, label_id)
+		SELECT
+			e.id, l.id
+		FROM
+			entries e, labels l
+		WHERE
+			e.user_id=$1 AND e.id = ANY($2) AND
+			l.user_id=$1 AND lower(l.title) = ANY(SELECT lower(title) FROM unnest($3::text[]) AS title)
+		ON CONFLICT DO NOTHING
+	`
+	if _, err := tx.Exec(attachQuery, userID, pq.Array(entryIDs), pq.Array(titles)); err != nil {
+		tx.Rollback()
+		return fmt.Errorf(`store: unable to attach labels: %v`, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf(`store: unable to commit labels: %v`, err)
+	}
+
+	return nil
+}
+
+// DetachLabelsByTitle removes the labels from the entries of the user.
+func (s *Storage) DetachLabelsByTitle(userID int64, entryIDs []int64, titles []string) error {
+	query := `
+		DELETE FROM entry_labels
+		WHERE
+			entry_id = ANY($2) AND
+			label_id IN (
+				SELECT id FROM labels
+				WHERE user_id=$1 AND lower(title) = ANY(SELECT lower(title) FROM unnest($3::text[]) AS title)
+			)
+	`
+	if _, err := s.db.Exec(query, userID, pq.Array(entryIDs), pq.Array(titles)); err != nil {
+		return fmt.Errorf(`store: unable to detach labels: %v`, err)
+	}
+
+	return nil
+}
+
+// MarkLabelAsRead updates the status of the unread entries of a label published before the given date.
+func (s *Storage) MarkLabelAsRead(userID, labelID int64, before time.Time) error {
+	query := `
+		UPDATE
+			entries
+		SET
+			status=$1,
+			changed_at=now()
+		WHERE
+			user_id=$2 AND
+			status=$3 AND
+			published_at < $4 AND
+			id IN (SELECT entry_id FROM entry_labels WHERE label_id=$5)
+	`
+	result, err := s.db.Exec(query, model.EntryStatusRead, userID, model.EntryStatusUnread, before, labelID)
+	if err != nil {
+		return fmt.Errorf(`store: unable to mark label entries as read: %v`, err)
+	}
+
+	count, _ := result.RowsAffected()
+	slog.Debug("Marked label entries as read",
+		slog.Int64("user_id", userID),
+		slog.Int64("label_id", labelID),
+		slog.Int64("nb_entries", count),
+		slog.String("before", before.Format(time.RFC3339)),
+	)
+
+	return nil
+}
+
+// labelsByEntryIDs returns the labels of the given entries, indexed by entry ID.
+func (s *Storage) labelsByEntryIDs(entryIDs []int64) (map[int64]model.Labels, error) {
+	query := `
+		SELECT
+			el.entry_id,
+			l.id,
+			l.user_id,
+			l.title
+		FROM
+			entry_labels el
+		INNER JOIN
+			labels l ON l.id=el.label_id
+		WHERE
+			el.entry_id = ANY($1)
+		ORDER BY
+			lower(l.title) ASC
+	`
+
+	rows, err := s.db.Query(query, pq.Array(entryIDs))
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch entry labels: %v`, err)
+	}
+	defer rows.Close()
+
+	labelsMap := make(map[int64]model.Labels)
+	for rows.Next() {
+		var entryID int64
+		var label model.Label
+		if err := rows.Scan(&entryID, &label.ID, &label.UserID, &label.Title); err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch entry label row: %v`, err)
+		}
+		labelsMap[entryID] = append(labelsMap[entryID], &label)
+	}
+
+	return labelsMap, nil
+}
@@ -0,0 +1,218 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package storage // import "miniflux.app/v2/internal/storage"
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/lib/pq"
+
+	"miniflux.app/v2/internal/crypto"
+	"miniflux.app/v2/internal/model"
+)
+
+var ErrNewsletterAddressNotFound = errors.New("store: newsletter address not found")
+
+const newsletterAddressColumns = `
+	id,
+	user_id,
+	category_id,
+	token,
+	description,
+	allowed_senders,
+	last_received_at,
+	created_at
+`
+
+// NewsletterAddressDescriptionExists checks if a newsletter address with the same description exists.
+func (s *Storage) NewsletterAddressDescriptionExists(userID int64, description string) bool {
+	var result bool
+	query := `SELECT true FROM newsletter_addresses WHERE user_id=$1 AND lower(description)=lower($2) LIMIT 1`
+	s.db.QueryRow(query, userID, description).Scan(&result)
+	return result
+}
+
+// NewsletterAddresses returns all newsletter addresses that belong to the given user.
+func (s *Storage) NewsletterAddresses(userID int64) (model.NewsletterAddresses, error) {
+	query := `SELECT ` + newsletterAddressColumns + ` FROM newsletter_addresses WHERE user_id=$1 ORDER BY description ASC`
+	rows, err := s.db.Query(query, userID)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch newsletter addresses: %v`, err)
+	}
+	defer rows.Close()
+
+	addresses := make(model.NewsletterAddresses, 0)
+	for rows.Next() {
+		address, err := scanNewsletterAddress(rows)
+		if err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch newsletter address row: %v`, err)
+		}
+
+		addresses = append(addresses, address)
+	}
+
+	return addresses, nil
+}
+
+// NewsletterAddressByToken returns the newsletter address that matches the given token.
+func (s *Storage) NewsletterAddressByToken(token string) (*model.NewsletterAddress, error) {
+	query := `SELECT ` + newsletterAddressColumns + ` FROM newsletter_addresses WHERE token=$1`
+	address, err := scanNewsletterAddress(s.db.QueryRow(query, token))
+
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf(`store: unable to fetch newsletter address: %v`, err)
+	default:
+		return address, nil
+	}
+}
+
+// CreateNewsletterAddress creates a new newsletter address with a random token.
+func (s *Storage) CreateNewsletterAddress(userID int64, request *model.NewsletterAddressCreationRequest) (*model.NewsletterAddress, error) {
+	query := `
+		INSERT INTO newsletter_addresses
+			(user_id, category_id, token, description, allowed_senders)
+		VALUES
+			($1, $2, $3, $4, $5)
+		RETURNING
+	` + newsletterAddressColumns
+
+	allowedSenders := request.AllowedSenders
+	if allowedSenders == nil {
+		allowedSenders = []string{}
+	}
+
+	address, err := scanNewsletterAddress(s.db.QueryRow(
+		query,
+		userID,
+		request.CategoryID,
+		crypto.GenerateRandomStringHex(10),
+		request.Description,
+		pq.Array(allowedSenders),
+	))
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to create newsletter address: %v`, err)
+	}
+
+	return address, nil
+}
+
+// SetNewsletterAddressReceivedTimestamp updates the date of the last message received by a newsletter address.
+func (s *Storage) SetNewsletterAddressReceivedTimestamp(addressID int64) error {
+	query := `UPDATE newsletter_addresses SET last_received_at=now() WHERE id=$1`
+	if _, err := s.db.Exec(query, addressID); err != nil {
+		return fmt.Errorf(`store: unable to update last reception date for newsletter address: %v`, err)
+	}
+
+	return nil
+}
+
+// RemoveNewsletterAddress deletes a newsletter address. The feeds created for its senders are kept.
+func (s *Storage) RemoveNewsletterAddress(userID, addressID int64) error {
+	result, err := s.db.Exec(`DELETE FROM newsletter_addresses WHERE id=$1 AND user_id=$2`, addressID, userID)
+	if err != nil {
+		return fmt.Errorf(`store: unable to remove this newsletter address: %v`, err)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf(`store: unable to remove this newsletter address: %v`, err)
+	}
+
+	if count == 0 {
+		return ErrNewsletterAddressNotFound
+	}
+
+	return nil
+}
+
+// FeedIDByURL returns the ID of the user feed that has the given feed URL, or zero if there is none.
+func (s *Storage) FeedIDByURL(userID int64, feedURL string) (int64, error) {
+	var feedID int64
+	err := s.db.QueryRow(`SELECT id FROM feeds WHERE user_id=$1 AND feed_url=$2`, userID, feedURL).Scan(&feedID)
+
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return 0, nil
+	case err != nil:
+		return 0, fmt.Errorf(`store: unable to fetch feed ID for %q: %v`, feedURL, err)
+	default:
+		return feedID, nil
+	}
+}
+
+// UpdateFeedUnsubscribeURL updates the unsubscribe link of a newsletter feed.
+func (s *Storage) UpdateFeedUnsubscribeURL(feedID int64, unsubscribeURL string) error {
+	query := `UPDATE feeds SET unsubscribe_url=$1 WHERE id=$2`
+	if _, err := s.db.Exec(query, unsubscribeURL, feedID); err != nil {
+		return fmt.Errorf(`store: unable to update unsubscribe URL of feed #%d: %v`, feedID, err)
+	}
+
+	return nil
+}
+
+// CreateNewsletterImages stores the inline images of a newsletter entry.
+func (s *Storage) CreateNewsletterImages(entryID int64, images []*model.NewsletterImage) error {
+	query := `
+		INSERT INTO newsletter_images
+			(entry_id, external_id, mime_type, content)
+		VALUES
+			($1, $2, $3, $4)
+		RETURNING
+			id
+	`
+
+	for _, image := range images {
+		image.EntryID = entryID
+		if err := s.db.QueryRow(query, entryID, image.ExternalID, image.MimeType, image.Content).Scan(&image.ID); err != nil {
+			return fmt.Errorf(`store: unable to create newsletter image for entry #%d: %v`, entryID, err)
+		}
+	}
+
+	return nil
+}
+
+// NewsletterImageByExternalID returns a newsletter image by its external ID.
+func (s *Storage) NewsletterImageByExternalID(externalID string) (*model.NewsletterImage, error) {
+	query := `SELECT id, entry_id, external_id, mime_type, content FROM newsletter_images WHERE external_id=$1`
+
+	var image model.NewsletterImage
+	err := s.db.QueryRow(query, externalID).Scan(&image.ID, &image.EntryID, &image.ExternalID, &image.MimeType, &image.Content)
+
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf(`store: unable to fetch newsletter image %q: %v`, externalID, err)
+	default:
+		return &image, nil
+	}
+}
+
+type newsletterAddressScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanNewsletterAddress(scanner newsletterAddressScanner) (*model.NewsletterAddress, error) {
+	var address model.NewsletterAddress
+	err := scanner.Scan(
+		&address.ID,
+		&address.UserID,
+		&address.CategoryID,
+		&address.Token,
+		&address.Description,
+		pq.Array(&address.AllowedSenders),
+		&address.LastReceivedAt,
+		&address.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &address, nil
+}
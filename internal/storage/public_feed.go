@@ -0,0 +1,154 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package storage // import "miniflux.app/v2/internal/storage"
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"miniflux.app/v2/internal/crypto"
+	"miniflux.app/v2/internal/model"
+)
+
+var ErrPublicFeedNotFound = errors.New("store: public feed not found")
+
+const publicFeedColumns = `
+	id,
+	user_id,
+	token,
+	title,
+	starred,
+	coalesce(category_id, 0),
+	tag,
+	search_query,
+	last_accessed_at,
+	created_at
+`
+
+// PublicFeedTitleExists checks if a public feed with the same title exists.
+func (s *Storage) PublicFeedTitleExists(userID int64, title string) bool {
+	var result bool
+	query := `SELECT true FROM public_feeds WHERE user_id=$1 AND lower(title)=lower($2) LIMIT 1`
+	s.db.QueryRow(query, userID, title).Scan(&result)
+	return result
+}
+
+// PublicFeeds returns all public feeds that belong to the given user.
+func (s *Storage) PublicFeeds(userID int64) (model.PublicFeeds, error) {
+	query := `SELECT ` + publicFeedColumns + ` FROM public_feeds WHERE user_id=$1 ORDER BY title ASC`
+	rows, err := s.db.Query(query, userID)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch public feeds: %v`, err)
+	}
+	defer rows.Close()
+
+	publicFeeds := make(model.PublicFeeds, 0)
+	for rows.Next() {
+		publicFeed, err := scanPublicFeed(rows)
+		if err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch public feed row: %v`, err)
+		}
+
+		publicFeeds = append(publicFeeds, publicFeed)
+	}
+
+	return publicFeeds, nil
+}
+
+// PublicFeedByToken returns the public feed that matches the given token.
+func (s *Storage) PublicFeedByToken(token string) (*model.PublicFeed, error) {
+	query := `SELECT ` + publicFeedColumns + ` FROM public_feeds WHERE token=$1`
+	publicFeed, err := scanPublicFeed(s.db.QueryRow(query, token))
+
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf(`store: unable to fetch public feed: %v`, err)
+	default:
+		return publicFeed, nil
+	}
+}
+
+// CreatePublicFeed creates a new public feed with a random token.
+func (s *Storage) CreatePublicFeed(userID int64, request *model.PublicFeedCreationRequest) (*model.PublicFeed, error) {
+	query := `
+		INSERT INTO public_feeds
+			(user_id, token, title, starred, category_id, tag, search_query)
+		VALUES
+			($1, $2, $3, $4, nullif($5, 0), $6, $7)
+		RETURNING
+	` + publicFeedColumns
+
+	publicFeed, err := scanPublicFeed(s.db.QueryRow(
+		query,
+		userID,
+		crypto.GenerateRandomStringHex(32),
+		request.Title,
+		request.Starred,
+		request.CategoryID,
+		request.Tag,
+		request.SearchQuery,
+	))
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to create public feed: %v`, err)
+	}
+
+	return publicFeed, nil
+}
+
+// SetPublicFeedAccessedTimestamp updates the last access date of a public feed.
+func (s *Storage) SetPublicFeedAccessedTimestamp(publicFeedID int64) error {
+	query := `UPDATE public_feeds SET last_accessed_at=now() WHERE id=$1`
+	if _, err := s.db.Exec(query, publicFeedID); err != nil {
+		return fmt.Errorf(`store: unable to update last access date for public feed: %v`, err)
+	}
+
+	return nil
+}
+
+// RemovePublicFeed deletes a public feed, which revokes its token.
+func (s *Storage) RemovePublicFeed(userID, publicFeedID int64) error {
+	result, err := s.db.Exec(`DELETE FROM public_feeds WHERE id=$1 AND user_id=$2`, publicFeedID, userID)
+	if err != nil {
+		return fmt.Errorf(`store: unable to remove this public feed: %v`, err)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf(`store: unable to remove this public feed: %v`, err)
+	}
+
+	if count == 0 {
+		return ErrPublicFeedNotFound
+	}
+
+	return nil
+}
+
+type publicFeedScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanPublicFeed(scanner publicFeedScanner) (*model.PublicFeed, error) {
+	var publicFeed model.PublicFeed
+	err := scanner.Scan(
+		&publicFeed.ID,
+		&publicFeed.UserID,
+		&publicFeed.Token,
+		&publicFeed.Title,
+		&publicFeed.Starred,
+		&publicFeed.CategoryID,
+		&publicFeed.Tag,
+		&publicFeed.SearchQuery,
+		&publicFeed.LastAccessedAt,
+		&publicFeed.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &publicFeed, nil
+}
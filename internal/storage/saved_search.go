@@ -0,0 +1,291 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package storage // import "miniflux.app/v2/internal/storage"
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+	"miniflux.app/v2/internal/model"
+)
+
+var ErrSavedSearchNotFound = errors.New("store: saved search not found")
+
+const savedSearchColumns = `
+	id,
+	user_id,
+	title,
+	search_query,
+	statuses,
+	starred,
+	category_ids,
+	feed_ids,
+	tags,
+	max_age_days,
+	created_at
+`
+
+// SavedSearchTitleExists checks if a saved search with the same title exists.
+func (s *Storage) SavedSearchTitleExists(userID int64, title string) bool {
+	var result bool
+	query := `SELECT true FROM saved_searches WHERE user_id=$1 AND lower(title)=lower($2) LIMIT 1`
+	s.db.QueryRow(query, userID, title).Scan(&result)
+	return result
+}
+
+// AnotherSavedSearchExists checks if another saved search exists with the same title.
+func (s *Storage) AnotherSavedSearchExists(userID, savedSearchID int64, title string) bool {
+	var result bool
+	query := `SELECT true FROM saved_searches WHERE user_id=$1 AND id != $2 AND lower(title)=lower($3) LIMIT 1`
+	s.db.QueryRow(query, userID, savedSearchID, title).Scan(&result)
+	return result
+}
+
+// SavedSearch returns a saved search from the database.
+func (s *Storage) SavedSearch(userID, savedSearchID int64) (*model.SavedSearch, error) {
+	query := `SELECT ` + savedSearchColumns + ` FROM saved_searches WHERE user_id=$1 AND id=$2`
+	savedSearch, err := scanSavedSearch(s.db.QueryRow(query, userID, savedSearchID))
+
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf(`store: unable to fetch saved search: %v`, err)
+	default:
+		return savedSearch, nil
+	}
+}
+
+// SavedSearchByTitle finds a saved search by the title, ignoring the case.
+func (s *Storage) SavedSearchByTitle(userID int64, title string) (*model.SavedSearch, error) {
+	query := `SELECT ` + savedSearchColumns + ` FROM saved_searches WHERE user_id=$1 AND lower(title)=lower($2)`
+	savedSearch, err := scanSavedSearch(s.db.QueryRow(query, userID, title))
+
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf(`store: unable to fetch saved search: %v`, err)
+	default:
+		return savedSearch, nil
+	}
+}
+
+// SavedSearches returns all saved searches that belong to the given user.
+func (s *Storage) SavedSearches(userID int64) (model.SavedSearches, error) {
+	query := `SELECT ` + savedSearchColumns + ` FROM saved_searches WHERE user_id=$1 ORDER BY lower(title) ASC`
+	rows, err := s.db.Query(query, userID)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch saved searches: %v`, err)
+	}
+	defer rows.Close()
+
+	savedSearches := make(model.SavedSearches, 0)
+	for rows.Next() {
+		savedSearch, err := scanSavedSearch(rows)
+		if err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch saved search row: %v`, err)
+		}
+
+		savedSearches = append(savedSearches, savedSearch)
+	}
+
+	return savedSearches, nil
+}
+
+// SavedSearchesWithUnreadCount returns all saved searches with the number of unread matching entries.
+// The entries are counted in a single query, with one filtered aggregate per saved search.
+func (s *Storage) SavedSearchesWithUnreadCount(userID int64) (model.SavedSearches, error) {
+	savedSearches, err := s.SavedSearches(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(savedSearches) == 0 {
+		return savedSearches, nil
+	}
+
+	builder := s.NewEntryQueryBuilder(userID).WithStatuses(model.EntryStatusUnread)
+	baseCondition := builder.buildCondition()
+	baseConditionsCount := len(builder.conditions)
+
+	aggregates := make([]string, 0, len(savedSearches))
+	for _, savedSearch := range savedSearches {
+		builder.WithSavedSearch(savedSearch)
+
+		filter := "true"
+		if len(builder.conditions) > baseConditionsCount {
+			filter = strings.Join(builder.conditions[baseConditionsCount:], " AND ")
+		}
+		aggregates = append(aggregates, "count(*) FILTER (WHERE "+filter+")")
+
+		builder.conditions = builder.conditions[:baseConditionsCount]
+	}
+
+	query := `
+		SELECT ` + strings.Join(aggregates, ", ") + `
+		FROM entries e
+			JOIN feeds f ON f.id = e.feed_id
+			JOIN categories c ON c.id = f.category_id
+		WHERE ` + baseCondition
+
+	counts := make([]int, len(savedSearches))
+	dest := make([]any, len(savedSearches))
+	for i := range counts {
+		dest[i] = &counts[i]
+	}
+
+	if err := s.db.QueryRow(query, builder.args...).Scan(dest...); err != nil {
+		return nil, fmt.Errorf(`store: unable to count the unread entries of the saved searches: %v`, err)
+	}
+
+	for i, savedSearch := range savedSearches {
+		savedSearch.TotalUnread = &counts[i]
+	}
+
+	return savedSearches, nil
+}
+
+// CreateSavedSearch creates a new saved search.
+func (s *Storage) CreateSavedSearch(userID int64, request *model.SavedSearchCreationRequest) (*model.SavedSearch, error) {
+	query := `
+		INSERT INTO saved_searches
+			(user_id, title, search_query, statuses, starred, category_ids, feed_ids, tags, max_age_days)
+		VALUES
+			($1, $2, $3, coalesce($4, '{}'::text[]), $5, coalesce($6, '{}'::bigint[]), coalesce($7, '{}'::bigint[]), coalesce($8, '{}'::text[]), $9)
+		RETURNING
+	` + savedSearchColumns
+
+	savedSearch, err := scanSavedSearch(s.db.QueryRow(
+		query,
+		userID,
+		request.Title,
+		request.SearchQuery,
+		pq.StringArray(request.Statuses),
+		request.Starred,
+		pq.Int64Array(request.CategoryIDs),
+		pq.Int64Array(request.FeedIDs),
+		pq.StringArray(request.Tags),
+		request.MaxAgeDays,
+	))
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to create saved search %q for user ID %d: %v`, request.Title, userID, err)
+	}
+
+	return savedSearch, nil
+}
+
+// UpdateSavedSearch updates an existing saved search.
+func (s *Storage) UpdateSavedSearch(savedSearch *model.SavedSearch) error {
+	query := `
+		UPDATE saved_searches SET
+			title=$1,
+			search_query=$2,
+			statuses=coalesce($3, '{}'::text[]),
+			starred=$4,
+			category_ids=coalesce($5, '{}'::bigint[]),
+			feed_ids=coalesce($6, '{}'::bigint[]),
+			tags=coalesce($7, '{}'::text[]),
+			max_age_days=$8
+		WHERE
+			id=$9 AND user_id=$10
+	`
+	_, err := s.db.Exec(
+		query,
+		savedSearch.Title,
+		savedSearch.SearchQuery,
+		pq.StringArray(savedSearch.Statuses),
+		savedSearch.Starred,
+		pq.Int64Array(savedSearch.CategoryIDs),
+		pq.Int64Array(savedSearch.FeedIDs),
+		pq.StringArray(savedSearch.Tags),
+		savedSearch.MaxAgeDays,
+		savedSearch.ID,
+		savedSearch.UserID,
+	)
+	if err != nil {
+		return fmt.Errorf(`store: unable to update saved search #%d: %v`, savedSearch.ID, err)
+	}
+
+	return nil
+}
+
+// RemoveSavedSearch deletes a saved search, the matching entries are not affected.
+func (s *Storage) RemoveSavedSearch(userID, savedSearchID int64) error {
+	result, err := s.db.Exec(`DELETE FROM saved_searches WHERE id=$1 AND user_id=$2`, savedSearchID, userID)
+	if err != nil {
+		return fmt.Errorf(`store: unable to remove this saved search: %v`, err)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf(`store: unable to remove this saved search: %v`, err)
+	}
+
+	if count == 0 {
+		return ErrSavedSearchNotFound
+	}
+
+	return nil
+}
+
+// MarkSavedSearchAsRead updates the status of the unread entries of a saved search published before the given date.
+func (s *Storage) MarkSavedSearchAsRead(userID int64, savedSearch *model.SavedSearch, before time.Time) error {
+	entryIDs, err := s.NewEntryQueryBuilder(userID).
+		WithSavedSearch(savedSearch).
+		WithStatuses(model.EntryStatusUnread).
+		BeforePublishedDate(before).
+		GetEntryIDs()
+	if err != nil {
+		return err
+	}
+
+	if len(entryIDs) == 0 {
+		return nil
+	}
+
+	if err := s.SetEntriesStatus(userID, entryIDs, model.EntryStatusRead); err != nil {
+		return err
+	}
+
+	slog.Debug("Marked saved search entries as read",
+		slog.Int64("user_id", userID),
+		slog.Int64("saved_search_id", savedSearch.ID),
+		slog.Int("nb_entries", len(entryIDs)),
+		slog.String("before", before.Format(time.RFC3339)),
+	)
+
+	return nil
+}
+
+type savedSearchScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanSavedSearch(scanner savedSearchScanner) (*model.SavedSearch, error) {
+	var savedSearch model.SavedSearch
+	err := scanner.Scan(
+		&savedSearch.ID,
+		&savedSearch.UserID,
+		&savedSearch.Title,
+		&savedSearch.SearchQuery,
+		pq.Array(&savedSearch.Statuses),
+		&savedSearch.Starred,
+		pq.Array(&savedSearch.CategoryIDs),
+		pq.Array(&savedSearch.FeedIDs),
+		pq.Array(&savedSearch.Tags),
+		&savedSearch.MaxAgeDays,
+		&savedSearch.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &savedSearch, nil
+}
@@ -0,0 +1,166 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package storage // import "miniflux.app/v2/internal/storage"
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/reader/language"
+	"miniflux.app/v2/internal/reader/sanitizer"
+)
+
+// textSearchConfigs maps the language codes to the PostgreSQL text search configurations.
+// Only the configurations available in all supported PostgreSQL versions are listed.
+var textSearchConfigs = map[string]string{
+	"da": "danish",
+	"de": "german",
+	"en": "english",
+	"es": "spanish",
+	"fi": "finnish",
+	"fr": "french",
+	"hu": "hungarian",
+	"it": "italian",
+	"nb": "norwegian",
+	"nl": "dutch",
+	"nn": "norwegian",
+	"no": "norwegian",
+	"pt": "portuguese",
+	"ro": "romanian",
+	"ru": "russian",
+	"sv": "swedish",
+	"tr": "turkish",
+}
+
+// textSearchConfigExpression is the text search configuration stored with an entry,
+// the entries without configuration use the database default.
+const textSearchConfigExpression = "coalesce(e.search_config::regconfig, get_current_ts_config())"
+
+// entryTextSearchConfig returns the text search configuration of an entry, from the entry or the feed language,
+// or detected from the text as a fallback. An empty string means the database default configuration.
+func entryTextSearchConfig(entry *model.Entry) string {
+	languageCode := entry.Language
+	if languageCode == "" && entry.Feed != nil {
+		languageCode = entry.Feed.Language
+	}
+
+	if languageCode == "" {
+		languageCode = language.Detect(entry.Title + "\n" + sanitizer.StripTags(entry.Content))
+	}
+
+	return textSearchConfig(languageCode)
+}
+
+// textSearchConfig returns the text search configuration of a language code such as "de" or "fr-CA".
+// The languages without stemming support use the "simple" configuration.
+func textSearchConfig(languageCode string) string {
+	if languageCode == "" {
+		return ""
+	}
+
+	primaryCode, _, _ := strings.Cut(strings.ToLower(languageCode), "-")
+	if config, found := textSearchConfigs[primaryCode]; found {
+		return config
+	}
+
+	return "simple"
+}
+
+// textSearchCondition returns the condition matching the search query of the given placeholder.
+// The query is parsed with the configuration of each entry, and each branch can use the full-text index.
+// There is one branch per configuration, the search cost grows with the number of supported languages.
+func textSearchCondition(argIndex int) string {
+	configs := []string{"simple"}
+	for _, config := range textSearchConfigs {
+		if !slices.Contains(configs, config) {
+			configs = append(configs, config)
+		}
+	}
+	slices.Sort(configs)
+
+	branches := []string{
+		fmt.Sprintf("(e.search_config IS NULL AND e.document_vectors @@ websearch_to_tsquery($%d))", argIndex),
+	}
+	for _, config := range configs {
+		branches = append(branches, fmt.Sprintf("(e.search_config = '%[1]s' AND e.document_vectors @@ websearch_to_tsquery('%[1]s', $%[2]d))", config, argIndex))
+	}
+
+	return "(" + strings.Join(branches, " OR ") + ")"
+}
+
+// RebuildEntrySearchVectors rebuilds the full-text search vectors of a batch of entries with an ID greater than afterID,
+// with the text search configuration of their language. It returns the last updated entry ID, or zero when there are no more entries.
+func (s *Storage) RebuildEntrySearchVectors(afterID int64, batchSize int) (int64, error) {
+	rows, err := s.db.Query(`
+		SELECT
+			e.id,
+			e.title,
+			e.content,
+			e.language,
+			f.language
+		FROM
+			entries e
+		INNER JOIN
+			feeds f ON f.id=e.feed_id
+		WHERE
+			e.id > $1
+		ORDER BY
+			e.id ASC
+		LIMIT $2
+	`, afterID, batchSize)
+	if err != nil {
+		return 0, fmt.Errorf(`store: unable to fetch entries: %v`, err)
+	}
+	defer rows.Close()
+
+	var entries model.Entries
+	for rows.Next() {
+		entry := &model.Entry{Feed: &model.Feed{}}
+		if err := rows.Scan(&entry.ID, &entry.Title, &entry.Content, &entry.Language, &entry.Feed.Language); err != nil {
+			return 0, fmt.Errorf(`store: unable to fetch entry row: %v`, err)
+		}
+		entries = append(entries, entry)
+	}
+
+	if err := rows.Err(); err != nil {
+		return 0, fmt.Errorf(`store: unable to fetch entries: %v`, err)
+	}
+
+	if len(entries) == 0 {
+		return 0, nil
+	}
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return 0, fmt.Errorf(`store: unable to start transaction: %v`, err)
+	}
+	defer tx.Rollback()
+
+	query := `
+		UPDATE
+			entries
+		SET
+			search_config=nullif($2, ''),
+			document_vectors=setweight(to_tsvector(coalesce(nullif($2, '')::regconfig, get_current_ts_config()), $3), 'A') ||
+				setweight(to_tsvector(coalesce(nullif($2, '')::regconfig, get_current_ts_config()), $4), 'B') ||
+				setweight(to_tsvector(coalesce(nullif($2, '')::regconfig, get_current_ts_config()), left(transcript, $5)), 'D')
+		WHERE
+			id=$1
+	`
+
+	for _, entry := range entries {
+		truncatedTitle, truncatedContent := truncateTitleAndContentForTSVectorField(entry.Title, entry.Content)
+		if _, err := tx.Exec(query, entry.ID, entryTextSearchConfig(entry), truncatedTitle, truncatedContent, transcriptMaxSizeForTSVectorField); err != nil {
+			return 0, fmt.Errorf(`store: unable to update search vectors of entry #%d: %v`, entry.ID, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf(`store: unable to commit transaction: %v`, err)
+	}
+
+	return entries[len(entries)-1].ID, nil
+}
@@ -0,0 +1,74 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"strings"
+	"testing"
+
+	"miniflux.app/v2/internal/model"
+)
+
+func TestTextSearchConfig(t *testing.T) {
+	cases := []struct {
+		languageCode, want string
+	}{
+		{"", ""},
+		{"de", "german"},
+		{"de-AT", "german"},
+		{"FR-ca", "french"},
+		{"nb-no", "norwegian"},
+		{"ja", "simple"},
+		{"zh-hant", "simple"},
+	}
+
+	for _, c := range cases {
+		if got := textSearchConfig(c.languageCode); got != c.want {
+			t.Errorf("textSearchConfig(%q) = %q, want %q", c.languageCode, got, c.want)
+		}
+	}
+}
+
+func TestEntryTextSearchConfig(t *testing.T) {
+	entry := &model.Entry{Language: "es", Feed: &model.Feed{Language: "de"}}
+	if config := entryTextSearchConfig(entry); config != "spanish" {
+		t.Errorf(`The entry language should be used first, got %q`, config)
+	}
+
+	entry.Language = ""
+	if config := entryTextSearchConfig(entry); config != "german" {
+		t.Errorf(`The feed language should be used when the entry has no language, got %q`, config)
+	}
+
+	entry.Feed.Language = ""
+	entry.Title = "La nouvelle version"
+	entry.Content = "<p>Elle est disponible pour les utilisateurs et apporte des corrections dans le code.</p>"
+	if config := entryTextSearchConfig(entry); config != "french" {
+		t.Errorf(`The language should be detected without entry and feed language, got %q`, config)
+	}
+
+	entry.Title = "Golang"
+	entry.Content = "<p>Kubernetes</p>"
+	if config := entryTextSearchConfig(entry); config != "" {
+		t.Errorf(`The default configuration should be used when the language is unknown, got %q`, config)
+	}
+}
+
+func TestTextSearchCondition(t *testing.T) {
+	condition := textSearchCondition(4)
+
+	if !strings.Contains(condition, "(e.search_config IS NULL AND e.document_vectors @@ websearch_to_tsquery($4))") {
+		t.Errorf(`The entries without configuration should use the default configuration, got %s`, condition)
+	}
+
+	for _, config := range []string{"simple", "german", "french", "english"} {
+		if !strings.Contains(condition, "(e.search_config = '"+config+"' AND e.document_vectors @@ websearch_to_tsquery('"+config+"', $4))") {
+			t.Errorf(`The %s configuration is missing, got %s`, config, condition)
+		}
+	}
+
+	if strings.Count(condition, "norwegian") != 2 {
+		t.Errorf(`Each configuration should be listed once, got %s`, condition)
+	}
+}
@@ -0,0 +1,279 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package storage // import "miniflux.app/v2/internal/storage"
+
+import (
+	"fmt"
+	"html"
+	"log/slog"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+
+	"miniflux.app/v2/internal/model"
+)
+
+const searchQueryDateFormat = "2006-01-02"
+
+// fuzzySearchMinEntries is the number of entries found by the full-text search below which the fuzzy search kicks in.
+const fuzzySearchMinEntries = 10
+
+// The search headlines delimit the matching terms with control characters,
+// so the text can be escaped before adding the highlighting tags.
+const (
+	searchHeadlineStartSel  = "\x02"
+	searchHeadlineStopSel   = "\x03"
+	searchHeadlineSelectors = `'StartSel=' || chr(2) || ', StopSel=' || chr(3)`
+)
+
+// searchQuery is a search string split into the operators and the full-text part.
+//
+// Supported operators: feed:, category:, author:, tag:, is:starred, is:unstarred,
+// is:unread, is:read, before:, after:, has:enclosure and lang:.
+// Values with spaces are quoted, e.g. feed:"Hacker News".
+// Tokens that are not valid operators are kept in the full-text part.
+type searchQuery struct {
+	text         string
+	feedIDs      []int64
+	feedTitles   []string
+	categoryIDs  []int64
+	categories   []string
+	authors      []string
+	tags         []string
+	statuses     []string
+	starred      *bool
+	before       time.Time
+	after        time.Time
+	hasEnclosure bool
+	languages    []string
+}
+
+func parseSearchQuery(input string) *searchQuery {
+	query := &searchQuery{}
+	var textParts []string
+
+	for _, token := range splitSearchQuery(input) {
+		if !query.parseOperator(token) {
+			textParts = append(textParts, token)
+		}
+	}
+
+	query.text = strings.Join(textParts, " ")
+	return query
+}
+
+// parseOperator applies the token to the query and returns false if the token is not an operator.
+func (q *searchQuery) parseOperator(token string) bool {
+	name, value, found := strings.Cut(token, ":")
+	if !found {
+		return false
+	}
+
+	value = strings.Trim(value, `"`)
+	if value == "" {
+		return false
+	}
+
+	switch strings.ToLower(name) {
+	case "feed":
+		if feedID, err := strconv.ParseInt(value, 10, 64); err == nil {
+			q.feedIDs = append(q.feedIDs, feedID)
+		} else {
+			q.feedTitles = append(q.feedTitles, value)
+		}
+	case "category":
+		if categoryID, err := strconv.ParseInt(value, 10, 64); err == nil {
+			q.categoryIDs = append(q.categoryIDs, categoryID)
+		} else {
+			q.categories = append(q.categories, value)
+		}
+	case "author":
+		q.authors = append(q.authors, value)
+	case "tag":
+		q.tags = append(q.tags, value)
+	case "is":
+		switch strings.ToLower(value) {
+		case "starred":
+			q.starred = new(true)
+		case "unstarred":
+			q.starred = new(false)
+		case model.EntryStatusUnread, model.EntryStatusRead:
+			q.statuses = append(q.statuses, strings.ToLower(value))
+		default:
+			return false
+		}
+	case "before", "after":
+		date, err := time.Parse(searchQueryDateFormat, value)
+		if err != nil {
+			return false
+		}
+		if strings.EqualFold(name, "before") {
+			q.before = date
+		} else {
+			q.after = date
+		}
+	case "has":
+		if !strings.EqualFold(value, "enclosure") {
+			return false
+		}
+		q.hasEnclosure = true
+	case "lang":
+		q.languages = append(q.languages, value)
+	default:
+		return false
+	}
+
+	return true
+}
+
+// conditions returns the SQL conditions of the operators, the placeholders start after argCount.
+// The entries table must be aliased "e" and the feeds table "f".
+func (q *searchQuery) conditions(argCount int) ([]string, []any) {
+	var conditions []string
+	var args []any
+
+	addCondition := func(format string, arg any) {
+		args = append(args, arg)
+		conditions = append(conditions, fmt.Sprintf(format, argCount+len(args)))
+	}
+
+	if len(q.feedIDs) > 0 {
+		addCondition("e.feed_id = ANY($%d)", pq.Int64Array(q.feedIDs))
+	}
+
+	for _, feedTitle := range q.feedTitles {
+		addCondition("lower(f.title) = lower($%d)", feedTitle)
+	}
+
+	if len(q.categoryIDs) > 0 {
+		addCondition("f.category_id = ANY($%d)", pq.Int64Array(q.categoryIDs))
+	}
+
+	for _, category := range q.categories {
+		addCondition("f.category_id IN (SELECT id FROM categories WHERE user_id = e.user_id AND lower(title) = lower($%d))", category)
+	}
+
+	for _, author := range q.authors {
+		addCondition("e.author ILIKE '%%' || $%d || '%%'", author)
+	}
+
+	if len(q.tags) > 0 {
+		addCondition("LOWER(e.tags::text)::text[] @> LOWER($%d::text)::text[]", pq.Array(q.tags))
+	}
+
+	if len(q.statuses) > 0 {
+		addCondition("e.status = ANY($%d)", pq.StringArray(q.statuses))
+	}
+
+	if q.starred != nil {
+		if *q.starred {
+			conditions = append(conditions, "e.starred is true")
+		} else {
+			conditions = append(conditions, "e.starred is false")
+		}
+	}
+
+	if !q.before.IsZero() {
+		addCondition("e.published_at < $%d", q.before)
+	}
+
+	if !q.after.IsZero() {
+		addCondition("e.published_at > $%d", q.after)
+	}
+
+	if q.hasEnclosure {
+		conditions = append(conditions, "EXISTS (SELECT 1 FROM enclosures WHERE entry_id = e.id)")
+	}
+
+	for _, language := range q.languages {
+		addCondition("lower(coalesce(nullif(e.language, ''), f.language)) LIKE lower($%d) || '%%'", language)
+	}
+
+	return conditions, args
+}
+
+// splitSearchQuery splits the input on spaces, except inside double quotes.
+// The quotes are kept so the full-text phrases are left untouched.
+func splitSearchQuery(input string) []string {
+	var tokens []string
+	var current strings.Builder
+	inQuotes := false
+
+	for _, r := range input {
+		switch {
+		case r == '"':
+			inQuotes = !inQuotes
+			current.WriteRune(r)
+		case (r == ' ' || r == '\t' || r == '\n') && !inQuotes:
+			if current.Len() > 0 {
+				tokens = append(tokens, current.String())
+				current.Reset()
+			}
+		default:
+			current.WriteRune(r)
+		}
+	}
+
+	if current.Len() > 0 {
+		tokens = append(tokens, current.String())
+	}
+
+	return tokens
+}
+
+// formatSearchHeadline returns the search headline as HTML, the matching terms are wrapped in mark tags.
+// The entities are only decoded in the headlines of the content, the titles are plain text.
+func formatSearchHeadline(headline string, isHTML bool) string {
+	if isHTML {
+		headline = html.UnescapeString(headline)
+	}
+	headline = strings.Join(strings.Fields(headline), " ")
+	headline = html.EscapeString(headline)
+	return strings.NewReplacer(searchHeadlineStartSel, "<mark>", searchHeadlineStopSel, "</mark>").Replace(headline)
+}
+
+// searchRankExpression returns the sort expression of the search results, the most relevant and recent entries first.
+// The fuzzy ranking adds the similarity of the entry and feed titles to the full-text rank.
+func searchRankExpression(argIndex int, fuzzy bool) string {
+	rank := fmt.Sprintf("ts_rank(document_vectors, websearch_to_tsquery(%s, $%d))", textSearchConfigExpression, argIndex)
+	if fuzzy {
+		rank += fmt.Sprintf(" + greatest(word_similarity($%[1]d, e.title), word_similarity($%[1]d, f.title))", argIndex)
+	}
+
+	// 0.0000001 = 0.1 / (seconds_in_a_day)
+	return rank + " - extract (epoch from now() - published_at)::float * 0.0000001 DESC"
+}
+
+// fuzzySearchCondition extends the full-text search condition to the entries with a title or a feed title
+// similar to the search query. The pg_trgm "<%" operator also matches the partial words and typos.
+func fuzzySearchCondition(textCondition string, argIndex int) string {
+	return fmt.Sprintf("(%[1]s OR $%[2]d <%% e.title OR $%[2]d <%% f.title)", textCondition, argIndex)
+}
+
+// hasTrigramIndexes returns true if the pg_trgm extension and the trigram indexes of the titles are installed, they are optional.
+// The migrations skip the indexes when the extension cannot be created, both are required to avoid scanning all the titles.
+// The result is cached once the lookup succeeds.
+func (s *Storage) hasTrigramIndexes() bool {
+	s.trigramIndexesMutex.Lock()
+	defer s.trigramIndexesMutex.Unlock()
+
+	if s.trigramIndexesChecked {
+		return s.trigramIndexes
+	}
+
+	query := `
+		SELECT
+			EXISTS (SELECT 1 FROM pg_extension WHERE extname='pg_trgm') AND
+			(SELECT count(*) FROM pg_indexes WHERE schemaname=current_schema() AND indexname IN ('entries_title_trgm_idx', 'feeds_title_trgm_idx')) = 2
+	`
+	if err := s.db.QueryRow(query).Scan(&s.trigramIndexes); err != nil {
+		slog.Warn("Unable to look up the trigram indexes of the fuzzy search", slog.Any("error", err))
+		return false
+	}
+
+	s.trigramIndexesChecked = true
+	return s.trigramIndexes
+}
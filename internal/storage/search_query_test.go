@@ -0,0 +1,202 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSplitSearchQuery(t *testing.T) {
+	tokens := splitSearchQuery(`  golang feed:"Hacker News"  "exact phrase" is:unread `)
+	expected := []string{"golang", `feed:"Hacker News"`, `"exact phrase"`, "is:unread"}
+	if !slices.Equal(tokens, expected) {
+		t.Errorf(`Unexpected tokens, got %q instead of %q`, tokens, expected)
+	}
+}
+
+func TestParseSearchQueryWithoutOperators(t *testing.T) {
+	query := parseSearchQuery(`golang "exact phrase" -rust`)
+	if query.text != `golang "exact phrase" -rust` {
+		t.Errorf(`Unexpected text, got %q`, query.text)
+	}
+
+	conditions, args := query.conditions(0)
+	if len(conditions) != 0 || len(args) != 0 {
+		t.Errorf(`No conditions were expected, got %v`, conditions)
+	}
+}
+
+func TestParseSearchQueryWithOperators(t *testing.T) {
+	query := parseSearchQuery(`golang feed:42 feed:"Hacker News" category:News author:alice tag:go is:starred is:Unread after:2024-01-31 before:2024-12-31 has:enclosure lang:fr`)
+
+	if query.text != "golang" {
+		t.Errorf(`Unexpected text, got %q`, query.text)
+	}
+
+	if !slices.Equal(query.feedIDs, []int64{42}) {
+		t.Errorf(`Unexpected feed IDs, got %v`, query.feedIDs)
+	}
+
+	if !slices.Equal(query.feedTitles, []string{"Hacker News"}) {
+		t.Errorf(`Unexpected feed titles, got %q`, query.feedTitles)
+	}
+
+	if !slices.Equal(query.categories, []string{"News"}) {
+		t.Errorf(`Unexpected categories, got %q`, query.categories)
+	}
+
+	if !slices.Equal(query.authors, []string{"alice"}) {
+		t.Errorf(`Unexpected authors, got %q`, query.authors)
+	}
+
+	if !slices.Equal(query.tags, []string{"go"}) {
+		t.Errorf(`Unexpected tags, got %q`, query.tags)
+	}
+
+	if query.starred == nil || !*query.starred {
+		t.Error(`The starred operator should be set`)
+	}
+
+	if !slices.Equal(query.statuses, []string{"unread"}) {
+		t.Errorf(`Unexpected statuses, got %q`, query.statuses)
+	}
+
+	if !query.after.Equal(time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf(`Unexpected after date, got %v`, query.after)
+	}
+
+	if !query.before.Equal(time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf(`Unexpected before date, got %v`, query.before)
+	}
+
+	if !query.hasEnclosure {
+		t.Error(`The enclosure operator should be set`)
+	}
+
+	if !slices.Equal(query.languages, []string{"fr"}) {
+		t.Errorf(`Unexpected languages, got %q`, query.languages)
+	}
+}
+
+func TestParseSearchQueryKeepsInvalidOperatorsInText(t *testing.T) {
+	query := parseSearchQuery(`https://example.org is:unknown before:yesterday has:image feed: foo:bar`)
+	if query.text != `https://example.org is:unknown before:yesterday has:image feed: foo:bar` {
+		t.Errorf(`Unexpected text, got %q`, query.text)
+	}
+
+	conditions, _ := query.conditions(0)
+	if len(conditions) != 0 {
+		t.Errorf(`No conditions were expected, got %v`, conditions)
+	}
+}
+
+func TestSearchQueryConditionsPlaceholders(t *testing.T) {
+	query := parseSearchQuery(`feed:42 author:alice is:unstarred has:enclosure`)
+	conditions, args := query.conditions(3)
+
+	expected := []string{
+		"e.feed_id = ANY($4)",
+		"e.author ILIKE '%' || $5 || '%'",
+		"e.starred is false",
+		"EXISTS (SELECT 1 FROM enclosures WHERE entry_id = e.id)",
+	}
+
+	if !slices.Equal(conditions, expected) {
+		t.Errorf(`Unexpected conditions, got %q instead of %q`, conditions, expected)
+	}
+
+	if len(args) != 2 {
+		t.Errorf(`Two arguments were expected, got %d`, len(args))
+	}
+}
+
+func TestFormatSearchHeadline(t *testing.T) {
+	headline := formatSearchHeadline("Tom &amp; Jerry  <b>\x02golang\x03</b>\n release", true)
+	expected := "Tom &amp; Jerry &lt;b&gt;<mark>golang</mark>&lt;/b&gt; release"
+	if headline != expected {
+		t.Errorf(`Unexpected headline, got %q instead of %q`, headline, expected)
+	}
+}
+
+func TestFormatSearchHeadlineOfPlainText(t *testing.T) {
+	headline := formatSearchHeadline("\x02AT&amp;T\x03 & Verizon", false)
+	expected := "<mark>AT&amp;amp;T</mark> &amp; Verizon"
+	if headline != expected {
+		t.Errorf(`Unexpected headline, got %q instead of %q`, headline, expected)
+	}
+}
+
+func TestEntryQueryBuilderSnippetColumns(t *testing.T) {
+	builder := &EntryQueryBuilder{args: []any{int64(1)}, conditions: []string{"e.user_id = $1"}}
+	if builder.snippetColumns() != "" {
+		t.Error(`No snippet columns were expected without a search query`)
+	}
+
+	builder.WithSearchQuery("feed:42 golang")
+	if builder.snippetColumns() != "" {
+		t.Error(`No snippet columns were expected without WithSearchSnippets`)
+	}
+
+	builder.WithSearchSnippets()
+	columns := builder.snippetColumns()
+	if !strings.Contains(columns, "websearch_to_tsquery("+textSearchConfigExpression+", $3)") {
+		t.Errorf(`The snippet columns should use the full-text argument, got %s`, columns)
+	}
+
+	if builder.args[2] != "golang" {
+		t.Errorf(`Unexpected full-text argument, got %v`, builder.args[2])
+	}
+}
+
+func TestFuzzySearchCondition(t *testing.T) {
+	condition := fuzzySearchCondition("e.document_vectors @@ websearch_to_tsquery($2)", 2)
+	expected := "(e.document_vectors @@ websearch_to_tsquery($2) OR $2 <% e.title OR $2 <% f.title)"
+	if condition != expected {
+		t.Errorf(`Unexpected condition, got %q instead of %q`, condition, expected)
+	}
+}
+
+func TestSearchRankExpression(t *testing.T) {
+	if rank := searchRankExpression(3, false); strings.Contains(rank, "word_similarity") {
+		t.Errorf(`The full-text rank should not use the similarity, got %s`, rank)
+	}
+
+	rank := searchRankExpression(3, true)
+	if !strings.Contains(rank, "greatest(word_similarity($3, e.title), word_similarity($3, f.title))") {
+		t.Errorf(`The fuzzy rank should use the title similarity, got %s`, rank)
+	}
+
+	if !strings.HasSuffix(rank, " DESC") {
+		t.Errorf(`The most relevant entries should come first, got %s`, rank)
+	}
+}
+
+func TestWithFuzzySearchQueryLocatesTheFullTextCondition(t *testing.T) {
+	builder := &EntryQueryBuilder{args: []any{int64(1)}, conditions: []string{"e.user_id = $1"}}
+	builder.WithFuzzySearchQuery("is:unread golnag")
+
+	if builder.fuzzySearch == nil {
+		t.Fatal(`The fuzzy search should be enabled`)
+	}
+
+	if builder.fuzzySearch.argIndex != 3 || builder.args[2] != "golnag" {
+		t.Errorf(`Unexpected full-text argument, got $%d`, builder.fuzzySearch.argIndex)
+	}
+
+	if builder.conditions[builder.fuzzySearch.conditionIndex] != textSearchCondition(3) {
+		t.Errorf(`The condition index should point to the full-text condition, got %s`, builder.conditions[builder.fuzzySearch.conditionIndex])
+	}
+
+	if builder.sortExpressions[builder.fuzzySearch.sortIndex] != searchRankExpression(3, false) {
+		t.Errorf(`The sort index should point to the rank expression, got %s`, builder.sortExpressions[builder.fuzzySearch.sortIndex])
+	}
+
+	builder = &EntryQueryBuilder{args: []any{int64(1)}, conditions: []string{"e.user_id = $1"}}
+	if builder.WithFuzzySearchQuery("is:unread").fuzzySearch != nil {
+		t.Error(`The fuzzy search should be disabled without full-text query`)
+	}
+}
@@ -6,17 +6,22 @@ package storage // import "miniflux.app/v2/internal/storage"
 import (
 	"context"
 	"database/sql"
+	"sync"
 	"time"
 )
 
 // Storage handles all operations related to the database.
 type Storage struct {
 	db *sql.DB
+
+	trigramIndexesMutex   sync.Mutex
+	trigramIndexesChecked bool
+	trigramIndexes        bool
 }
 
 // NewStorage returns a new Storage.
 func NewStorage(db *sql.DB) *Storage {
-	return &Storage{db}
+	return &Storage{db: db}
 }
 
 // DatabaseVersion returns the version of the database which is in use.
@@ -0,0 +1,407 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"miniflux.app/v2/internal/config"
+	"miniflux.app/v2/internal/crypto"
+	"miniflux.app/v2/internal/database"
+	"miniflux.app/v2/internal/model"
+)
+
+const skipIntegrationTestsMessage = `Set TEST_MINIFLUX_DATABASE_URL to run the storage integration tests`
+
+// newIntegrationTestStorage returns a storage connected to the migrated test database.
+func newIntegrationTestStorage(t *testing.T) *Storage {
+	t.Helper()
+
+	dsn := os.Getenv("TEST_MINIFLUX_DATABASE_URL")
+	if dsn == "" {
+		t.Skip(skipIntegrationTestsMessage)
+	}
+
+	var err error
+	if config.Opts, err = config.NewConfigParser().ParseEnvironmentVariables(); err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	db, err := database.NewConnectionPool(dsn, 1, 5, time.Minute)
+	if err != nil {
+		t.Fatalf(`Unable to connect to the database: %v`, err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	if err := database.Migrate(db); err != nil {
+		t.Fatalf(`Unable to migrate the database: %v`, err)
+	}
+
+	return NewStorage(db)
+}
+
+// createIntegrationTestFeed creates a user with a feed, both removed at the end of the test.
+func createIntegrationTestFeed(t *testing.T, store *Storage) (*model.User, *model.Feed) {
+	t.Helper()
+
+	user, err := store.CreateUser(&model.UserCreationRequest{Username: "storage_test_" + crypto.GenerateRandomStringHex(8)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { store.RemoveUser(user.ID) })
+
+	category, err := store.FirstCategory(user.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	feed := &model.Feed{
+		UserID:   user.ID,
+		FeedURL:  "https://example.org/feed.xml",
+		SiteURL:  "https://example.org/",
+		Title:    "Example",
+		Category: category,
+	}
+	if err := store.CreateFeed(feed); err != nil {
+		t.Fatal(err)
+	}
+
+	return user, feed
+}
+
+func TestCreateAndUpdateFeedWithoutSkipHints(t *testing.T) {
+	store := newIntegrationTestStorage(t)
+	user, feed := createIntegrationTestFeed(t, store)
+
+	if feed.SkipHours != nil || feed.SkipDays != nil {
+		t.Fatal(`The feed should not have skip hints`)
+	}
+
+	if err := store.UpdateFeed(feed); err != nil {
+		t.Fatalf(`Updating a feed without skip hints should not fail: %v`, err)
+	}
+
+	feed.SkipHours = []int64{0, 1}
+	feed.SkipDays = []int64{0}
+	if err := store.UpdateFeed(feed); err != nil {
+		t.Fatal(err)
+	}
+
+	storedFeed, err := store.FeedByID(user.ID, feed.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(storedFeed.SkipHours) != 2 || len(storedFeed.SkipDays) != 1 {
+		t.Errorf(`Unexpected skip hints, got hours %v and days %v`, storedFeed.SkipHours, storedFeed.SkipDays)
+	}
+}
+
+func TestRefreshFeedEntriesUpdatesExistingEntry(t *testing.T) {
+	store := newIntegrationTestStorage(t)
+	user, feed := createIntegrationTestFeed(t, store)
+
+	newEntry := func(title, content string) *model.Entry {
+		return &model.Entry{
+			Hash:    "advisory",
+			Title:   title,
+			URL:     "https://example.org/advisory",
+			Content: content,
+			Date:    time.Now(),
+			Tags:    []string{},
+		}
+	}
+
+	newEntries, _, err := store.RefreshFeedEntries(user.ID, feed.ID, model.Entries{newEntry("Advisory", "<p>Version 1.0 is affected.</p>")}, true, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(newEntries) != 1 {
+		t.Fatalf(`One entry should be created, got %d`, len(newEntries))
+	}
+	entryID := newEntries[0].ID
+
+	if err := store.SetEntriesStatus(user.ID, []int64{entryID}, model.EntryStatusRead); err != nil {
+		t.Fatal(err)
+	}
+
+	newEntries, updatedEntriesCount, err := store.RefreshFeedEntries(user.ID, feed.ID, model.Entries{newEntry("Advisory (updated)", "<p>Versions 1.0 and 1.1 are affected, upgrade to 1.2.</p>")}, true, 20)
+	if err != nil {
+		t.Fatalf(`Updating an existing entry should not fail: %v`, err)
+	}
+
+	if len(newEntries) != 0 || updatedEntriesCount != 1 {
+		t.Fatalf(`The existing entry should be updated, got %d new entries and %d updated entries`, len(newEntries), updatedEntriesCount)
+	}
+
+	entry, err := store.NewEntryQueryBuilder(user.ID).WithEntryIDs(entryID).GetEntry()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if entry.Title != "Advisory (updated)" {
+		t.Errorf(`The title should be updated, got %q`, entry.Title)
+	}
+
+	if entry.Status != model.EntryStatusUnread {
+		t.Errorf(`The entry should be marked as unread, got %q`, entry.Status)
+	}
+
+	revisions, err := store.EntryRevisions(entryID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(revisions) != 1 || revisions[0].Title != "Advisory" || revisions[0].Content != "<p>Version 1.0 is affected.</p>" {
+		t.Fatalf(`The previous version should be kept, got %+v`, revisions)
+	}
+
+	if _, _, err := store.RefreshFeedEntries(user.ID, feed.ID, model.Entries{newEntry("Advisory (updated)", "<div>Versions 1.0 and 1.1 are affected, upgrade to 1.2.</div>")}, true, 20); err != nil {
+		t.Fatal(err)
+	}
+
+	if revisions, _ := store.EntryRevisions(entryID); len(revisions) != 1 {
+		t.Errorf(`Changes limited to the markup should not create a revision, got %d revisions`, len(revisions))
+	}
+}
+
+func TestUpdateEntryTitleAndContent(t *testing.T) {
+	store := newIntegrationTestStorage(t)
+	user, feed := createIntegrationTestFeed(t, store)
+
+	entry := &model.Entry{Hash: "scraped", Title: "Title", URL: "https://example.org/scraped", Date: time.Now(), Tags: []string{}}
+	if _, _, err := store.RefreshFeedEntries(user.ID, feed.ID, model.Entries{entry}, true, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	entry.Content = "<p>Original content</p>"
+	entry.ThumbnailURL = "https://example.org/thumbnail.png"
+	entry.ThumbnailWidth = 640
+	entry.ThumbnailHeight = 480
+	if err := store.UpdateEntryTitleAndContent(entry); err != nil {
+		t.Fatalf(`Updating the entry content should not fail: %v`, err)
+	}
+
+	storedEntry, err := store.NewEntryQueryBuilder(user.ID).WithEntryIDs(entry.ID).GetEntry()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if storedEntry.Content != entry.Content || storedEntry.ThumbnailURL != entry.ThumbnailURL {
+		t.Errorf(`Unexpected entry, got content %q and thumbnail %q`, storedEntry.Content, storedEntry.ThumbnailURL)
+	}
+}
+
+func TestGetEntryFetchesDetailsOnlyWhenRequested(t *testing.T) {
+	store := newIntegrationTestStorage(t)
+	user, feed := createIntegrationTestFeed(t, store)
+
+	entry := &model.Entry{Hash: "details", Title: "Title", URL: "https://example.org/details", Date: time.Now(), Tags: []string{}}
+	if _, _, err := store.RefreshFeedEntries(user.ID, feed.ID, model.Entries{entry}, true, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	label, err := store.CreateLabel(user.ID, &model.LabelCreationRequest{Title: "Security"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := store.AttachLabel(user.ID, entry.ID, label.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := store.CreateHighlight(user.ID, entry.ID, &model.HighlightCreationRequest{Quote: "Title"}); err != nil {
+		t.Fatal(err)
+	}
+
+	storedEntry, err := store.NewEntryQueryBuilder(user.ID).WithEntryIDs(entry.ID).GetEntry()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if storedEntry.Labels != nil || storedEntry.Highlights != nil {
+		t.Errorf(`The labels and highlights should not be fetched by default`)
+	}
+
+	storedEntry, err = store.NewEntryQueryBuilder(user.ID).WithEntryIDs(entry.ID).WithEntryDetails().GetEntry()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(storedEntry.Labels) != 1 || len(storedEntry.Highlights) != 1 {
+		t.Errorf(`Unexpected details, got %d labels and %d highlights`, len(storedEntry.Labels), len(storedEntry.Highlights))
+	}
+}
+
+func TestTrigramIndexesAreLookedUpOnce(t *testing.T) {
+	store := newIntegrationTestStorage(t)
+	hasTrigramIndexes := store.hasTrigramIndexes()
+
+	// The database is not reachable anymore, the cached result is returned.
+	store.db.Close()
+
+	if store.hasTrigramIndexes() != hasTrigramIndexes {
+		t.Error(`The trigram indexes should be looked up only once`)
+	}
+}
+
+func TestTrigramIndexesLookupErrorIsNotCached(t *testing.T) {
+	store := newIntegrationTestStorage(t)
+	store.db.Close()
+
+	if store.hasTrigramIndexes() {
+		t.Error(`The fuzzy search should be disabled when the lookup fails`)
+	}
+
+	if store.trigramIndexesChecked {
+		t.Error(`A failed lookup should not be cached`)
+	}
+}
+
+func TestFuzzySearchCountsEntries(t *testing.T) {
+	store := newIntegrationTestStorage(t)
+	user, feed := createIntegrationTestFeed(t, store)
+
+	entries := model.Entries{
+		{Hash: "kubernetes", Title: "Kubernetes release", URL: "https://example.org/kubernetes", Date: time.Now(), Tags: []string{}},
+		{Hash: "postgresql", Title: "PostgreSQL release", URL: "https://example.org/postgresql", Date: time.Now(), Tags: []string{}},
+	}
+	if _, _, err := store.RefreshFeedEntries(user.ID, feed.ID, entries, true, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := store.NewEntryQueryBuilder(user.ID).WithFuzzySearchQuery("release").CountEntries()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if count != 2 {
+		t.Errorf(`Unexpected number of entries, got %d instead of 2`, count)
+	}
+}
+
+func TestWebSubSubscriptionsToRenewSkipsDisabledFeeds(t *testing.T) {
+	store := newIntegrationTestStorage(t)
+	user, feed := createIntegrationTestFeed(t, store)
+
+	if _, err := store.CreateWebSubSubscription(user.ID, feed.ID, "https://hub.example.org/", feed.FeedURL); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := store.ActivateWebSubSubscription(feed.ID, time.Minute); err != nil {
+		t.Fatal(err)
+	}
+
+	containsFeed := func() bool {
+		subscriptions, err := store.WebSubSubscriptionsToRenew(time.Now().Add(time.Hour))
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, subscription := range subscriptions {
+			if subscription.FeedID == feed.ID {
+				return true
+			}
+		}
+		return false
+	}
+
+	if !containsFeed() {
+		t.Fatal(`The subscription should be renewed`)
+	}
+
+	feed.Disabled = true
+	if err := store.UpdateFeed(feed); err != nil {
+		t.Fatal(err)
+	}
+
+	if containsFeed() {
+		t.Error(`The subscription of a disabled feed should not be renewed`)
+	}
+}
+
+func TestUpdateRemovesStaleFilterRuleStats(t *testing.T) {
+	store := newIntegrationTestStorage(t)
+	user, feed := createIntegrationTestFeed(t, store)
+
+	user.BlockFilterEntryRules = "EntryTitle=old\nEntryTitle=kept"
+	feed.BlocklistRules = "(?i)spam"
+	now := time.Now()
+	stats := model.FilterRuleStats{
+		{UserID: user.ID, Rule: "EntryTitle=old", HitCount: 1, LastMatchedAt: now},
+		{UserID: user.ID, Rule: "EntryTitle=kept", HitCount: 1, LastMatchedAt: now},
+		{UserID: user.ID, FeedID: feed.ID, Rule: "(?i)spam", HitCount: 1, LastMatchedAt: now},
+	}
+	if err := store.UpdateFilterRuleStats(stats); err != nil {
+		t.Fatal(err)
+	}
+
+	user.BlockFilterEntryRules = "EntryTitle = kept"
+	if err := store.UpdateUser(user); err != nil {
+		t.Fatal(err)
+	}
+
+	feed.BlocklistRules = ""
+	if err := store.UpdateFeed(feed); err != nil {
+		t.Fatal(err)
+	}
+
+	stored, err := store.FilterRuleStats(user.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(stored) != 1 || stored.Find(0, "EntryTitle=kept") == nil {
+		t.Errorf(`Only the statistics of the remaining rule should be kept, got %d rows`, len(stored))
+	}
+}
+
+func TestSavedSearchesWithUnreadCount(t *testing.T) {
+	store := newIntegrationTestStorage(t)
+	user, feed := createIntegrationTestFeed(t, store)
+
+	entries := model.Entries{
+		{Hash: "kubernetes", Title: "Kubernetes release", URL: "https://example.org/kubernetes", Date: time.Now(), Tags: []string{"go"}},
+		{Hash: "postgresql", Title: "PostgreSQL release", URL: "https://example.org/postgresql", Date: time.Now(), Tags: []string{}},
+		{Hash: "changelog", Title: "Changelog", URL: "https://example.org/changelog", Date: time.Now(), Tags: []string{"go"}},
+	}
+	newEntries, _, err := store.RefreshFeedEntries(user.ID, feed.ID, entries, true, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, entry := range newEntries {
+		if entry.Hash == "changelog" {
+			if err := store.SetEntriesStatus(user.ID, []int64{entry.ID}, model.EntryStatusRead); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	expectedCounts := map[string]int{"Releases": 2, "Go": 1, "Everything": 2, "Other feed": 0}
+	requests := []*model.SavedSearchCreationRequest{
+		{Title: "Releases", SearchQuery: "release"},
+		{Title: "Go", Tags: []string{"go"}},
+		{Title: "Everything"},
+		{Title: "Other feed", FeedIDs: []int64{feed.ID + 1}},
+	}
+	for _, request := range requests {
+		if _, err := store.CreateSavedSearch(user.ID, request); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	savedSearches, err := store.SavedSearchesWithUnreadCount(user.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, savedSearch := range savedSearches {
+		if savedSearch.TotalUnread == nil || *savedSearch.TotalUnread != expectedCounts[savedSearch.Title] {
+			t.Errorf(`Unexpected unread count for %q, got %v instead of %d`, savedSearch.Title, savedSearch.TotalUnread, expectedCounts[savedSearch.Title])
+		}
+	}
+}
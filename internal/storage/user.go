@@ -99,7 +99,11 @@ func (s *Storage) CreateUser(userCreationRequest *model.UserCreationRequest) (*m
 			block_filter_entry_rules,
 			keep_filter_entry_rules,
 			always_open_external_links,
-			open_external_links_in_new_tab
+			open_external_links_in_new_tab,
+			entry_list_layout,
+			mark_duplicate_entries_as_read,
+			group_entries_by_story,
+			action_entry_rules
 	`
 
 	tx, err := s.db.Begin()
@@ -145,6 +149,10 @@ func (s *Storage) CreateUser(userCreationRequest *model.UserCreationRequest) (*m
 		&user.KeepFilterEntryRules,
 		&user.AlwaysOpenExternalLinks,
 		&user.OpenExternalLinksInNewTab,
+		&user.EntryListLayout,
+		&user.MarkDuplicateEntriesAsRead,
+		&user.GroupEntriesByStory,
+		&user.ActionEntryRules,
 	)
 	if err != nil {
 		tx.Rollback()
@@ -211,9 +219,13 @@ func (s *Storage) UpdateUser(user *model.User) error {
 				block_filter_entry_rules=$27,
 				keep_filter_entry_rules=$28,
 				always_open_external_links=$29,
-				open_external_links_in_new_tab=$30
+				open_external_links_in_new_tab=$30,
+				entry_list_layout=$31,
+				mark_duplicate_entries_as_read=$32,
+				group_entries_by_story=$33,
+				action_entry_rules=$34
 			WHERE
-				id=$31
+				id=$35
 		`
 
 		_, err = s.db.Exec(
@@ -248,6 +260,10 @@ func (s *Storage) UpdateUser(user *model.User) error {
 			user.KeepFilterEntryRules,
 			user.AlwaysOpenExternalLinks,
 			user.OpenExternalLinksInNewTab,
+			user.EntryListLayout,
+			user.MarkDuplicateEntriesAsRead,
+			user.GroupEntriesByStory,
+			user.ActionEntryRules,
 			user.ID,
 		)
 		if err != nil {
@@ -284,9 +300,13 @@ func (s *Storage) UpdateUser(user *model.User) error {
 				block_filter_entry_rules=$26,
 				keep_filter_entry_rules=$27,
 				always_open_external_links=$28,
-				open_external_links_in_new_tab=$29
+				open_external_links_in_new_tab=$29,
+				entry_list_layout=$30,
+				mark_duplicate_entries_as_read=$31,
+				group_entries_by_story=$32,
+				action_entry_rules=$33
 			WHERE
-				id=$30
+				id=$34
 		`
 
 		_, err := s.db.Exec(
@@ -320,6 +340,10 @@ func (s *Storage) UpdateUser(user *model.User) error {
 			user.KeepFilterEntryRules,
 			user.AlwaysOpenExternalLinks,
 			user.OpenExternalLinksInNewTab,
+			user.EntryListLayout,
+			user.MarkDuplicateEntriesAsRead,
+			user.GroupEntriesByStory,
+			user.ActionEntryRules,
 			user.ID,
 		)
 
@@ -328,7 +352,7 @@ func (s *Storage) UpdateUser(user *model.User) error {
 		}
 	}
 
-	return nil
+	return s.removeStaleUserFilterRuleStats(user)
 }
 
 // UserLanguage returns the language of the given user, or "en_US" if the lookup fails.
@@ -375,7 +399,11 @@ func (s *Storage) UserByID(userID int64) (*model.User, error) {
 			block_filter_entry_rules,
 			keep_filter_entry_rules,
 			always_open_external_links,
-			open_external_links_in_new_tab
+			open_external_links_in_new_tab,
+			entry_list_layout,
+			mark_duplicate_entries_as_read,
+			group_entries_by_story,
+			action_entry_rules
 		FROM
 			users
 		WHERE
@@ -418,7 +446,11 @@ func (s *Storage) UserByUsername(username string) (*model.User, error) {
 			block_filter_entry_rules,
 			keep_filter_entry_rules,
 			always_open_external_links,
-			open_external_links_in_new_tab
+			open_external_links_in_new_tab,
+			entry_list_layout,
+			mark_duplicate_entries_as_read,
+			group_entries_by_story,
+			action_entry_rules
 		FROM
 			users
 		WHERE
@@ -461,7 +493,11 @@ func (s *Storage) UserByField(field, value string) (*model.User, error) {
 			block_filter_entry_rules,
 			keep_filter_entry_rules,
 			always_open_external_links,
-			open_external_links_in_new_tab
+			open_external_links_in_new_tab,
+			entry_list_layout,
+			mark_duplicate_entries_as_read,
+			group_entries_by_story,
+			action_entry_rules
 		FROM
 			users
 		WHERE
@@ -512,7 +548,11 @@ func (s *Storage) UserByAPIKey(token string) (*model.User, error) {
 			u.block_filter_entry_rules,
 			u.keep_filter_entry_rules,
 			u.always_open_external_links,
-			u.open_external_links_in_new_tab
+			u.open_external_links_in_new_tab,
+			u.entry_list_layout,
+			u.mark_duplicate_entries_as_read,
+			u.group_entries_by_story,
+			u.action_entry_rules
 		FROM
 			users u
 		INNER JOIN
@@ -557,6 +597,10 @@ func (s *Storage) fetchUser(query string, args ...any) (*model.User, error) {
 		&user.KeepFilterEntryRules,
 		&user.AlwaysOpenExternalLinks,
 		&user.OpenExternalLinksInNewTab,
+		&user.EntryListLayout,
+		&user.MarkDuplicateEntriesAsRead,
+		&user.GroupEntriesByStory,
+		&user.ActionEntryRules,
 	)
 
 	if errors.Is(err, sql.ErrNoRows) {
@@ -610,7 +654,11 @@ func (s *Storage) Users() (model.Users, error) {
 			block_filter_entry_rules,
 			keep_filter_entry_rules,
 			always_open_external_links,
-			open_external_links_in_new_tab
+			open_external_links_in_new_tab,
+			entry_list_layout,
+			mark_duplicate_entries_as_read,
+			group_entries_by_story,
+			action_entry_rules
 		FROM
 			users
 		ORDER BY username ASC
@@ -656,6 +704,10 @@ func (s *Storage) Users() (model.Users, error) {
 			&user.KeepFilterEntryRules,
 			&user.AlwaysOpenExternalLinks,
 			&user.OpenExternalLinksInNewTab,
+			&user.EntryListLayout,
+			&user.MarkDuplicateEntriesAsRead,
+			&user.GroupEntriesByStory,
+			&user.ActionEntryRules,
 		)
 
 		if err != nil {
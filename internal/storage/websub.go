@@ -0,0 +1,190 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package storage // import "miniflux.app/v2/internal/storage"
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"miniflux.app/v2/internal/crypto"
+	"miniflux.app/v2/internal/model"
+)
+
+const webSubSubscriptionColumns = `
+	feed_id,
+	user_id,
+	hub_url,
+	topic_url,
+	secret,
+	callback_token,
+	state,
+	lease_expires_at,
+	created_at,
+	updated_at
+`
+
+// CreateWebSubSubscription creates or resets the pending WebSub subscription of a feed.
+// A new secret and callback token are generated each time, so a previous hub cannot push content anymore.
+func (s *Storage) CreateWebSubSubscription(userID, feedID int64, hubURL, topicURL string) (*model.WebSubSubscription, error) {
+	query := `
+		INSERT INTO websub_subscriptions
+			(feed_id, user_id, hub_url, topic_url, secret, callback_token, state)
+		VALUES
+			($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (feed_id) DO UPDATE SET
+			hub_url=EXCLUDED.hub_url,
+			topic_url=EXCLUDED.topic_url,
+			secret=EXCLUDED.secret,
+			callback_token=EXCLUDED.callback_token,
+			state=EXCLUDED.state,
+			lease_expires_at=NULL,
+			updated_at=now()
+		RETURNING` + webSubSubscriptionColumns
+
+	subscription, err := scanWebSubSubscription(s.db.QueryRow(
+		query,
+		feedID,
+		userID,
+		hubURL,
+		topicURL,
+		crypto.GenerateRandomStringHex(32),
+		crypto.GenerateRandomStringHex(32),
+		model.WebSubStatePending,
+	))
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to create WebSub subscription for feed #%d: %v`, feedID, err)
+	}
+
+	return subscription, nil
+}
+
+// WebSubSubscriptionByFeedID returns the WebSub subscription of a feed, if any.
+func (s *Storage) WebSubSubscriptionByFeedID(userID, feedID int64) (*model.WebSubSubscription, error) {
+	query := `SELECT` + webSubSubscriptionColumns + `FROM websub_subscriptions WHERE user_id=$1 AND feed_id=$2`
+	subscription, err := scanWebSubSubscription(s.db.QueryRow(query, userID, feedID))
+
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf(`store: unable to fetch WebSub subscription for feed #%d: %v`, feedID, err)
+	}
+
+	return subscription, nil
+}
+
+// WebSubSubscriptionByCallbackToken returns the WebSub subscription matching the callback token, if any.
+func (s *Storage) WebSubSubscriptionByCallbackToken(callbackToken string) (*model.WebSubSubscription, error) {
+	query := `SELECT` + webSubSubscriptionColumns + `FROM websub_subscriptions WHERE callback_token=$1`
+	subscription, err := scanWebSubSubscription(s.db.QueryRow(query, callbackToken))
+
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf(`store: unable to fetch WebSub subscription: %v`, err)
+	}
+
+	return subscription, nil
+}
+
+// WebSubSubscriptionsToRenew returns active subscriptions whose lease expires before the given date.
+// The subscriptions of disabled feeds are not renewed, the hub stops pushing content when their lease expires.
+func (s *Storage) WebSubSubscriptionsToRenew(before time.Time) (model.WebSubSubscriptions, error) {
+	query := `
+		SELECT` + webSubSubscriptionColumns + `
+		FROM
+			websub_subscriptions
+		WHERE
+			state=$1 AND lease_expires_at < $2 AND
+			feed_id IN (SELECT id FROM feeds WHERE disabled is false)
+		ORDER BY lease_expires_at ASC
+	`
+	rows, err := s.db.Query(query, model.WebSubStateActive, before)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch WebSub subscriptions to renew: %v`, err)
+	}
+	defer rows.Close()
+
+	subscriptions := make(model.WebSubSubscriptions, 0)
+	for rows.Next() {
+		subscription, err := scanWebSubSubscription(rows)
+		if err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch WebSub subscription row: %v`, err)
+		}
+		subscriptions = append(subscriptions, subscription)
+	}
+
+	return subscriptions, nil
+}
+
+// ActivateWebSubSubscription marks a subscription as verified by the hub for the given lease duration.
+func (s *Storage) ActivateWebSubSubscription(feedID int64, leaseDuration time.Duration) error {
+	query := `
+		UPDATE
+			websub_subscriptions
+		SET
+			state=$1,
+			lease_expires_at=$2,
+			updated_at=now()
+		WHERE
+			feed_id=$3
+	`
+	if _, err := s.db.Exec(query, model.WebSubStateActive, time.Now().Add(leaseDuration), feedID); err != nil {
+		return fmt.Errorf(`store: unable to activate WebSub subscription for feed #%d: %v`, feedID, err)
+	}
+
+	return nil
+}
+
+// DenyWebSubSubscription marks a subscription as refused by the hub.
+func (s *Storage) DenyWebSubSubscription(feedID int64) error {
+	query := `
+		UPDATE
+			websub_subscriptions
+		SET
+			state=$1,
+			lease_expires_at=NULL,
+			updated_at=now()
+		WHERE
+			feed_id=$2
+	`
+	if _, err := s.db.Exec(query, model.WebSubStateDenied, feedID); err != nil {
+		return fmt.Errorf(`store: unable to deny WebSub subscription for feed #%d: %v`, feedID, err)
+	}
+
+	return nil
+}
+
+type webSubSubscriptionScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanWebSubSubscription(scanner webSubSubscriptionScanner) (*model.WebSubSubscription, error) {
+	var subscription model.WebSubSubscription
+	var leaseExpiresAt sql.NullTime
+
+	if err := scanner.Scan(
+		&subscription.FeedID,
+		&subscription.UserID,
+		&subscription.HubURL,
+		&subscription.TopicURL,
+		&subscription.Secret,
+		&subscription.CallbackToken,
+		&subscription.State,
+		&leaseExpiresAt,
+		&subscription.CreatedAt,
+		&subscription.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	if leaseExpiresAt.Valid {
+		subscription.LeaseExpiresAt = &leaseExpiresAt.Time
+	}
+
+	return &subscription, nil
+}
@@ -35,36 +35,49 @@ func NewEngine(basePath string) *Engine {
 func (e *Engine) ParseTemplates() {
 	funcMap := e.funcMap.Map()
 	templates := map[string][]string{ // this isn't a global variable so that it can be garbage-collected.
-		"about.html":               {"layout.html", "settings_menu.html"},
-		"add_subscription.html":    {"feed_menu.html", "layout.html", "settings_menu.html"},
-		"api_keys.html":            {"layout.html", "settings_menu.html"},
-		"starred_entries.html":     {"item_meta.html", "layout.html", "pagination.html"},
-		"categories.html":          {"layout.html"},
-		"category_entries.html":    {"item_meta.html", "layout.html", "pagination.html"},
-		"category_feeds.html":      {"feed_list.html", "layout.html"},
-		"choose_subscription.html": {"feed_menu.html", "layout.html"},
-		"create_api_key.html":      {"layout.html", "settings_menu.html"},
-		"create_category.html":     {"layout.html"},
-		"create_user.html":         {"layout.html", "settings_menu.html"},
-		"edit_category.html":       {"layout.html", "settings_menu.html"},
-		"edit_feed.html":           {"layout.html"},
-		"edit_user.html":           {"layout.html", "settings_menu.html"},
-		"entry.html":               {"layout.html"},
-		"feed_entries.html":        {"item_meta.html", "layout.html", "pagination.html"},
-		"feeds.html":               {"feed_list.html", "feed_menu.html", "item_meta.html", "layout.html", "pagination.html"},
-		"history_entries.html":     {"item_meta.html", "layout.html", "pagination.html"},
-		"import.html":              {"feed_menu.html", "layout.html"},
-		"integrations.html":        {"layout.html", "settings_menu.html"},
-		"login.html":               {"layout.html"},
-		"offline.html":             {},
-		"search.html":              {"item_meta.html", "layout.html", "pagination.html"},
-		"sessions.html":            {"layout.html", "settings_menu.html"},
-		"settings.html":            {"layout.html", "settings_menu.html"},
-		"shared_entries.html":      {"layout.html", "pagination.html"},
-		"tag_entries.html":         {"item_meta.html", "layout.html", "pagination.html"},
-		"unread_entries.html":      {"item_meta.html", "layout.html", "pagination.html"},
-		"users.html":               {"layout.html", "settings_menu.html"},
-		"webauthn_rename.html":     {"layout.html"},
+		"about.html":                {"layout.html", "settings_menu.html"},
+		"add_subscription.html":     {"feed_menu.html", "layout.html", "settings_menu.html"},
+		"api_keys.html":             {"layout.html", "settings_menu.html"},
+		"starred_entries.html":      {"item_meta.html", "layout.html", "pagination.html"},
+		"categories.html":           {"layout.html"},
+		"category_entries.html":     {"item_meta.html", "layout.html", "pagination.html"},
+		"category_feeds.html":       {"feed_list.html", "layout.html"},
+		"choose_subscription.html":  {"feed_menu.html", "layout.html"},
+		"create_api_key.html":       {"layout.html", "settings_menu.html"},
+		"create_category.html":      {"layout.html"},
+		"create_newsletter.html":    {"feed_menu.html", "layout.html"},
+		"create_public_feed.html":   {"layout.html", "settings_menu.html"},
+		"create_saved_search.html":  {"layout.html", "saved_search_form.html"},
+		"create_user.html":          {"layout.html", "settings_menu.html"},
+		"edit_category.html":        {"filter_apply_form.html", "layout.html", "settings_menu.html"},
+		"edit_feed.html":            {"filter_apply_form.html", "filter_rule_stats.html", "layout.html"},
+		"edit_saved_search.html":    {"layout.html", "saved_search_form.html"},
+		"edit_user.html":            {"layout.html", "settings_menu.html"},
+		"entry.html":                {"layout.html"},
+		"feed_entries.html":         {"item_meta.html", "layout.html", "pagination.html"},
+		"feed_preview.html":         {"layout.html"},
+		"filter_simulation.html":    {"layout.html"},
+		"feeds.html":                {"feed_list.html", "feed_menu.html", "item_meta.html", "layout.html", "pagination.html"},
+		"highlights.html":           {"layout.html", "pagination.html"},
+		"history_entries.html":      {"item_meta.html", "layout.html", "pagination.html"},
+		"import.html":               {"feed_menu.html", "layout.html"},
+		"integrations.html":         {"layout.html", "settings_menu.html"},
+		"label_entries.html":        {"item_meta.html", "layout.html", "pagination.html"},
+		"labels.html":               {"layout.html"},
+		"login.html":                {"layout.html"},
+		"newsletters.html":          {"feed_menu.html", "layout.html"},
+		"offline.html":              {},
+		"public_feeds.html":         {"layout.html", "settings_menu.html"},
+		"saved_search_entries.html": {"item_meta.html", "layout.html", "pagination.html"},
+		"saved_searches.html":       {"layout.html"},
+		"search.html":               {"item_meta.html", "layout.html", "pagination.html"},
+		"sessions.html":             {"layout.html", "settings_menu.html"},
+		"settings.html":             {"filter_apply_form.html", "filter_rule_stats.html", "layout.html", "settings_menu.html"},
+		"shared_entries.html":       {"layout.html", "pagination.html"},
+		"tag_entries.html":          {"item_meta.html", "layout.html", "pagination.html"},
+		"unread_entries.html":       {"item_meta.html", "layout.html", "pagination.html"},
+		"users.html":                {"layout.html", "settings_menu.html"},
+		"webauthn_rename.html":      {"layout.html"},
 	}
 
 	for name, dependencies := range templates {
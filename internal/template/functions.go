@@ -6,6 +6,7 @@ package template // import "miniflux.app/v2/internal/template"
 import (
 	"errors"
 	"fmt"
+	"html"
 	"html/template"
 	"math"
 	"net/mail"
@@ -21,6 +22,7 @@ import (
 	"miniflux.app/v2/internal/mediaproxy"
 	"miniflux.app/v2/internal/model"
 	"miniflux.app/v2/internal/reader/sanitizer"
+	"miniflux.app/v2/internal/reader/textdiff"
 	"miniflux.app/v2/internal/timezone"
 	"miniflux.app/v2/internal/ui/static"
 	"miniflux.app/v2/internal/urllib"
@@ -39,18 +41,21 @@ func (f *funcMap) Map() template.FuncMap {
 		f.iconPaths[filename] = f.basePath + "/icon/" + bundle.Checksum + "/" + filename
 	}
 	return template.FuncMap{
-		"contains":         strings.Contains,
-		"csp":              csp,
-		"startsWith":       strings.HasPrefix,
-		"formatFileSize":   formatFileSize,
-		"dict":             dict,
-		"truncate":         truncate,
-		"isEmail":          isEmail,
-		"baseURL":          config.Opts.BaseURL,
-		"apiEnabled":       config.Opts.HasAPI,
-		"rootURL":          config.Opts.RootURL,
-		"disableLocalAuth": config.Opts.DisableLocalAuth,
-		"oidcProviderName": config.Opts.OAuth2OIDCProviderName,
+		"contains":          strings.Contains,
+		"csp":               csp,
+		"diffText":          diffText,
+		"startsWith":        strings.HasPrefix,
+		"formatFileSize":    formatFileSize,
+		"formatTimestamp":   formatTimestamp,
+		"dict":              dict,
+		"truncate":          truncate,
+		"isEmail":           isEmail,
+		"baseURL":           config.Opts.BaseURL,
+		"apiEnabled":        config.Opts.HasAPI,
+		"newsletterEnabled": config.Opts.HasNewsletterReceiver,
+		"rootURL":           config.Opts.RootURL,
+		"disableLocalAuth":  config.Opts.DisableLocalAuth,
+		"oidcProviderName":  config.Opts.OAuth2OIDCProviderName,
 		"hasOAuth2Provider": func(provider string) bool {
 			return config.Opts.OAuth2Provider() == provider
 		},
@@ -86,7 +91,8 @@ func (f *funcMap) Map() template.FuncMap {
 		"mustBeProxyfied": func(mediaType string) bool {
 			return slices.Contains(config.Opts.MediaProxyResourceTypes(), mediaType)
 		},
-		"domain": urllib.Domain,
+		"domain":    urllib.Domain,
+		"stripTags": sanitizer.StripTags,
 		"replace": func(str, old, new string) string {
 			return strings.Replace(str, old, new, 1)
 		},
@@ -331,3 +337,34 @@ func formatFileSize(b int64) string {
 	number := math.Pow(unit, base-math.Floor(base))
 	return fmt.Sprintf("%.1f %ciB", number, "KMGTPE"[int64(base)-1])
 }
+
+// formatTimestamp formats a position in a media file, expressed in seconds, like media players do.
+func formatTimestamp(seconds float64) string {
+	total := int64(max(seconds, 0))
+	hours, minutes, remaining := total/3600, total%3600/60, total%60
+	if hours > 0 {
+		return fmt.Sprintf("%d:%02d:%02d", hours, minutes, remaining)
+	}
+	return fmt.Sprintf("%d:%02d", minutes, remaining)
+}
+
+// diffText returns the words changed between two versions of a text, marked with ins and del elements.
+func diffText(before, after string) template.HTML {
+	var sb strings.Builder
+	for i, chunk := range textdiff.Diff(before, after) {
+		if i > 0 {
+			sb.WriteString(" ")
+		}
+
+		text := html.EscapeString(chunk.Text)
+		switch chunk.Operation {
+		case textdiff.Delete:
+			sb.WriteString("<del>" + text + "</del>")
+		case textdiff.Insert:
+			sb.WriteString("<ins>" + text + "</ins>")
+		default:
+			sb.WriteString(text)
+		}
+	}
+	return template.HTML(sb.String())
+}
@@ -234,6 +234,26 @@ func TestFormatFileSize(t *testing.T) {
 	}
 }
 
+func TestFormatTimestamp(t *testing.T) {
+	scenarios := []struct {
+		input    float64
+		expected string
+	}{
+		{-1, "0:00"},
+		{0, "0:00"},
+		{73.5, "1:13"},
+		{3600, "1:00:00"},
+		{4997, "1:23:17"},
+	}
+
+	for _, scenario := range scenarios {
+		result := formatTimestamp(scenario.input)
+		if result != scenario.expected {
+			t.Errorf(`Unexpected result, got %q instead of %q for %v`, result, scenario.expected, scenario.input)
+		}
+	}
+}
+
 func TestQueryString(t *testing.T) {
 	params, err := dict("q", "ai", "unread", true, "offset", 20)
 	if err != nil {
@@ -360,3 +380,15 @@ func TestCSPExternalFontStylesheet(t *testing.T) {
 		}
 	}
 }
+
+func TestDiffText(t *testing.T) {
+	result := diffText("Version 1.0 fixes <script> issues", "Version 1.1 fixes <script> issues today")
+	expected := template.HTML("Version <del>1.0</del> <ins>1.1</ins> fixes &lt;script&gt; issues <ins>today</ins>")
+	if result != expected {
+		t.Errorf(`Unexpected diff, got %q instead of %q`, result, expected)
+	}
+
+	if result := diffText("", ""); result != "" {
+		t.Errorf(`An empty diff was expected, got %q`, result)
+	}
+}
@@ -30,6 +30,12 @@ func (h *handler) showEditCategoryPage(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	filterApplyJob, err := h.store.LatestFilterApplyJob(user.ID, 0, category.ID)
+	if err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
 	categoryForm := form.CategoryForm{
 		Title:        category.Title,
 		HideGlobally: category.HideGlobally,
@@ -38,6 +44,7 @@ func (h *handler) showEditCategoryPage(w http.ResponseWriter, r *http.Request) {
 	view := view.New(h.tpl, r)
 	view.Set("form", categoryForm)
 	view.Set("category", category)
+	view.Set("filterApplyJob", filterApplyJob)
 	view.Set("menu", "categories")
 	view.Set("user", user)
 	navMetadata, _ := h.store.GetNavMetadata(user.ID)
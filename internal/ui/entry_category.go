@@ -25,6 +25,7 @@ func (h *handler) showCategoryEntryPage(w http.ResponseWriter, r *http.Request)
 	entry, err := h.store.NewEntryQueryBuilder(user.ID).
 		WithCategoryID(categoryID).
 		WithEntryIDs(entryID).
+		WithEntryDetails().
 		GetEntry()
 	if err != nil {
 		response.HTMLServerError(w, r, err)
@@ -0,0 +1,71 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ui // import "miniflux.app/v2/internal/ui"
+
+import (
+	json_parser "encoding/json"
+	"net/http"
+
+	"miniflux.app/v2/internal/http/request"
+	"miniflux.app/v2/internal/http/response"
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/validator"
+)
+
+func (h *handler) createEntryHighlight(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+	entryID := request.RouteInt64Param(r, "entryID")
+
+	entryCount, err := h.store.NewEntryQueryBuilder(userID).WithEntryIDs(entryID).CountEntries()
+	if err != nil {
+		response.JSONServerError(w, r, err)
+		return
+	}
+
+	if entryCount == 0 {
+		response.JSONNotFound(w, r)
+		return
+	}
+
+	var highlightCreationRequest model.HighlightCreationRequest
+	if err := json_parser.NewDecoder(r.Body).Decode(&highlightCreationRequest); err != nil {
+		response.JSONBadRequest(w, r, err)
+		return
+	}
+
+	if validationErr := validator.ValidateHighlightCreation(&highlightCreationRequest); validationErr != nil {
+		response.JSONBadRequest(w, r, validationErr.Error())
+		return
+	}
+
+	highlight, err := h.store.CreateHighlight(userID, entryID, &highlightCreationRequest)
+	if err != nil {
+		response.JSONServerError(w, r, err)
+		return
+	}
+
+	response.JSONCreated(w, r, highlight)
+}
+
+func (h *handler) removeEntryHighlight(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+
+	highlight, err := h.store.Highlight(userID, request.RouteInt64Param(r, "highlightID"))
+	if err != nil {
+		response.JSONServerError(w, r, err)
+		return
+	}
+
+	if highlight == nil || highlight.EntryID != request.RouteInt64Param(r, "entryID") {
+		response.JSONNotFound(w, r)
+		return
+	}
+
+	if err := h.store.RemoveHighlight(userID, highlight.ID); err != nil {
+		response.JSONServerError(w, r, err)
+		return
+	}
+
+	response.JSON(w, r, "OK")
+}
@@ -0,0 +1,118 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ui // import "miniflux.app/v2/internal/ui"
+
+import (
+	json_parser "encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"miniflux.app/v2/internal/http/request"
+	"miniflux.app/v2/internal/http/response"
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/ui/view"
+)
+
+func (h *handler) showLabelEntryPage(w http.ResponseWriter, r *http.Request) {
+	user, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	labelID := request.RouteInt64Param(r, "labelID")
+	entryID := request.RouteInt64Param(r, "entryID")
+
+	entry, err := h.store.NewEntryQueryBuilder(user.ID).
+		WithLabelID(labelID).
+		WithEntryIDs(entryID).
+		WithEntryDetails().
+		GetEntry()
+	if err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	if entry == nil {
+		response.HTMLNotFound(w, r)
+		return
+	}
+
+	if entry.ShouldMarkAsReadOnView(user) {
+		err = h.store.SetEntriesStatus(user.ID, []int64{entry.ID}, model.EntryStatusRead)
+		if err != nil {
+			response.HTMLServerError(w, r, err)
+			return
+		}
+
+		entry.Status = model.EntryStatusRead
+	}
+
+	prevEntry, nextEntry, err := h.store.NewEntryPaginationBuilder(user.ID, entry.ID, user.EntryOrder, user.EntryDirection).
+		WithLabelID(labelID).
+		Entries()
+	if err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	nextEntryRoute := ""
+	if nextEntry != nil {
+		nextEntryRoute = h.routePath("/label/%d/entry/%d", labelID, nextEntry.ID)
+	}
+
+	prevEntryRoute := ""
+	if prevEntry != nil {
+		prevEntryRoute = h.routePath("/label/%d/entry/%d", labelID, prevEntry.ID)
+	}
+
+	view := view.New(h.tpl, r)
+	view.Set("entry", entry)
+	view.Set("prevEntry", prevEntry)
+	view.Set("nextEntry", nextEntry)
+	view.Set("nextEntryRoute", nextEntryRoute)
+	view.Set("prevEntryRoute", prevEntryRoute)
+	view.Set("menu", "labels")
+	view.Set("user", user)
+	navMetadata, _ := h.store.GetNavMetadata(user.ID)
+	view.Set("countUnread", navMetadata.CountUnread)
+	view.Set("countErrorFeeds", navMetadata.CountErrorFeeds)
+	view.Set("hasSaveEntry", navMetadata.HasSaveEntry)
+
+	response.HTML(w, r, view.Render("entry"))
+}
+
+func (h *handler) attachEntryLabel(w http.ResponseWriter, r *http.Request) {
+	var labelCreationRequest model.LabelCreationRequest
+	if err := json_parser.NewDecoder(r.Body).Decode(&labelCreationRequest); err != nil {
+		response.JSONBadRequest(w, r, err)
+		return
+	}
+
+	title := strings.TrimSpace(labelCreationRequest.Title)
+	if title == "" {
+		response.JSONBadRequest(w, r, errors.New("the label title is required"))
+		return
+	}
+
+	entryID := request.RouteInt64Param(r, "entryID")
+	if err := h.store.AttachLabelsByTitle(request.UserID(r), []int64{entryID}, []string{title}); err != nil {
+		response.JSONServerError(w, r, err)
+		return
+	}
+
+	response.JSON(w, r, "OK")
+}
+
+func (h *handler) detachEntryLabel(w http.ResponseWriter, r *http.Request) {
+	entryID := request.RouteInt64Param(r, "entryID")
+	labelID := request.RouteInt64Param(r, "labelID")
+	if err := h.store.DetachLabel(request.UserID(r), entryID, labelID); err != nil {
+		response.JSONServerError(w, r, err)
+		return
+	}
+
+	response.JSON(w, r, "OK")
+}
@@ -16,6 +16,7 @@ func (h *handler) saveEntry(w http.ResponseWriter, r *http.Request) {
 
 	entry, err := h.store.NewEntryQueryBuilder(request.UserID(r)).
 		WithEntryIDs(entryID).
+		WithHighlights().
 		GetEntry()
 	if err != nil {
 		response.JSONServerError(w, r, err)
@@ -0,0 +1,90 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ui // import "miniflux.app/v2/internal/ui"
+
+import (
+	"net/http"
+
+	"miniflux.app/v2/internal/http/request"
+	"miniflux.app/v2/internal/http/response"
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/ui/view"
+)
+
+func (h *handler) showSavedSearchEntryPage(w http.ResponseWriter, r *http.Request) {
+	user, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	savedSearch, err := h.store.SavedSearch(user.ID, request.RouteInt64Param(r, "savedSearchID"))
+	if err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	if savedSearch == nil {
+		response.HTMLNotFound(w, r)
+		return
+	}
+
+	// The entry is not filtered by the saved search, it may have been marked as read in the meantime.
+	entry, err := h.store.NewEntryQueryBuilder(user.ID).
+		WithEntryIDs(request.RouteInt64Param(r, "entryID")).
+		WithEntryDetails().
+		GetEntry()
+	if err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	if entry == nil {
+		response.HTMLNotFound(w, r)
+		return
+	}
+
+	if entry.ShouldMarkAsReadOnView(user) {
+		err = h.store.SetEntriesStatus(user.ID, []int64{entry.ID}, model.EntryStatusRead)
+		if err != nil {
+			response.HTMLServerError(w, r, err)
+			return
+		}
+
+		entry.Status = model.EntryStatusRead
+	}
+
+	prevEntry, nextEntry, err := h.store.NewEntryPaginationBuilder(user.ID, entry.ID, user.EntryOrder, user.EntryDirection).
+		WithSavedSearch(savedSearch).
+		Entries()
+	if err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	nextEntryRoute := ""
+	if nextEntry != nil {
+		nextEntryRoute = h.routePath("/saved-search/%d/entry/%d", savedSearch.ID, nextEntry.ID)
+	}
+
+	prevEntryRoute := ""
+	if prevEntry != nil {
+		prevEntryRoute = h.routePath("/saved-search/%d/entry/%d", savedSearch.ID, prevEntry.ID)
+	}
+
+	view := view.New(h.tpl, r)
+	view.Set("entry", entry)
+	view.Set("prevEntry", prevEntry)
+	view.Set("nextEntry", nextEntry)
+	view.Set("nextEntryRoute", nextEntryRoute)
+	view.Set("prevEntryRoute", prevEntryRoute)
+	view.Set("menu", "search")
+	view.Set("user", user)
+	navMetadata, _ := h.store.GetNavMetadata(user.ID)
+	view.Set("countUnread", navMetadata.CountUnread)
+	view.Set("countErrorFeeds", navMetadata.CountErrorFeeds)
+	view.Set("hasSaveEntry", navMetadata.HasSaveEntry)
+
+	response.HTML(w, r, view.Render("entry"))
+}
@@ -22,11 +22,16 @@ func (h *handler) showSearchEntryPage(w http.ResponseWriter, r *http.Request) {
 	entryID := request.RouteInt64Param(r, "entryID")
 	searchQuery := request.QueryStringParam(r, "q", "")
 	unreadOnly := request.QueryBoolParam(r, "unread", false)
+	fuzzy := request.QueryBoolParam(r, "fuzzy", false)
 
-	entry, err := h.store.NewEntryQueryBuilder(user.ID).
-		WithSearchQuery(searchQuery).
-		WithEntryIDs(entryID).
-		GetEntry()
+	builder := h.store.NewEntryQueryBuilder(user.ID).WithEntryIDs(entryID)
+	if fuzzy {
+		builder = builder.WithFuzzySearchQuery(searchQuery)
+	} else {
+		builder = builder.WithSearchQuery(searchQuery)
+	}
+
+	entry, err := builder.WithEntryDetails().GetEntry()
 	if err != nil {
 		response.HTMLServerError(w, r, err)
 		return
@@ -52,8 +57,12 @@ func (h *handler) showSearchEntryPage(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	entryPaginationBuilder := h.store.NewEntryPaginationBuilder(user.ID, entry.ID, user.EntryOrder, user.EntryDirection).
-		WithSearchQuery(searchQuery)
+	entryPaginationBuilder := h.store.NewEntryPaginationBuilder(user.ID, entry.ID, user.EntryOrder, user.EntryDirection)
+	if fuzzy {
+		entryPaginationBuilder = entryPaginationBuilder.WithFuzzySearchQuery(searchQuery)
+	} else {
+		entryPaginationBuilder = entryPaginationBuilder.WithSearchQuery(searchQuery)
+	}
 	if unreadOnly {
 		if entry.Status == model.EntryStatusRead {
 			entryPaginationBuilder = entryPaginationBuilder.WithStatusOrEntryID(model.EntryStatusUnread, entry.ID)
@@ -81,6 +90,7 @@ func (h *handler) showSearchEntryPage(w http.ResponseWriter, r *http.Request) {
 	view := view.New(h.tpl, r)
 	view.Set("searchQuery", searchQuery)
 	view.Set("searchUnreadOnly", unreadOnly)
+	view.Set("searchFuzzy", fuzzy)
 	view.Set("entry", entry)
 	view.Set("prevEntry", prevEntry)
 	view.Set("nextEntry", nextEntry)
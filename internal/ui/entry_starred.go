@@ -23,6 +23,7 @@ func (h *handler) showStarredEntryPage(w http.ResponseWriter, r *http.Request) {
 
 	entry, err := h.store.NewEntryQueryBuilder(user.ID).
 		WithEntryIDs(entryID).
+		WithEntryDetails().
 		GetEntry()
 	if err != nil {
 		response.HTMLServerError(w, r, err)
@@ -30,6 +30,7 @@ func (h *handler) showTagEntryPage(w http.ResponseWriter, r *http.Request) {
 	entry, err := h.store.NewEntryQueryBuilder(user.ID).
 		WithTags(tagName).
 		WithEntryIDs(entryID).
+		WithEntryDetails().
 		GetEntry()
 	if err != nil {
 		response.HTMLServerError(w, r, err)
@@ -13,6 +13,9 @@ import (
 	"miniflux.app/v2/internal/ui/view"
 )
 
+// feedFetchHistoryLimit is the number of refresh attempts displayed on the feed edit page.
+const feedFetchHistoryLimit = 20
+
 func (h *handler) showEditFeedPage(w http.ResponseWriter, r *http.Request) {
 	user, err := h.store.UserByID(request.UserID(r))
 	if err != nil {
@@ -38,6 +41,24 @@ func (h *handler) showEditFeedPage(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	feedFetches, err := h.store.FeedFetches(user.ID, feed.ID, feedFetchHistoryLimit)
+	if err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	filterRuleStats, err := h.store.FilterRuleStats(user.ID)
+	if err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	filterApplyJob, err := h.store.LatestFilterApplyJob(user.ID, feed.ID, 0)
+	if err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
 	feedForm := form.FeedForm{
 		SiteURL:                     feed.SiteURL,
 		FeedURL:                     feed.FeedURL,
@@ -50,8 +71,10 @@ func (h *handler) showEditFeedPage(w http.ResponseWriter, r *http.Request) {
 		KeeplistRules:               feed.KeeplistRules,
 		BlockFilterEntryRules:       feed.BlockFilterEntryRules,
 		KeepFilterEntryRules:        feed.KeepFilterEntryRules,
+		ActionEntryRules:            feed.ActionEntryRules,
 		Crawler:                     feed.Crawler,
 		IgnoreEntryUpdates:          feed.IgnoreEntryUpdates,
+		MarkUnreadOnChangeThreshold: feed.MarkUnreadOnChangeThreshold,
 		UserAgent:                   feed.UserAgent,
 		Cookie:                      feed.Cookie,
 		CategoryID:                  feed.Category.ID,
@@ -73,12 +96,25 @@ func (h *handler) showEditFeedPage(w http.ResponseWriter, r *http.Request) {
 		PushoverEnabled:             feed.PushoverEnabled,
 		PushoverPriority:            feed.PushoverPriority,
 		ProxyURL:                    feed.ProxyURL,
+		PageItemSelector:            feed.PageItemSelector,
+		PageTitleSelector:           feed.PageTitleSelector,
+		PageLinkSelector:            feed.PageLinkSelector,
+		PageDateSelector:            feed.PageDateSelector,
+		PageContentSelector:         feed.PageContentSelector,
 	}
 
 	view := view.New(h.tpl, r)
 	view.Set("form", feedForm)
 	view.Set("categories", categories)
 	view.Set("feed", feed)
+	view.Set("feedFetches", feedFetches)
+	view.Set("filterApplyJob", filterApplyJob)
+	view.Set("filterRuleStats", filterRuleStatsRows(
+		filterRuleStats,
+		feed.ID,
+		[]string{feed.BlockFilterEntryRules, feed.KeepFilterEntryRules},
+		[]string{feed.BlocklistRules, feed.KeeplistRules},
+	))
 	view.Set("menu", "feeds")
 	view.Set("user", user)
 	navMetadata, _ := h.store.GetNavMetadata(user.ID)
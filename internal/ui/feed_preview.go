@@ -0,0 +1,65 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ui // import "miniflux.app/v2/internal/ui"
+
+import (
+	"net/http"
+
+	"miniflux.app/v2/internal/http/request"
+	"miniflux.app/v2/internal/http/response"
+	"miniflux.app/v2/internal/model"
+	feedHandler "miniflux.app/v2/internal/reader/handler"
+	"miniflux.app/v2/internal/reader/processor"
+	"miniflux.app/v2/internal/ui/form"
+	"miniflux.app/v2/internal/ui/view"
+)
+
+func (h *handler) showFeedPreviewPage(w http.ResponseWriter, r *http.Request) {
+	loggedUser, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	feedID := request.RouteInt64Param(r, "feedID")
+	feed, err := h.store.FeedByID(loggedUser.ID, feedID)
+	if err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	if feed == nil {
+		response.HTMLNotFound(w, r)
+		return
+	}
+
+	// Only the rules submitted with the form are applied, the feed is not saved.
+	feedForm := form.NewFeedForm(r)
+	feedPreviewRequest := &model.FeedPreviewRequest{
+		ScraperRules:    &feedForm.ScraperRules,
+		RewriteRules:    &feedForm.RewriteRules,
+		UrlRewriteRules: &feedForm.UrlRewriteRules,
+		Crawler:         &feedForm.Crawler,
+	}
+	feedPreviewRequest.Patch(feed)
+
+	view := view.New(h.tpl, r)
+	view.Set("feed", feed)
+
+	// The rules are applied to the entries as published, the stored entries have already been processed.
+	entries, localizedError := feedHandler.FetchFeedEntries(feed, model.DefaultFeedPreviewLimit)
+	if localizedError != nil {
+		view.Set("errorMessage", localizedError.Translate(loggedUser.Language))
+	} else {
+		view.Set("preview", processor.PreviewFeedEntries(feed, entries, loggedUser))
+	}
+
+	view.Set("menu", "feeds")
+	view.Set("user", loggedUser)
+	navMetadata, _ := h.store.GetNavMetadata(loggedUser.ID)
+	view.Set("countUnread", navMetadata.CountUnread)
+	view.Set("countErrorFeeds", navMetadata.CountErrorFeeds)
+
+	response.HTML(w, r, view.Render("feed_preview"))
+}
@@ -42,6 +42,11 @@ func (h *handler) updateFeed(w http.ResponseWriter, r *http.Request) {
 
 	feedForm := form.NewFeedForm(r)
 
+	// The URL of newsletter feeds identifies the sender and cannot be changed.
+	if feed.IsNewsletter() {
+		feedForm.FeedURL = feed.FeedURL
+	}
+
 	view := view.New(h.tpl, r)
 	view.Set("form", feedForm)
 	view.Set("categories", categories)
@@ -54,17 +59,28 @@ func (h *handler) updateFeed(w http.ResponseWriter, r *http.Request) {
 	view.Set("defaultUserAgent", config.Opts.HTTPClientUserAgent())
 
 	feedModificationRequest := &model.FeedModificationRequest{
-		FeedURL:               model.OptionalString(feedForm.FeedURL),
-		SiteURL:               model.OptionalString(feedForm.SiteURL),
-		Title:                 model.OptionalString(feedForm.Title),
-		Description:           model.OptionalString(feedForm.Description),
-		CategoryID:            model.OptionalNumber(feedForm.CategoryID),
-		BlocklistRules:        model.OptionalString(feedForm.BlocklistRules),
-		KeeplistRules:         model.OptionalString(feedForm.KeeplistRules),
-		UrlRewriteRules:       model.OptionalString(feedForm.UrlRewriteRules),
-		ProxyURL:              model.OptionalString(feedForm.ProxyURL),
-		BlockFilterEntryRules: model.OptionalString(feedForm.BlockFilterEntryRules),
-		KeepFilterEntryRules:  model.OptionalString(feedForm.KeepFilterEntryRules),
+		FeedURL:                     model.OptionalString(feedForm.FeedURL),
+		SiteURL:                     model.OptionalString(feedForm.SiteURL),
+		Title:                       model.OptionalString(feedForm.Title),
+		Description:                 model.OptionalString(feedForm.Description),
+		CategoryID:                  model.OptionalNumber(feedForm.CategoryID),
+		BlocklistRules:              model.OptionalString(feedForm.BlocklistRules),
+		KeeplistRules:               model.OptionalString(feedForm.KeeplistRules),
+		UrlRewriteRules:             model.OptionalString(feedForm.UrlRewriteRules),
+		ProxyURL:                    model.OptionalString(feedForm.ProxyURL),
+		BlockFilterEntryRules:       model.OptionalString(feedForm.BlockFilterEntryRules),
+		KeepFilterEntryRules:        model.OptionalString(feedForm.KeepFilterEntryRules),
+		ActionEntryRules:            model.OptionalString(feedForm.ActionEntryRules),
+		MarkUnreadOnChangeThreshold: &feedForm.MarkUnreadOnChangeThreshold,
+		PageItemSelector:            model.OptionalString(feedForm.PageItemSelector),
+		PageTitleSelector:           model.OptionalString(feedForm.PageTitleSelector),
+		PageLinkSelector:            model.OptionalString(feedForm.PageLinkSelector),
+		PageDateSelector:            model.OptionalString(feedForm.PageDateSelector),
+		PageContentSelector:         model.OptionalString(feedForm.PageContentSelector),
+	}
+
+	if feed.IsNewsletter() {
+		feedModificationRequest.FeedURL = nil
 	}
 
 	if validationErr := validator.ValidateFeedModification(h.store, loggedUser.ID, feed.ID, feedModificationRequest); validationErr != nil {
@@ -0,0 +1,55 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ui // import "miniflux.app/v2/internal/ui"
+
+import (
+	"net/http"
+
+	"miniflux.app/v2/internal/http/request"
+	"miniflux.app/v2/internal/http/response"
+	"miniflux.app/v2/internal/locale"
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/reader/processor"
+	"miniflux.app/v2/internal/validator"
+)
+
+func (h *handler) applyFilterRules(w http.ResponseWriter, r *http.Request) {
+	loggedUser, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	filterApplyRequest := &model.FilterApplyRequest{
+		FeedID:     request.RouteInt64Param(r, "feedID"),
+		CategoryID: request.RouteInt64Param(r, "categoryID"),
+		Outcome:    r.FormValue("outcome"),
+	}
+
+	redirectURL := h.routePath("/settings")
+	switch {
+	case filterApplyRequest.FeedID > 0:
+		redirectURL = h.routePath("/feed/%d/edit", filterApplyRequest.FeedID)
+	case filterApplyRequest.CategoryID > 0:
+		redirectURL = h.routePath("/category/%d/edit", filterApplyRequest.CategoryID)
+	}
+
+	sess := request.WebSession(r)
+	printer := locale.NewPrinter(loggedUser.Language)
+
+	if validationErr := validator.ValidateFilterApplyRequest(h.store, loggedUser.ID, filterApplyRequest); validationErr != nil {
+		sess.SetErrorMessage(validationErr.Translate(loggedUser.Language))
+		response.HTMLRedirect(w, r, redirectURL)
+		return
+	}
+
+	// The entries are processed in the background, the progress is shown below the form.
+	if _, err := processor.StartFilterRulesJob(h.store, loggedUser, filterApplyRequest); err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	sess.SetSuccessMessage(printer.Print("alert.filter_rules_applying"))
+	response.HTMLRedirect(w, r, redirectURL)
+}
@@ -0,0 +1,31 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ui // import "miniflux.app/v2/internal/ui"
+
+import (
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/reader/filter"
+)
+
+// filterRuleStatsRows returns the statistics of each filter rule line and regex rule, in the order of the forms.
+// The rules that never matched are listed with a zero hit count.
+func filterRuleStatsRows(stats model.FilterRuleStats, feedID int64, filterRules []string, regexRules []string) model.FilterRuleStats {
+	rules := filter.NormalizeRules(filterRules...)
+
+	for _, regexRule := range regexRules {
+		if regexRule != "" {
+			rules = append(rules, regexRule)
+		}
+	}
+
+	rows := make(model.FilterRuleStats, 0, len(rules))
+	for _, rule := range rules {
+		if stat := stats.Find(feedID, rule); stat != nil {
+			rows = append(rows, stat)
+		} else {
+			rows = append(rows, &model.FilterRuleStat{FeedID: feedID, Rule: rule})
+		}
+	}
+	return rows
+}
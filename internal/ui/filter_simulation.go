@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ui // import "miniflux.app/v2/internal/ui"
+
+import (
+	"net/http"
+
+	"miniflux.app/v2/internal/http/request"
+	"miniflux.app/v2/internal/http/response"
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/reader/filter"
+	"miniflux.app/v2/internal/ui/view"
+)
+
+func (h *handler) showFilterSimulationPage(w http.ResponseWriter, r *http.Request) {
+	loggedUser, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	blockRules := r.FormValue("block_filter_entry_rules")
+	keepRules := r.FormValue("keep_filter_entry_rules")
+
+	builder := h.store.NewEntryQueryBuilder(loggedUser.ID).
+		WithSorting("published_at", "desc").
+		WithLimit(model.DefaultFilterSimulationLimit)
+
+	var feed *model.Feed
+	var simulator *filter.Simulator
+	if feedID := request.RouteInt64Param(r, "feedID"); feedID > 0 {
+		feed, err = h.store.FeedByID(loggedUser.ID, feedID)
+		if err != nil {
+			response.HTMLServerError(w, r, err)
+			return
+		}
+
+		if feed == nil {
+			response.HTMLNotFound(w, r)
+			return
+		}
+
+		simulator = filter.NewSimulator(loggedUser.BlockFilterEntryRules, blockRules, loggedUser.KeepFilterEntryRules, keepRules)
+		builder.WithFeedID(feed.ID)
+	} else {
+		simulator = filter.NewSimulator(blockRules, "", keepRules, "")
+	}
+
+	entries, err := builder.GetEntries()
+	if err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	for _, entry := range entries {
+		simulator.Evaluate(entry)
+	}
+
+	view := view.New(h.tpl, r)
+	view.Set("feed", feed)
+	view.Set("simulation", simulator.Result())
+	view.Set("menu", "settings")
+	if feed != nil {
+		view.Set("menu", "feeds")
+	}
+	view.Set("user", loggedUser)
+	navMetadata, _ := h.store.GetNavMetadata(loggedUser.ID)
+	view.Set("countUnread", navMetadata.CountUnread)
+	view.Set("countErrorFeeds", navMetadata.CountErrorFeeds)
+
+	response.HTML(w, r, view.Render("filter_simulation"))
+}
@@ -23,6 +23,7 @@ type FeedForm struct {
 	KeeplistRules               string
 	BlockFilterEntryRules       string
 	KeepFilterEntryRules        string
+	ActionEntryRules            string
 	UserAgent                   string
 	Cookie                      string
 	CategoryID                  int64
@@ -42,13 +43,20 @@ type FeedForm struct {
 	NtfyPriority int
 	NtfyTopic    string
 
-	Crawler            bool
-	IgnoreEntryUpdates bool
+	Crawler                     bool
+	IgnoreEntryUpdates          bool
+	MarkUnreadOnChangeThreshold int
 
 	DisableHTTP2     bool
 	PushoverEnabled  bool
 	PushoverPriority int
 	ProxyURL         string
+
+	PageItemSelector    string
+	PageTitleSelector   string
+	PageLinkSelector    string
+	PageDateSelector    string
+	PageContentSelector string
 }
 
 // Merge updates the fields of the given feed.
@@ -65,8 +73,10 @@ func (f FeedForm) Merge(feed *model.Feed) *model.Feed {
 	feed.KeeplistRules = f.KeeplistRules
 	feed.BlockFilterEntryRules = f.BlockFilterEntryRules
 	feed.KeepFilterEntryRules = f.KeepFilterEntryRules
+	feed.ActionEntryRules = f.ActionEntryRules
 	feed.Crawler = f.Crawler
 	feed.IgnoreEntryUpdates = f.IgnoreEntryUpdates
+	feed.MarkUnreadOnChangeThreshold = f.MarkUnreadOnChangeThreshold
 	feed.UserAgent = f.UserAgent
 	feed.Cookie = f.Cookie
 	feed.ParsingErrorCount = 0
@@ -88,6 +98,11 @@ func (f FeedForm) Merge(feed *model.Feed) *model.Feed {
 	feed.PushoverEnabled = f.PushoverEnabled
 	feed.PushoverPriority = f.PushoverPriority
 	feed.ProxyURL = f.ProxyURL
+	feed.PageItemSelector = f.PageItemSelector
+	feed.PageTitleSelector = f.PageTitleSelector
+	feed.PageLinkSelector = f.PageLinkSelector
+	feed.PageDateSelector = f.PageDateSelector
+	feed.PageContentSelector = f.PageContentSelector
 	return feed
 }
 
@@ -108,6 +123,11 @@ func NewFeedForm(r *http.Request) *FeedForm {
 		pushoverPriority = 0
 	}
 
+	markUnreadOnChangeThreshold, err := strconv.Atoi(r.FormValue("mark_unread_on_change_threshold"))
+	if err != nil {
+		markUnreadOnChangeThreshold = 0
+	}
+
 	return &FeedForm{
 		FeedURL:                     r.FormValue("feed_url"),
 		SiteURL:                     r.FormValue("site_url"),
@@ -122,8 +142,10 @@ func NewFeedForm(r *http.Request) *FeedForm {
 		KeeplistRules:               r.FormValue("keeplist_rules"),
 		BlockFilterEntryRules:       r.FormValue("block_filter_entry_rules"),
 		KeepFilterEntryRules:        r.FormValue("keep_filter_entry_rules"),
+		ActionEntryRules:            r.FormValue("action_entry_rules"),
 		Crawler:                     r.FormValue("crawler") == "1",
 		IgnoreEntryUpdates:          r.FormValue("ignore_entry_updates") == "1",
+		MarkUnreadOnChangeThreshold: markUnreadOnChangeThreshold,
 		CategoryID:                  int64(categoryID),
 		Username:                    r.FormValue("feed_username"),
 		Password:                    r.FormValue("feed_password"),
@@ -142,5 +164,10 @@ func NewFeedForm(r *http.Request) *FeedForm {
 		PushoverEnabled:             r.FormValue("pushover_enabled") == "1",
 		PushoverPriority:            pushoverPriority,
 		ProxyURL:                    r.FormValue("proxy_url"),
+		PageItemSelector:            r.FormValue("page_item_selector"),
+		PageTitleSelector:           r.FormValue("page_title_selector"),
+		PageLinkSelector:            r.FormValue("page_link_selector"),
+		PageDateSelector:            r.FormValue("page_date_selector"),
+		PageContentSelector:         r.FormValue("page_content_selector"),
 	}
 }
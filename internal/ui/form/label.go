@@ -0,0 +1,21 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package form // import "miniflux.app/v2/internal/ui/form"
+
+import (
+	"net/http"
+	"strings"
+)
+
+// LabelForm represents a label form in the UI
+type LabelForm struct {
+	Title string
+}
+
+// NewLabelForm returns a new LabelForm.
+func NewLabelForm(r *http.Request) *LabelForm {
+	return &LabelForm{
+		Title: strings.TrimSpace(r.FormValue("title")),
+	}
+}
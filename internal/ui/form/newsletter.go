@@ -0,0 +1,42 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package form // import "miniflux.app/v2/internal/ui/form"
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// NewsletterAddressForm represents the newsletter address form.
+type NewsletterAddressForm struct {
+	Description    string
+	CategoryID     int64
+	AllowedSenders string
+}
+
+// AllowedSenderList returns the allowed senders, one per line in the form.
+func (f *NewsletterAddressForm) AllowedSenderList() []string {
+	var allowedSenders []string
+	for line := range strings.Lines(f.AllowedSenders) {
+		if line = strings.ToLower(strings.TrimSpace(line)); line != "" {
+			allowedSenders = append(allowedSenders, line)
+		}
+	}
+	return allowedSenders
+}
+
+// NewNewsletterAddressForm returns a new NewsletterAddressForm.
+func NewNewsletterAddressForm(r *http.Request) *NewsletterAddressForm {
+	categoryID, err := strconv.ParseInt(r.FormValue("category_id"), 10, 64)
+	if err != nil {
+		categoryID = 0
+	}
+
+	return &NewsletterAddressForm{
+		Description:    strings.TrimSpace(r.FormValue("description")),
+		CategoryID:     categoryID,
+		AllowedSenders: r.FormValue("allowed_senders"),
+	}
+}
@@ -0,0 +1,35 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package form // import "miniflux.app/v2/internal/ui/form"
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// PublicFeedForm represents the public feed form.
+type PublicFeedForm struct {
+	Title       string
+	Starred     bool
+	CategoryID  int64
+	Tag         string
+	SearchQuery string
+}
+
+// NewPublicFeedForm returns a new PublicFeedForm.
+func NewPublicFeedForm(r *http.Request) *PublicFeedForm {
+	categoryID, err := strconv.ParseInt(r.FormValue("category_id"), 10, 64)
+	if err != nil {
+		categoryID = 0
+	}
+
+	return &PublicFeedForm{
+		Title:       strings.TrimSpace(r.FormValue("title")),
+		Starred:     r.FormValue("starred") == "1",
+		CategoryID:  categoryID,
+		Tag:         strings.TrimSpace(r.FormValue("tag")),
+		SearchQuery: strings.TrimSpace(r.FormValue("search_query")),
+	}
+}
@@ -0,0 +1,123 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package form // import "miniflux.app/v2/internal/ui/form"
+
+import (
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"miniflux.app/v2/internal/model"
+)
+
+// SavedSearchForm represents the saved search form.
+type SavedSearchForm struct {
+	Title       string
+	SearchQuery string
+	Statuses    []string
+	Starred     bool
+	CategoryIDs []int64
+	FeedIDs     []int64
+	Tags        string
+	MaxAgeDays  int
+}
+
+// HasStatus returns true if the status is selected.
+func (f *SavedSearchForm) HasStatus(status string) bool {
+	return slices.Contains(f.Statuses, status)
+}
+
+// HasCategoryID returns true if the category is selected.
+func (f *SavedSearchForm) HasCategoryID(categoryID int64) bool {
+	return slices.Contains(f.CategoryIDs, categoryID)
+}
+
+// HasFeedID returns true if the feed is selected.
+func (f *SavedSearchForm) HasFeedID(feedID int64) bool {
+	return slices.Contains(f.FeedIDs, feedID)
+}
+
+// TagList returns the tags, separated by commas in the form.
+func (f *SavedSearchForm) TagList() []string {
+	var tags []string
+	for tag := range strings.SplitSeq(f.Tags, ",") {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
+
+// CreationRequest returns the request to create a saved search from the form.
+func (f *SavedSearchForm) CreationRequest() *model.SavedSearchCreationRequest {
+	return &model.SavedSearchCreationRequest{
+		Title:       f.Title,
+		SearchQuery: f.SearchQuery,
+		Statuses:    f.Statuses,
+		Starred:     f.Starred,
+		CategoryIDs: f.CategoryIDs,
+		FeedIDs:     f.FeedIDs,
+		Tags:        f.TagList(),
+		MaxAgeDays:  f.MaxAgeDays,
+	}
+}
+
+// ModificationRequest returns the request to update all the fields of a saved search from the form.
+func (f *SavedSearchForm) ModificationRequest() *model.SavedSearchModificationRequest {
+	tags := f.TagList()
+	return &model.SavedSearchModificationRequest{
+		Title:       &f.Title,
+		SearchQuery: &f.SearchQuery,
+		Statuses:    &f.Statuses,
+		Starred:     &f.Starred,
+		CategoryIDs: &f.CategoryIDs,
+		FeedIDs:     &f.FeedIDs,
+		Tags:        &tags,
+		MaxAgeDays:  &f.MaxAgeDays,
+	}
+}
+
+// NewSavedSearchFormFromModel returns a SavedSearchForm filled with the saved search.
+func NewSavedSearchFormFromModel(savedSearch *model.SavedSearch) *SavedSearchForm {
+	return &SavedSearchForm{
+		Title:       savedSearch.Title,
+		SearchQuery: savedSearch.SearchQuery,
+		Statuses:    savedSearch.Statuses,
+		Starred:     savedSearch.Starred,
+		CategoryIDs: savedSearch.CategoryIDs,
+		FeedIDs:     savedSearch.FeedIDs,
+		Tags:        strings.Join(savedSearch.Tags, ", "),
+		MaxAgeDays:  savedSearch.MaxAgeDays,
+	}
+}
+
+// NewSavedSearchForm returns a new SavedSearchForm.
+func NewSavedSearchForm(r *http.Request) *SavedSearchForm {
+	maxAgeDays, err := strconv.Atoi(r.FormValue("max_age_days"))
+	if err != nil {
+		maxAgeDays = 0
+	}
+
+	return &SavedSearchForm{
+		Title:       strings.TrimSpace(r.FormValue("title")),
+		SearchQuery: strings.TrimSpace(r.FormValue("search_query")),
+		Statuses:    r.Form["statuses"],
+		Starred:     r.FormValue("starred") == "1",
+		CategoryIDs: parseInt64List(r.Form["category_ids"]),
+		FeedIDs:     parseInt64List(r.Form["feed_ids"]),
+		Tags:        r.FormValue("tags"),
+		MaxAgeDays:  maxAgeDays,
+	}
+}
+
+func parseInt64List(values []string) []int64 {
+	var ids []int64
+	for _, value := range values {
+		if id, err := strconv.ParseInt(value, 10, 64); err == nil && id > 0 {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
@@ -0,0 +1,54 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package form // import "miniflux.app/v2/internal/ui/form"
+
+import (
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestNewSavedSearchForm(t *testing.T) {
+	values := url.Values{
+		"title":        {" Go news "},
+		"search_query": {"golang"},
+		"statuses":     {"unread"},
+		"starred":      {"1"},
+		"category_ids": {"1", "invalid", "2"},
+		"feed_ids":     {"0", "5"},
+		"tags":         {"go, ,news,"},
+		"max_age_days": {"7"},
+	}
+	r, _ := http.NewRequest(http.MethodPost, "/saved-searches/save", strings.NewReader(values.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	savedSearchForm := NewSavedSearchForm(r)
+	request := savedSearchForm.CreationRequest()
+
+	if request.Title != "Go news" || request.SearchQuery != "golang" || !request.Starred || request.MaxAgeDays != 7 {
+		t.Errorf(`Unexpected saved search request: %+v`, request)
+	}
+
+	if !reflect.DeepEqual(request.Statuses, []string{"unread"}) {
+		t.Errorf(`Unexpected statuses: %v`, request.Statuses)
+	}
+
+	if !reflect.DeepEqual(request.CategoryIDs, []int64{1, 2}) {
+		t.Errorf(`Unexpected category IDs: %v`, request.CategoryIDs)
+	}
+
+	if !reflect.DeepEqual(request.FeedIDs, []int64{5}) {
+		t.Errorf(`Unexpected feed IDs: %v`, request.FeedIDs)
+	}
+
+	if !reflect.DeepEqual(request.Tags, []string{"go", "news"}) {
+		t.Errorf(`Unexpected tags: %v`, request.Tags)
+	}
+
+	if !savedSearchForm.HasCategoryID(2) || savedSearchForm.HasFeedID(2) || !savedSearchForm.HasStatus("unread") {
+		t.Error(`The selection helpers do not match the form values`)
+	}
+}
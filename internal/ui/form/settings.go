@@ -38,6 +38,7 @@ type SettingsForm struct {
 	CustomJS               string
 	ExternalFontHosts      string
 	GestureNav             string
+	EntryListLayout        string
 	DisplayMode            string
 	DefaultReadingSpeed    int
 	CJKReadingSpeed        int
@@ -48,8 +49,11 @@ type SettingsForm struct {
 	MediaPlaybackRate         float64
 	BlockFilterEntryRules     string
 	KeepFilterEntryRules      string
+	ActionEntryRules          string
 	AlwaysOpenExternalLinks   bool
 	OpenExternalLinksInNewTab bool
+	MarkDuplicatesAsRead      bool
+	GroupEntriesByStory       bool
 	KeyboardShortcuts         bool
 	EntrySwipe                bool
 	MarkReadOnView            bool
@@ -108,6 +112,7 @@ func (s *SettingsForm) Merge(user *model.User) *model.User {
 	user.ExternalFontHosts = s.ExternalFontHosts
 	user.EntrySwipe = s.EntrySwipe
 	user.GestureNav = s.GestureNav
+	user.EntryListLayout = s.EntryListLayout
 	user.DisplayMode = s.DisplayMode
 	user.CJKReadingSpeed = s.CJKReadingSpeed
 	user.DefaultReadingSpeed = s.DefaultReadingSpeed
@@ -116,7 +121,10 @@ func (s *SettingsForm) Merge(user *model.User) *model.User {
 	user.MediaPlaybackRate = s.MediaPlaybackRate
 	user.BlockFilterEntryRules = s.BlockFilterEntryRules
 	user.KeepFilterEntryRules = s.KeepFilterEntryRules
+	user.ActionEntryRules = s.ActionEntryRules
 	user.AlwaysOpenExternalLinks = s.AlwaysOpenExternalLinks
+	user.MarkDuplicateEntriesAsRead = s.MarkDuplicatesAsRead
+	user.GroupEntriesByStory = s.GroupEntriesByStory
 	user.OpenExternalLinksInNewTab = s.OpenExternalLinksInNewTab
 
 	MarkReadOnView, MarkReadOnMediaPlayerCompletion := extractMarkAsReadBehavior(s.MarkReadBehavior)
@@ -199,6 +207,7 @@ func NewSettingsForm(r *http.Request) *SettingsForm {
 		ExternalFontHosts:         r.FormValue("external_font_hosts"),
 		EntrySwipe:                r.FormValue("entry_swipe") == "1",
 		GestureNav:                r.FormValue("gesture_nav"),
+		EntryListLayout:           r.FormValue("entry_list_layout"),
 		DisplayMode:               r.FormValue("display_mode"),
 		DefaultReadingSpeed:       int(defaultReadingSpeed),
 		CJKReadingSpeed:           int(cjkReadingSpeed),
@@ -209,7 +218,10 @@ func NewSettingsForm(r *http.Request) *SettingsForm {
 		MediaPlaybackRate:         mediaPlaybackRate,
 		BlockFilterEntryRules:     r.FormValue("block_filter_entry_rules"),
 		KeepFilterEntryRules:      r.FormValue("keep_filter_entry_rules"),
+		ActionEntryRules:          r.FormValue("action_entry_rules"),
 		AlwaysOpenExternalLinks:   r.FormValue("always_open_external_links") == "1",
 		OpenExternalLinksInNewTab: r.FormValue("open_external_links_in_new_tab") == "1",
+		MarkDuplicatesAsRead:      r.FormValue("mark_duplicate_entries_as_read") == "1",
+		GroupEntriesByStory:       r.FormValue("group_entries_by_story") == "1",
 	}
 }
@@ -33,6 +33,11 @@ type SubscriptionForm struct {
 	IgnoreEntryUpdates          bool
 	FetchViaProxy               bool
 	AllowSelfSignedCertificates bool
+	PageItemSelector            string
+	PageTitleSelector           string
+	PageLinkSelector            string
+	PageDateSelector            string
+	PageContentSelector         string
 }
 
 // Validate makes sure the form values locale.are valid.
@@ -73,9 +78,20 @@ func (s *SubscriptionForm) Validate() *locale.LocalizedError {
 		}
 	}
 
+	for _, selector := range []string{s.PageItemSelector, s.PageTitleSelector, s.PageLinkSelector, s.PageDateSelector, s.PageContentSelector} {
+		if selector != "" && !validator.IsValidCSSSelector(selector) {
+			return locale.NewLocalizedError("error.feed_invalid_page_selector", selector)
+		}
+	}
+
 	return nil
 }
 
+// IsPageFeed returns true if the feed must be generated from the web page with the CSS selectors.
+func (s *SubscriptionForm) IsPageFeed() bool {
+	return s.PageItemSelector != ""
+}
+
 // NewSubscriptionForm returns a new SubscriptionForm.
 func NewSubscriptionForm(r *http.Request) *SubscriptionForm {
 	categoryID, err := strconv.Atoi(r.FormValue("category_id"))
@@ -103,5 +119,10 @@ func NewSubscriptionForm(r *http.Request) *SubscriptionForm {
 		BlockFilterEntryRules:       r.FormValue("block_filter_entry_rules"),
 		DisableHTTP2:                r.FormValue("disable_http2") == "1",
 		ProxyURL:                    r.FormValue("proxy_url"),
+		PageItemSelector:            r.FormValue("page_item_selector"),
+		PageTitleSelector:           r.FormValue("page_title_selector"),
+		PageLinkSelector:            r.FormValue("page_link_selector"),
+		PageDateSelector:            r.FormValue("page_date_selector"),
+		PageContentSelector:         r.FormValue("page_content_selector"),
 	}
 }
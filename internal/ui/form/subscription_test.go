@@ -25,3 +25,25 @@ func TestSubscriptionFormValidateValidFilterRules(t *testing.T) {
 		t.Errorf("Validate should not return an error for a valid filter rule, got: %v", err)
 	}
 }
+
+func TestSubscriptionFormValidateInvalidPageSelector(t *testing.T) {
+	s := &SubscriptionForm{URL: "https://example.com/blog", CategoryID: 1, PageItemSelector: "article", PageTitleSelector: "h2["}
+	if err := s.Validate(); err == nil {
+		t.Error("Validate should return an error for an invalid CSS selector")
+	}
+}
+
+func TestSubscriptionFormIsPageFeed(t *testing.T) {
+	s := &SubscriptionForm{URL: "https://example.com/blog", CategoryID: 1, PageTitleSelector: "h2"}
+	if s.IsPageFeed() {
+		t.Error("The feed should not be generated from the page without item selector")
+	}
+
+	s.PageItemSelector = "article"
+	if !s.IsPageFeed() {
+		t.Error("The feed should be generated from the page with an item selector")
+	}
+	if err := s.Validate(); err != nil {
+		t.Errorf("Validate should not return an error for valid CSS selectors, got: %v", err)
+	}
+}
@@ -0,0 +1,56 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ui // import "miniflux.app/v2/internal/ui"
+
+import (
+	"net/http"
+
+	"miniflux.app/v2/internal/http/request"
+	"miniflux.app/v2/internal/http/response"
+	"miniflux.app/v2/internal/ui/view"
+)
+
+func (h *handler) showHighlightListPage(w http.ResponseWriter, r *http.Request) {
+	user, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	offset := request.QueryIntParam(r, "offset", 0)
+
+	highlights, err := h.store.Highlights(user.ID, user.EntriesPerPage, offset)
+	if err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	count, err := h.store.CountHighlights(user.ID)
+	if err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	view := view.New(h.tpl, r)
+	view.Set("highlights", highlights)
+	view.Set("total", count)
+	view.Set("pagination", getPagination(h.routePath("/highlights"), count, offset, user.EntriesPerPage))
+	view.Set("menu", "highlights")
+	view.Set("user", user)
+	navMetadata, _ := h.store.GetNavMetadata(user.ID)
+	view.Set("countUnread", navMetadata.CountUnread)
+	view.Set("countErrorFeeds", navMetadata.CountErrorFeeds)
+
+	response.HTML(w, r, view.Render("highlights"))
+}
+
+func (h *handler) exportHighlights(w http.ResponseWriter, r *http.Request) {
+	highlights, err := h.store.Highlights(request.UserID(r), 0, 0)
+	if err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	response.MarkdownAttachment(w, r, "highlights.md", highlights.ExportMarkdown())
+}
@@ -0,0 +1,62 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ui // import "miniflux.app/v2/internal/ui"
+
+import (
+	"net/http"
+
+	"miniflux.app/v2/internal/http/request"
+	"miniflux.app/v2/internal/http/response"
+	"miniflux.app/v2/internal/ui/view"
+)
+
+func (h *handler) showLabelEntriesPage(w http.ResponseWriter, r *http.Request) {
+	user, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	label, err := h.store.Label(user.ID, request.RouteInt64Param(r, "labelID"))
+	if err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	if label == nil {
+		response.HTMLNotFound(w, r)
+		return
+	}
+
+	offset := request.QueryIntParam(r, "offset", 0)
+
+	entries, count, err := h.store.NewEntryQueryBuilder(user.ID).
+		WithLabelID(label.ID).
+		WithSorting("status", "asc").
+		WithSorting(user.EntryOrder, user.EntryDirection).
+		WithSorting("id", user.EntryDirection).
+		WithoutContent().
+		WithOffset(offset).
+		WithLimit(user.EntriesPerPage).
+		GetEntriesWithCount()
+	if err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	view := view.New(h.tpl, r)
+	view.Set("label", label)
+	view.Set("total", count)
+	view.Set("entries", entries)
+	view.Set("pagination", getPagination(h.routePath("/label/%d/entries", label.ID), count, offset, user.EntriesPerPage))
+	view.Set("menu", "labels")
+	view.Set("user", user)
+	navMetadata, _ := h.store.GetNavMetadata(user.ID)
+	view.Set("countUnread", navMetadata.CountUnread)
+	view.Set("countErrorFeeds", navMetadata.CountErrorFeeds)
+	view.Set("hasSaveEntry", navMetadata.HasSaveEntry)
+	view.Set("showOnlyUnreadEntries", false)
+
+	response.HTML(w, r, view.Render("label_entries"))
+}
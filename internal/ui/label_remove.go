@@ -0,0 +1,21 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ui // import "miniflux.app/v2/internal/ui"
+
+import (
+	"net/http"
+
+	"miniflux.app/v2/internal/http/request"
+	"miniflux.app/v2/internal/http/response"
+)
+
+func (h *handler) removeLabel(w http.ResponseWriter, r *http.Request) {
+	labelID := request.RouteInt64Param(r, "labelID")
+	if err := h.store.RemoveLabel(request.UserID(r), labelID); err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	response.HTMLRedirect(w, r, h.routePath("/labels"))
+}
@@ -0,0 +1,38 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ui // import "miniflux.app/v2/internal/ui"
+
+import (
+	"net/http"
+
+	"miniflux.app/v2/internal/http/request"
+	"miniflux.app/v2/internal/http/response"
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/ui/form"
+	"miniflux.app/v2/internal/validator"
+)
+
+func (h *handler) saveLabel(w http.ResponseWriter, r *http.Request) {
+	user, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	labelForm := form.NewLabelForm(r)
+	labelCreationRequest := &model.LabelCreationRequest{Title: labelForm.Title}
+
+	if validationErr := validator.ValidateLabelCreation(h.store, user.ID, labelCreationRequest); validationErr != nil {
+		request.WebSession(r).SetErrorMessage(validationErr.Translate(user.Language))
+		response.HTMLRedirect(w, r, h.routePath("/labels"))
+		return
+	}
+
+	if _, err = h.store.CreateLabel(user.ID, labelCreationRequest); err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	response.HTMLRedirect(w, r, h.routePath("/labels"))
+}
@@ -0,0 +1,36 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ui // import "miniflux.app/v2/internal/ui"
+
+import (
+	"net/http"
+	"time"
+
+	"miniflux.app/v2/internal/http/request"
+	"miniflux.app/v2/internal/http/response"
+)
+
+func (h *handler) showNewsletterImage(w http.ResponseWriter, r *http.Request) {
+	externalID := request.RouteStringParam(r, "externalID")
+	image, err := h.store.NewsletterImageByExternalID(externalID)
+	if err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	if image == nil {
+		response.HTMLNotFound(w, r)
+		return
+	}
+
+	response.NewBuilder(w, r).WithCaching(image.ExternalID, 72*time.Hour, func(b *response.Builder) {
+		b.WithHeader("Content-Security-Policy", response.ContentSecurityPolicyForUntrustedContent)
+		b.WithHeader("Content-Type", image.MimeType)
+		b.WithBodyAsBytes(image.Content)
+		if image.MimeType != "image/svg+xml" {
+			b.WithoutCompression()
+		}
+		b.Write()
+	})
+}
@@ -0,0 +1,57 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ui // import "miniflux.app/v2/internal/ui"
+
+import (
+	"net/http"
+
+	"miniflux.app/v2/internal/http/request"
+	"miniflux.app/v2/internal/http/response"
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/ui/form"
+	"miniflux.app/v2/internal/ui/view"
+	"miniflux.app/v2/internal/validator"
+)
+
+func (h *handler) saveNewsletterAddress(w http.ResponseWriter, r *http.Request) {
+	user, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	addressForm := form.NewNewsletterAddressForm(r)
+	addressCreationRequest := &model.NewsletterAddressCreationRequest{
+		CategoryID:     addressForm.CategoryID,
+		Description:    addressForm.Description,
+		AllowedSenders: addressForm.AllowedSenderList(),
+	}
+
+	if validationErr := validator.ValidateNewsletterAddressCreation(h.store, user.ID, addressCreationRequest); validationErr != nil {
+		categories, err := h.store.Categories(user.ID)
+		if err != nil {
+			response.HTMLServerError(w, r, err)
+			return
+		}
+
+		view := view.New(h.tpl, r)
+		view.Set("form", addressForm)
+		view.Set("categories", categories)
+		view.Set("menu", "feeds")
+		view.Set("user", user)
+		navMetadata, _ := h.store.GetNavMetadata(user.ID)
+		view.Set("countUnread", navMetadata.CountUnread)
+		view.Set("countErrorFeeds", navMetadata.CountErrorFeeds)
+		view.Set("errorMessage", validationErr.Translate(user.Language))
+		response.HTML(w, r, view.Render("create_newsletter"))
+		return
+	}
+
+	if _, err = h.store.CreateNewsletterAddress(user.ID, addressCreationRequest); err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	response.HTMLRedirect(w, r, h.routePath("/newsletters"))
+}
@@ -14,6 +14,7 @@ type pagination struct {
 	PrevOffset   int
 	FirstOffset  int
 	UnreadOnly   bool
+	Fuzzy        bool
 	ShowNext     bool
 	ShowLast     bool
 	ShowFirst    bool
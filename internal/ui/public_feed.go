@@ -0,0 +1,105 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ui // import "miniflux.app/v2/internal/ui"
+
+import (
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"miniflux.app/v2/internal/config"
+	"miniflux.app/v2/internal/crypto"
+	"miniflux.app/v2/internal/http/request"
+	"miniflux.app/v2/internal/http/response"
+	"miniflux.app/v2/internal/mediaproxy"
+	"miniflux.app/v2/internal/publicfeed"
+)
+
+// publicFeedEntryLimit is the number of most recent entries included in a public feed.
+const publicFeedEntryLimit = 100
+
+func (h *handler) showPublicFeed(w http.ResponseWriter, r *http.Request) {
+	format := request.RouteStringParam(r, "format")
+	if !publicfeed.IsValidFormat(format) {
+		response.HTMLNotFound(w, r)
+		return
+	}
+
+	publicFeed, err := h.store.PublicFeedByToken(request.RouteStringParam(r, "token"))
+	if err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	if publicFeed == nil {
+		response.HTMLNotFound(w, r)
+		return
+	}
+
+	builder := h.store.NewEntryQueryBuilder(publicFeed.UserID)
+	builder.WithEnclosures()
+	builder.WithSearchQuery(publicFeed.SearchQuery)
+	builder.WithSorting("published_at", "desc")
+	builder.WithSorting("id", "desc")
+	builder.WithLimit(publicFeedEntryLimit)
+
+	if publicFeed.Starred {
+		builder.WithStarred(true)
+	}
+
+	if publicFeed.CategoryID > 0 {
+		builder.WithCategoryID(publicFeed.CategoryID)
+	}
+
+	if publicFeed.Tag != "" {
+		builder.WithTags(publicFeed.Tag)
+	}
+
+	entries, err := builder.GetEntries()
+	if err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	if err := h.store.SetPublicFeedAccessedTimestamp(publicFeed.ID); err != nil {
+		slog.Warn("Unable to update the last access date of the public feed",
+			slog.Int64("public_feed_id", publicFeed.ID),
+			slog.Any("error", err),
+		)
+	}
+
+	// The ETag covers every entry of the list, so removing an entry from the selection also changes it,
+	// while the modification date only moves forward.
+	lastModified := publicFeed.CreatedAt
+	etagSource := format + ":" + publicFeed.Title
+	for _, entry := range entries {
+		if entry.ChangedAt.After(lastModified) {
+			lastModified = entry.ChangedAt
+		}
+		etagSource += ":" + strconv.FormatInt(entry.ID, 10) + "-" + strconv.FormatInt(entry.ChangedAt.UnixNano(), 10)
+	}
+
+	for _, entry := range entries {
+		entry.Content = mediaproxy.RewriteDocumentWithAbsoluteProxyURL(entry.Content)
+		entry.Enclosures.ProxifyEnclosureURL(config.Opts.MediaProxyMode(), config.Opts.MediaProxyResourceTypes())
+	}
+
+	response.NewBuilder(w, r).WithConditionalCaching(crypto.SHA256(etagSource), lastModified, func(b *response.Builder) {
+		contentType, body, err := publicfeed.Serialize(format, &publicfeed.Feed{
+			Title:   publicFeed.Title,
+			SiteURL: config.Opts.BaseURL() + "/",
+			FeedURL: config.Opts.BaseURL() + "/public/" + publicFeed.Token + "/" + format,
+			Updated: lastModified,
+			Entries: entries,
+		})
+		if err != nil {
+			response.HTMLServerError(w, r, err)
+			return
+		}
+
+		b.WithHeader("Content-Type", contentType)
+		b.WithBodyAsBytes(body)
+		b.Write()
+	})
+}
@@ -0,0 +1,21 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ui // import "miniflux.app/v2/internal/ui"
+
+import (
+	"net/http"
+
+	"miniflux.app/v2/internal/http/request"
+	"miniflux.app/v2/internal/http/response"
+)
+
+func (h *handler) removePublicFeed(w http.ResponseWriter, r *http.Request) {
+	publicFeedID := request.RouteInt64Param(r, "publicFeedID")
+	if err := h.store.RemovePublicFeed(request.UserID(r), publicFeedID); err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	response.HTMLRedirect(w, r, h.routePath("/public-feeds"))
+}
@@ -0,0 +1,59 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ui // import "miniflux.app/v2/internal/ui"
+
+import (
+	"net/http"
+
+	"miniflux.app/v2/internal/http/request"
+	"miniflux.app/v2/internal/http/response"
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/ui/form"
+	"miniflux.app/v2/internal/ui/view"
+	"miniflux.app/v2/internal/validator"
+)
+
+func (h *handler) savePublicFeed(w http.ResponseWriter, r *http.Request) {
+	user, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	publicFeedForm := form.NewPublicFeedForm(r)
+	publicFeedCreationRequest := &model.PublicFeedCreationRequest{
+		Title:       publicFeedForm.Title,
+		Starred:     publicFeedForm.Starred,
+		CategoryID:  publicFeedForm.CategoryID,
+		Tag:         publicFeedForm.Tag,
+		SearchQuery: publicFeedForm.SearchQuery,
+	}
+
+	if validationErr := validator.ValidatePublicFeedCreation(h.store, user.ID, publicFeedCreationRequest); validationErr != nil {
+		categories, err := h.store.Categories(user.ID)
+		if err != nil {
+			response.HTMLServerError(w, r, err)
+			return
+		}
+
+		view := view.New(h.tpl, r)
+		view.Set("form", publicFeedForm)
+		view.Set("categories", categories)
+		view.Set("menu", "settings")
+		view.Set("user", user)
+		navMetadata, _ := h.store.GetNavMetadata(user.ID)
+		view.Set("countUnread", navMetadata.CountUnread)
+		view.Set("countErrorFeeds", navMetadata.CountErrorFeeds)
+		view.Set("errorMessage", validationErr.Translate(user.Language))
+		response.HTML(w, r, view.Render("create_public_feed"))
+		return
+	}
+
+	if _, err = h.store.CreatePublicFeed(user.ID, publicFeedCreationRequest); err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	response.HTMLRedirect(w, r, h.routePath("/public-feeds"))
+}
@@ -0,0 +1,54 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ui // import "miniflux.app/v2/internal/ui"
+
+import (
+	"net/http"
+
+	"miniflux.app/v2/internal/http/request"
+	"miniflux.app/v2/internal/http/response"
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/ui/form"
+	"miniflux.app/v2/internal/ui/view"
+)
+
+func (h *handler) showCreateSavedSearchPage(w http.ResponseWriter, r *http.Request) {
+	user, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	categories, err := h.store.Categories(user.ID)
+	if err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	feeds, err := h.store.Feeds(user.ID)
+	if err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	// The form is prefilled with the query of the search page.
+	savedSearchForm := &form.SavedSearchForm{
+		SearchQuery: request.QueryStringParam(r, "q", ""),
+	}
+	if request.QueryBoolParam(r, "unread", false) {
+		savedSearchForm.Statuses = []string{model.EntryStatusUnread}
+	}
+
+	view := view.New(h.tpl, r)
+	view.Set("form", savedSearchForm)
+	view.Set("categories", categories)
+	view.Set("feeds", feeds)
+	view.Set("menu", "saved_searches")
+	view.Set("user", user)
+	navMetadata, _ := h.store.GetNavMetadata(user.ID)
+	view.Set("countUnread", navMetadata.CountUnread)
+	view.Set("countErrorFeeds", navMetadata.CountErrorFeeds)
+
+	response.HTML(w, r, view.Render("create_saved_search"))
+}
@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ui // import "miniflux.app/v2/internal/ui"
+
+import (
+	"net/http"
+
+	"miniflux.app/v2/internal/http/request"
+	"miniflux.app/v2/internal/http/response"
+	"miniflux.app/v2/internal/ui/form"
+	"miniflux.app/v2/internal/ui/view"
+	"miniflux.app/v2/internal/validator"
+)
+
+func (h *handler) updateSavedSearch(w http.ResponseWriter, r *http.Request) {
+	user, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	savedSearch, err := h.store.SavedSearch(user.ID, request.RouteInt64Param(r, "savedSearchID"))
+	if err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	if savedSearch == nil {
+		response.HTMLNotFound(w, r)
+		return
+	}
+
+	savedSearchForm := form.NewSavedSearchForm(r)
+	savedSearchModificationRequest := savedSearchForm.ModificationRequest()
+
+	if validationErr := validator.ValidateSavedSearchModification(h.store, user.ID, savedSearch, savedSearchModificationRequest); validationErr != nil {
+		categories, err := h.store.Categories(user.ID)
+		if err != nil {
+			response.HTMLServerError(w, r, err)
+			return
+		}
+
+		feeds, err := h.store.Feeds(user.ID)
+		if err != nil {
+			response.HTMLServerError(w, r, err)
+			return
+		}
+
+		view := view.New(h.tpl, r)
+		view.Set("form", savedSearchForm)
+		view.Set("savedSearch", savedSearch)
+		view.Set("categories", categories)
+		view.Set("feeds", feeds)
+		view.Set("menu", "saved_searches")
+		view.Set("user", user)
+		navMetadata, _ := h.store.GetNavMetadata(user.ID)
+		view.Set("countUnread", navMetadata.CountUnread)
+		view.Set("countErrorFeeds", navMetadata.CountErrorFeeds)
+		view.Set("errorMessage", validationErr.Translate(user.Language))
+		response.HTML(w, r, view.Render("edit_saved_search"))
+		return
+	}
+
+	savedSearchModificationRequest.Patch(savedSearch)
+
+	if err := h.store.UpdateSavedSearch(savedSearch); err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	response.HTMLRedirect(w, r, h.routePath("/saved-search/%d/entries", savedSearch.ID))
+}
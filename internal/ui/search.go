@@ -21,6 +21,7 @@ func (h *handler) showSearchPage(w http.ResponseWriter, r *http.Request) {
 
 	searchQuery := request.QueryStringParam(r, "q", "")
 	unreadOnly := request.QueryBoolParam(r, "unread", false)
+	fuzzy := request.QueryBoolParam(r, "fuzzy", false)
 	offset := request.QueryIntParam(r, "offset", 0)
 
 	var entries model.Entries
@@ -28,11 +29,17 @@ func (h *handler) showSearchPage(w http.ResponseWriter, r *http.Request) {
 
 	if searchQuery != "" {
 		builder := h.store.NewEntryQueryBuilder(user.ID).
-			WithSearchQuery(searchQuery).
 			WithoutContent().
+			WithSearchSnippets().
 			WithOffset(offset).
 			WithLimit(user.EntriesPerPage)
 
+		if fuzzy {
+			builder = builder.WithFuzzySearchQuery(searchQuery)
+		} else {
+			builder = builder.WithSearchQuery(searchQuery)
+		}
+
 		if unreadOnly {
 			builder = builder.WithStatuses(model.EntryStatusUnread)
 		}
@@ -44,13 +51,22 @@ func (h *handler) showSearchPage(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	savedSearches, err := h.store.SavedSearchesWithUnreadCount(user.ID)
+	if err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
 	view := view.New(h.tpl, r)
 	pagination := getPagination(h.routePath("/search"), entriesCount, offset, user.EntriesPerPage)
 	pagination.SearchQuery = searchQuery
 	pagination.UnreadOnly = unreadOnly
+	pagination.Fuzzy = fuzzy
 
 	view.Set("searchQuery", searchQuery)
 	view.Set("searchUnreadOnly", unreadOnly)
+	view.Set("searchFuzzy", fuzzy)
+	view.Set("savedSearches", savedSearches)
 	view.Set("entries", entries)
 	view.Set("total", entriesCount)
 	view.Set("pagination", pagination)
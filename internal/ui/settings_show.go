@@ -37,6 +37,7 @@ func (h *handler) showSettingsPage(w http.ResponseWriter, r *http.Request) {
 		ExternalFontHosts:         user.ExternalFontHosts,
 		EntrySwipe:                user.EntrySwipe,
 		GestureNav:                user.GestureNav,
+		EntryListLayout:           user.EntryListLayout,
 		DisplayMode:               user.DisplayMode,
 		DefaultReadingSpeed:       user.DefaultReadingSpeed,
 		CJKReadingSpeed:           user.CJKReadingSpeed,
@@ -46,8 +47,11 @@ func (h *handler) showSettingsPage(w http.ResponseWriter, r *http.Request) {
 		MediaPlaybackRate:         user.MediaPlaybackRate,
 		BlockFilterEntryRules:     user.BlockFilterEntryRules,
 		KeepFilterEntryRules:      user.KeepFilterEntryRules,
+		ActionEntryRules:          user.ActionEntryRules,
 		AlwaysOpenExternalLinks:   user.AlwaysOpenExternalLinks,
 		OpenExternalLinksInNewTab: user.OpenExternalLinksInNewTab,
+		MarkDuplicatesAsRead:      user.MarkDuplicateEntriesAsRead,
+		GroupEntriesByStory:       user.GroupEntriesByStory,
 	}
 
 	creds, err := h.store.WebAuthnCredentialsByUserID(user.ID)
@@ -56,8 +60,22 @@ func (h *handler) showSettingsPage(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	filterRuleStats, err := h.store.FilterRuleStats(user.ID)
+	if err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
+	filterApplyJob, err := h.store.LatestFilterApplyJob(user.ID, 0, 0)
+	if err != nil {
+		response.HTMLServerError(w, r, err)
+		return
+	}
+
 	view := view.New(h.tpl, r)
 	view.Set("form", settingsForm)
+	view.Set("filterApplyJob", filterApplyJob)
+	view.Set("filterRuleStats", filterRuleStatsRows(filterRuleStats, 0, []string{user.BlockFilterEntryRules, user.KeepFilterEntryRules}, nil))
 	view.Set("readBehaviors", map[string]any{
 		"NoAutoMarkAsRead":                           form.NoAutoMarkAsRead,
 		"MarkAsReadOnView":                           form.MarkAsReadOnView,
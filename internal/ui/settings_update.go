@@ -70,12 +70,14 @@ func (h *handler) updateSettings(w http.ResponseWriter, r *http.Request) {
 		CategoriesSortingOrder: model.OptionalString(settingsForm.CategoriesSortingOrder),
 		DisplayMode:            model.OptionalString(settingsForm.DisplayMode),
 		GestureNav:             model.OptionalString(settingsForm.GestureNav),
+		EntryListLayout:        model.OptionalString(settingsForm.EntryListLayout),
 		DefaultReadingSpeed:    model.OptionalNumber(settingsForm.DefaultReadingSpeed),
 		CJKReadingSpeed:        model.OptionalNumber(settingsForm.CJKReadingSpeed),
 		DefaultHomePage:        model.OptionalString(settingsForm.DefaultHomePage),
 		MediaPlaybackRate:      model.OptionalNumber(settingsForm.MediaPlaybackRate),
 		BlockFilterEntryRules:  model.OptionalString(settingsForm.BlockFilterEntryRules),
 		KeepFilterEntryRules:   model.OptionalString(settingsForm.KeepFilterEntryRules),
+		ActionEntryRules:       model.OptionalString(settingsForm.ActionEntryRules),
 		ExternalFontHosts:      model.OptionalString(settingsForm.ExternalFontHosts),
 	}
 
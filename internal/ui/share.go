@@ -45,6 +45,7 @@ func (h *handler) sharedEntry(w http.ResponseWriter, r *http.Request) {
 	response.NewBuilder(w, r).WithCaching(etag, 72*time.Hour, func(b *response.Builder) {
 		entry, err := h.store.NewAnonymousQueryBuilder().
 			WithShareCode(shareCode).
+			WithTranscript().
 			GetEntry()
 
 		if err != nil {
@@ -2,6 +2,12 @@
 const TOP = 9999;
 const BOTTOM = -9999;
 
+// Number of characters stored before and after a highlight to find it again in the entry content.
+const HIGHLIGHT_CONTEXT_LENGTH = 32;
+
+// Last text range selected in the entry content, the selection can be lost when clicking the highlight button.
+let lastEntryContentSelection = null;
+
 // Simple Polyfill for browsers that don't support Trusted Types
 // See https://caniuse.com/?search=trusted%20types
 if (!window.trustedTypes || !trustedTypes.createPolicy) {
@@ -552,7 +558,12 @@ function initializeMainMenuHandlers() {
  */
 function initializeFormHandlers() {
     document.querySelectorAll("form").forEach((element) => {
-        element.onsubmit = () => {
+        element.onsubmit = (event) => {
+            // Buttons opening their result in a new tab (e.g. the rules preview) must not lock the form.
+            if (event.submitter && event.submitter.formTarget === "_blank") {
+                return;
+            }
+
             const buttons = element.querySelectorAll("button[type=submit]");
             buttons.forEach((button) => {
                 if (button.dataset.labelLoading) {
@@ -562,6 +573,25 @@ function initializeFormHandlers() {
             });
         };
     });
+
+    document.querySelectorAll("form[data-entry-label-form]").forEach((element) => {
+        element.addEventListener("submit", handleEntryLabelSubmit);
+    });
+}
+
+/**
+ * Attach the label typed in the entry label form and reload the page to show it.
+ *
+ * @param {SubmitEvent} event
+ */
+function handleEntryLabelSubmit(event) {
+    event.preventDefault();
+
+    const form = event.target;
+    const title = form.elements.title.value.trim();
+    if (title === "") return;
+
+    sendPOSTRequest(form.action, { title }).then(() => window.location.reload());
 }
 
 /**
@@ -749,6 +779,159 @@ function handleStarAction(element) {
     });
 }
 
+/**
+ * Keep track of the text selected in the entry content.
+ *
+ * @returns {void}
+ */
+function trackEntryContentSelection() {
+    document.addEventListener("selectionchange", () => {
+        const contentElement = document.querySelector(".entry-content");
+        const selection = document.getSelection();
+        if (!contentElement || !selection || selection.isCollapsed || selection.rangeCount === 0) return;
+
+        const range = selection.getRangeAt(0);
+        if (contentElement.contains(range.commonAncestorContainer)) {
+            lastEntryContentSelection = range.cloneRange();
+        }
+    });
+}
+
+/**
+ * Get the position of a boundary point in the text content of an element.
+ *
+ * @param {Element} rootElement - The element containing the boundary point.
+ * @param {Node} container - The node of the boundary point.
+ * @param {number} offset - The offset of the boundary point in the node.
+ * @returns {number} The number of characters before the boundary point.
+ */
+function getTextOffset(rootElement, container, offset) {
+    const range = document.createRange();
+    range.selectNodeContents(rootElement);
+    range.setEnd(container, offset);
+    return range.toString().length;
+}
+
+/**
+ * Highlight the text selected in the entry content, with an optional note.
+ *
+ * @returns {void}
+ */
+function handleHighlightAction() {
+    const buttonElement = document.querySelector(":is(a, button)[data-highlight-entry]");
+    const contentElement = document.querySelector(".entry-content");
+    if (!buttonElement || !contentElement) return;
+
+    const range = lastEntryContentSelection;
+    const quote = range ? range.toString() : "";
+    if (quote.trim() === "") {
+        showToastNotification("highlight", buttonElement.dataset.toastNoSelection);
+        return;
+    }
+
+    const note = window.prompt(buttonElement.dataset.labelNote, "");
+    if (note === null) return;
+
+    const text = contentElement.textContent;
+    const startOffset = getTextOffset(contentElement, range.startContainer, range.startOffset);
+    const endOffset = startOffset + quote.length;
+
+    lastEntryContentSelection = null;
+
+    sendPOSTRequest(buttonElement.dataset.highlightUrl, {
+        quote,
+        prefix: text.slice(Math.max(0, startOffset - HIGHLIGHT_CONTEXT_LENGTH), startOffset),
+        suffix: text.slice(endOffset, endOffset + HIGHLIGHT_CONTEXT_LENGTH),
+        start_offset: startOffset,
+        end_offset: endOffset,
+        note: note.trim(),
+    }).then(() => window.location.reload());
+}
+
+/**
+ * Find the position of a highlight in a text.
+ * The stored offsets are tried first, then the occurrence of the quote surrounded by the same context.
+ *
+ * @param {string} text - The text content of the entry.
+ * @param {DOMStringMap} highlight - The quote, prefix, suffix and offsets of the highlight.
+ * @returns {number} The start offset of the highlight, or -1 when the quote is not found.
+ */
+function findHighlightOffset(text, highlight) {
+    const quote = highlight.quote;
+    const startOffset = parseInt(highlight.startOffset, 10);
+    if (text.slice(startOffset, startOffset + quote.length) === quote) {
+        return startOffset;
+    }
+
+    let firstOffset = -1;
+    for (let offset = text.indexOf(quote); offset !== -1; offset = text.indexOf(quote, offset + 1)) {
+        const before = text.slice(0, offset);
+        const after = text.slice(offset + quote.length);
+        if (before.endsWith(highlight.prefix) && after.startsWith(highlight.suffix)) {
+            return offset;
+        }
+        if (firstOffset === -1) {
+            firstOffset = offset;
+        }
+    }
+
+    return firstOffset;
+}
+
+/**
+ * Wrap the characters of an element between two text offsets in mark elements.
+ *
+ * @param {Element} rootElement - The element containing the text.
+ * @param {number} startOffset - The position of the first character.
+ * @param {number} endOffset - The position after the last character.
+ * @returns {void}
+ */
+function markTextRange(rootElement, startOffset, endOffset) {
+    const walker = document.createTreeWalker(rootElement, NodeFilter.SHOW_TEXT);
+    const textNodes = [];
+    let position = 0;
+
+    while (walker.nextNode()) {
+        const node = walker.currentNode;
+        const nodeStart = position;
+        position += node.data.length;
+
+        if (position > startOffset && nodeStart < endOffset) {
+            textNodes.push({ node, start: Math.max(startOffset - nodeStart, 0), end: Math.min(endOffset - nodeStart, node.data.length) });
+        }
+    }
+
+    for (const { node, start, end } of textNodes) {
+        if (node.data.slice(start, end).trim() === "") continue;
+
+        const range = document.createRange();
+        range.setStart(node, start);
+        range.setEnd(node, end);
+
+        const markElement = document.createElement("mark");
+        markElement.className = "entry-highlight";
+        range.surroundContents(markElement);
+    }
+}
+
+/**
+ * Mark the highlights listed below the entry content.
+ *
+ * @returns {void}
+ */
+function markEntryHighlights() {
+    const contentElement = document.querySelector(".entry-content");
+    if (!contentElement) return;
+
+    document.querySelectorAll(".entry-highlight-item").forEach((highlightElement) => {
+        const highlight = highlightElement.dataset;
+        const startOffset = findHighlightOffset(contentElement.textContent, highlight);
+        if (startOffset !== -1) {
+            markTextRange(contentElement, startOffset, startOffset + highlight.quote.length);
+        }
+    });
+}
+
 /**
  * Handle fetching the original content of an entry.
  *
@@ -1043,6 +1226,11 @@ function handleMediaControlButtonClick(mediaPlayerButtonElement) {
         case "seek":
             mediaElement.currentTime = Math.max(mediaElement.currentTime + actionValue, 0);
             break;
+        case "goto":
+            // Chapters and soundbites jump to an absolute position.
+            mediaElement.currentTime = Math.max(actionValue, 0);
+            mediaElement.play();
+            break;
         case "speed":
             // 0.25 was chosen because it will allow to get back to 1x in two "faster" clicks.
             // A lower value would result in a playback rate of 0, effectively pausing playback.
@@ -1252,6 +1440,7 @@ function initializeClickHandlers() {
     onClick(":is(a, button)[data-toggle-status]", (event) => handleEntryStatus("next", event.target));
     onClick(":is(a, button)[data-fetch-content-entry]", handleFetchOriginalContentAction);
     onClick(":is(a, button)[data-share-status]", handleEntryShareAction);
+    onClick(":is(a, button)[data-highlight-entry]", handleHighlightAction);
 
     // Page actions with confirmation
     onClick(":is(a, button)[data-action=markPageAsRead]", (event) => handleConfirmationMessage(event.target, markPageAsReadAction));
@@ -1292,6 +1481,11 @@ initializeTouchHandler();
 initializeClickHandlers();
 initializeServiceWorker();
 
+if (isEntryView()) {
+    trackEntryContentSelection();
+    markEntryHighlights();
+}
+
 // Reload the page if it was restored from the back-forward cache and mark entries as read is enabled.
 window.addEventListener("pageshow", (event) => {
     if (event.persisted && document.body.dataset.markAsReadOnView === "true") {
@@ -50,6 +50,47 @@ func (h *handler) submitSubscription(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// Web pages without feed are not discovered, the feed is generated from the page with the CSS selectors.
+	if subscriptionForm.IsPageFeed() {
+		feed, localizedError := feedHandler.CreateFeed(h.store, user.ID, &model.FeedCreationRequest{
+			CategoryID:                  subscriptionForm.CategoryID,
+			FeedURL:                     subscriptionForm.URL,
+			Crawler:                     subscriptionForm.Crawler,
+			IgnoreEntryUpdates:          subscriptionForm.IgnoreEntryUpdates,
+			AllowSelfSignedCertificates: subscriptionForm.AllowSelfSignedCertificates,
+			UserAgent:                   subscriptionForm.UserAgent,
+			Cookie:                      subscriptionForm.Cookie,
+			Username:                    subscriptionForm.Username,
+			Password:                    subscriptionForm.Password,
+			ScraperRules:                subscriptionForm.ScraperRules,
+			RewriteRules:                subscriptionForm.RewriteRules,
+			UrlRewriteRules:             subscriptionForm.UrlRewriteRules,
+			BlocklistRules:              subscriptionForm.BlocklistRules,
+			KeeplistRules:               subscriptionForm.KeeplistRules,
+			KeepFilterEntryRules:        subscriptionForm.KeepFilterEntryRules,
+			BlockFilterEntryRules:       subscriptionForm.BlockFilterEntryRules,
+			FetchViaProxy:               subscriptionForm.FetchViaProxy,
+			DisableHTTP2:                subscriptionForm.DisableHTTP2,
+			ProxyURL:                    subscriptionForm.ProxyURL,
+			PageFeedSelectors: model.PageFeedSelectors{
+				PageItemSelector:    subscriptionForm.PageItemSelector,
+				PageTitleSelector:   subscriptionForm.PageTitleSelector,
+				PageLinkSelector:    subscriptionForm.PageLinkSelector,
+				PageDateSelector:    subscriptionForm.PageDateSelector,
+				PageContentSelector: subscriptionForm.PageContentSelector,
+			},
+		})
+		if localizedError != nil {
+			v.Set("form", subscriptionForm)
+			v.Set("errorMessage", localizedError.Translate(user.Language))
+			response.HTML(w, r, v.Render("add_subscription"))
+			return
+		}
+
+		response.HTMLRedirect(w, r, h.routePath("/feed/%d/entries", feed.ID))
+		return
+	}
+
 	var rssBridgeURL string
 	var rssBridgeToken string
 	if intg, err := h.store.Integration(user.ID); err == nil && intg != nil && intg.RSSBridgeEnabled {
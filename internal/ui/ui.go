@@ -67,12 +67,16 @@ func Serve(store *storage.Storage, pool *worker.Pool) http.Handler {
 	mux.HandleFunc("GET /feed/{feedID}/edit", handler.showEditFeedPage)
 	mux.HandleFunc("POST /feed/{feedID}/remove", handler.removeFeed)
 	mux.HandleFunc("POST /feed/{feedID}/update", handler.updateFeed)
+	mux.HandleFunc("POST /feed/{feedID}/preview", handler.showFeedPreviewPage)
+	mux.HandleFunc("POST /feed/{feedID}/filters/simulate", handler.showFilterSimulationPage)
+	mux.HandleFunc("POST /feed/{feedID}/filters/apply", handler.applyFilterRules)
 	mux.HandleFunc("GET /feed/{feedID}/entries", handler.showFeedEntriesPage)
 	mux.HandleFunc("GET /feed/{feedID}/entries/all", handler.showFeedEntriesAllPage)
 	mux.HandleFunc("GET /feed/{feedID}/entry/{entryID}", handler.showFeedEntryPage)
 	mux.HandleFunc("GET /unread/feed/{feedID}/entry/{entryID}", handler.showUnreadFeedEntryPage)
 	mux.HandleFunc("POST /feed/{feedID}/mark-all-as-read", handler.markFeedAsRead)
 	mux.HandleFunc("GET /feed-icon/{externalIconID}", handler.showFeedIcon)
+	mux.HandleFunc("GET /newsletter-image/{externalID}", handler.showNewsletterImage)
 
 	// Category pages.
 	mux.HandleFunc("GET /category/{categoryID}/entry/{entryID}", handler.showCategoryEntryPage)
@@ -91,6 +95,7 @@ func Serve(store *storage.Storage, pool *worker.Pool) http.Handler {
 	mux.HandleFunc("GET /category/{categoryID}/entries/starred", handler.showCategoryEntriesStarredPage)
 	mux.HandleFunc("GET /category/{categoryID}/edit", handler.showEditCategoryPage)
 	mux.HandleFunc("POST /category/{categoryID}/update", handler.updateCategory)
+	mux.HandleFunc("POST /category/{categoryID}/filters/apply", handler.applyFilterRules)
 	mux.HandleFunc("POST /category/{categoryID}/remove", handler.removeCategory)
 	mux.HandleFunc("POST /category/{categoryID}/mark-all-as-read", handler.markCategoryAsRead)
 
@@ -98,12 +103,38 @@ func Serve(store *storage.Storage, pool *worker.Pool) http.Handler {
 	mux.HandleFunc("GET /tags/{tagName}/entries/all", handler.showTagEntriesAllPage)
 	mux.HandleFunc("GET /tags/{tagName}/entry/{entryID}", handler.showTagEntryPage)
 
+	// Label pages.
+	mux.HandleFunc("GET /labels", handler.showLabelListPage)
+	mux.HandleFunc("POST /labels/save", handler.saveLabel)
+	mux.HandleFunc("GET /label/{labelID}/entries", handler.showLabelEntriesPage)
+	mux.HandleFunc("GET /label/{labelID}/entry/{entryID}", handler.showLabelEntryPage)
+	mux.HandleFunc("POST /label/{labelID}/remove", handler.removeLabel)
+
+	// Saved search pages.
+	mux.HandleFunc("GET /saved-searches", handler.showSavedSearchListPage)
+	mux.HandleFunc("GET /saved-searches/create", handler.showCreateSavedSearchPage)
+	mux.HandleFunc("POST /saved-searches/save", handler.saveSavedSearch)
+	mux.HandleFunc("GET /saved-search/{savedSearchID}/entries", handler.showSavedSearchEntriesPage)
+	mux.HandleFunc("GET /saved-search/{savedSearchID}/entry/{entryID}", handler.showSavedSearchEntryPage)
+	mux.HandleFunc("GET /saved-search/{savedSearchID}/edit", handler.showEditSavedSearchPage)
+	mux.HandleFunc("POST /saved-search/{savedSearchID}/update", handler.updateSavedSearch)
+	mux.HandleFunc("POST /saved-search/{savedSearchID}/remove", handler.removeSavedSearch)
+	mux.HandleFunc("POST /saved-search/{savedSearchID}/mark-all-as-read", handler.markSavedSearchAsRead)
+
+	// Highlight pages.
+	mux.HandleFunc("GET /highlights", handler.showHighlightListPage)
+	mux.HandleFunc("GET /highlights/export", handler.exportHighlights)
+
 	// Entry pages.
 	mux.HandleFunc("POST /entry/status", handler.updateEntriesStatus)
 	mux.HandleFunc("POST /entry/save/{entryID}", handler.saveEntry)
 	mux.HandleFunc("POST /entry/enclosure/{enclosureID}/save-progression", handler.saveEnclosureProgression)
 	mux.HandleFunc("POST /entry/download/{entryID}", handler.fetchContent)
 	mux.HandleFunc("POST /entry/star/{entryID}", handler.toggleStarred)
+	mux.HandleFunc("POST /entry/{entryID}/labels", handler.attachEntryLabel)
+	mux.HandleFunc("POST /entry/{entryID}/labels/{labelID}/remove", handler.detachEntryLabel)
+	mux.HandleFunc("POST /entry/{entryID}/highlights", handler.createEntryHighlight)
+	mux.HandleFunc("POST /entry/{entryID}/highlights/{highlightID}/remove", handler.removeEntryHighlight)
 
 	// Media proxy.
 	mux.HandleFunc("GET /proxy/{encodedDigest}/{encodedURL}", handler.mediaProxy)
@@ -114,6 +145,19 @@ func Serve(store *storage.Storage, pool *worker.Pool) http.Handler {
 	mux.HandleFunc("GET /share/{shareCode}", handler.sharedEntry)
 	mux.HandleFunc("GET /shares", handler.sharedEntries)
 
+	// Public feed pages.
+	mux.HandleFunc("GET /public/{token}/{format}", handler.showPublicFeed)
+	mux.HandleFunc("GET /public-feeds", handler.showPublicFeedsPage)
+	mux.HandleFunc("GET /public-feeds/create", handler.showCreatePublicFeedPage)
+	mux.HandleFunc("POST /public-feeds/save", handler.savePublicFeed)
+	mux.HandleFunc("POST /public-feeds/{publicFeedID}/remove", handler.removePublicFeed)
+
+	// Newsletter pages.
+	mux.HandleFunc("GET /newsletters", handler.showNewsletterAddressesPage)
+	mux.HandleFunc("GET /newsletters/create", handler.showCreateNewsletterAddressPage)
+	mux.HandleFunc("POST /newsletters/save", handler.saveNewsletterAddress)
+	mux.HandleFunc("POST /newsletters/{addressID}/remove", handler.removeNewsletterAddress)
+
 	// User pages.
 	mux.HandleFunc("GET /users", handler.showUsersPage)
 	mux.HandleFunc("GET /user/create", handler.showCreateUserPage)
@@ -125,6 +169,8 @@ func Serve(store *storage.Storage, pool *worker.Pool) http.Handler {
 	// Settings pages.
 	mux.HandleFunc("GET /settings", handler.showSettingsPage)
 	mux.HandleFunc("POST /settings", handler.updateSettings)
+	mux.HandleFunc("POST /settings/filters/simulate", handler.showFilterSimulationPage)
+	mux.HandleFunc("POST /settings/filters/apply", handler.applyFilterRules)
 	mux.HandleFunc("GET /integrations", handler.showIntegrationPage)
 	mux.HandleFunc("POST /integration", handler.updateIntegration)
 	mux.HandleFunc("GET /about", handler.showAboutPage)
@@ -28,6 +28,7 @@ func (h *handler) showUnreadPage(w http.ResponseWriter, r *http.Request) {
 		WithOffset(offset).
 		WithLimit(user.EntriesPerPage).
 		WithGloballyVisible().
+		WithStoryGrouping(user.GroupEntriesByStory).
 		WithoutContent().
 		GetEntriesWithCount()
 	if err != nil {
@@ -44,6 +45,7 @@ func (h *handler) showUnreadPage(w http.ResponseWriter, r *http.Request) {
 			WithSorting("id", user.EntryDirection).
 			WithLimit(user.EntriesPerPage).
 			WithGloballyVisible().
+			WithStoryGrouping(user.GroupEntriesByStory).
 			WithoutContent().
 			GetEntriesWithCount()
 		if err != nil {
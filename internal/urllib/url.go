@@ -160,6 +160,32 @@ func DomainWithoutWWW(websiteURL string) string {
 	return strings.TrimPrefix(Domain(websiteURL), "www.")
 }
 
+// NormalizeURL returns a canonical form of an HTTP URL used to compare links found in different feeds.
+// The scheme, the "www." prefix, the fragment and the trailing slash are ignored, and the query parameters are sorted.
+// An empty string is returned for invalid or non-HTTP URLs.
+func NormalizeURL(inputURL string) string {
+	if !hasHTTPPrefix(strings.ToLower(inputURL)) {
+		return ""
+	}
+
+	parsedURL, err := url.Parse(inputURL)
+	if err != nil || parsedURL.Host == "" {
+		return ""
+	}
+
+	normalizedURL := strings.TrimPrefix(strings.ToLower(parsedURL.Hostname()), "www.")
+	if port := parsedURL.Port(); port != "" && port != "80" && port != "443" {
+		normalizedURL += ":" + port
+	}
+
+	normalizedURL += strings.TrimSuffix(parsedURL.EscapedPath(), "/")
+	if parsedURL.RawQuery != "" {
+		normalizedURL += "?" + parsedURL.Query().Encode()
+	}
+
+	return normalizedURL
+}
+
 // JoinBaseURLAndPath joins a base URL and a path segment into a single URL string.
 func JoinBaseURLAndPath(baseURL, path string) (string, error) {
 	if baseURL == "" {
@@ -224,6 +224,29 @@ func TestDomainWithoutWWW(t *testing.T) {
 	}
 }
 
+func TestNormalizeURL(t *testing.T) {
+	scenarios := map[string]string{
+		"https://example.org/article":                 "example.org/article",
+		"http://www.Example.org/article/":             "example.org/article",
+		"https://example.org/article#comments":        "example.org/article",
+		"https://example.org/article?b=2&a=1":         "example.org/article?a=1&b=2",
+		"https://example.org:443/":                    "example.org",
+		"https://example.org:8443/article":            "example.org:8443/article",
+		"HTTPS://EXAMPLE.ORG/Article":                 "example.org/Article",
+		"https://example.org/caf%C3%A9?q=hello+world": "example.org/caf%C3%A9?q=hello+world",
+		"ftp://example.org/file":                      "",
+		"/relative/path":                              "",
+		"https://":                                    "",
+		"":                                            "",
+	}
+
+	for input, expected := range scenarios {
+		if actual := NormalizeURL(input); actual != expected {
+			t.Errorf(`Unexpected result for %q, got %q instead of %q`, input, actual, expected)
+		}
+	}
+}
+
 func TestJoinBaseURLAndPath(t *testing.T) {
 	type args struct {
 		baseURL string
@@ -70,6 +70,10 @@ func ValidateFeedCreation(store *storage.Storage, userID int64, request *model.F
 		return locale.NewLocalizedError("error.invalid_feed_proxy_url")
 	}
 
+	if err := validatePageFeedSelectors(&request.PageFeedSelectors); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -145,11 +149,51 @@ func ValidateFeedModification(store *storage.Storage, userID, feedID int64, requ
 		}
 	}
 
+	if request.ActionEntryRules != nil {
+		if err := IsValidActionRules(*request.ActionEntryRules); err != nil {
+			return err
+		}
+	}
+
 	if request.ProxyURL != nil && *request.ProxyURL != "" {
 		if !urllib.IsValidProxyURL(*request.ProxyURL) {
 			return locale.NewLocalizedError("error.invalid_feed_proxy_url")
 		}
 	}
 
+	if request.MarkUnreadOnChangeThreshold != nil {
+		if *request.MarkUnreadOnChangeThreshold < 0 || *request.MarkUnreadOnChangeThreshold > 100 {
+			return locale.NewLocalizedError("error.feed_invalid_mark_unread_on_change_threshold")
+		}
+	}
+
+	for _, selector := range []*string{
+		request.PageItemSelector,
+		request.PageTitleSelector,
+		request.PageLinkSelector,
+		request.PageDateSelector,
+		request.PageContentSelector,
+	} {
+		if selector != nil && *selector != "" && !IsValidCSSSelector(*selector) {
+			return locale.NewLocalizedError("error.feed_invalid_page_selector", *selector)
+		}
+	}
+
+	return nil
+}
+
+func validatePageFeedSelectors(selectors *model.PageFeedSelectors) *locale.LocalizedError {
+	for _, selector := range []string{
+		selectors.PageItemSelector,
+		selectors.PageTitleSelector,
+		selectors.PageLinkSelector,
+		selectors.PageDateSelector,
+		selectors.PageContentSelector,
+	} {
+		if selector != "" && !IsValidCSSSelector(selector) {
+			return locale.NewLocalizedError("error.feed_invalid_page_selector", selector)
+		}
+	}
+
 	return nil
 }
@@ -41,3 +41,56 @@ func TestValidateFeedModificationProxyURL(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateFeedModificationPageSelectors(t *testing.T) {
+	tests := []struct {
+		name     string
+		selector string
+		wantErr  bool
+	}{
+		{
+			name:     "empty selector",
+			selector: "",
+			wantErr:  false,
+		},
+		{
+			name:     "valid selector",
+			selector: "article.post > h2 a[href]",
+			wantErr:  false,
+		},
+		{
+			name:     "invalid selector",
+			selector: "article[",
+			wantErr:  true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			request := &model.FeedModificationRequest{PageItemSelector: &tc.selector}
+			if err := ValidateFeedModification(nil, 0, 0, request); (err != nil) != tc.wantErr {
+				t.Fatalf("expected error %v, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestValidateFeedModificationMarkUnreadOnChangeThreshold(t *testing.T) {
+	tests := []struct {
+		threshold int
+		wantErr   bool
+	}{
+		{threshold: 0, wantErr: false},
+		{threshold: 25, wantErr: false},
+		{threshold: 100, wantErr: false},
+		{threshold: -1, wantErr: true},
+		{threshold: 101, wantErr: true},
+	}
+
+	for _, tc := range tests {
+		request := &model.FeedModificationRequest{MarkUnreadOnChangeThreshold: &tc.threshold}
+		if err := ValidateFeedModification(nil, 0, 0, request); (err != nil) != tc.wantErr {
+			t.Errorf("threshold %d: expected error %v, got %v", tc.threshold, tc.wantErr, err)
+		}
+	}
+}
@@ -4,18 +4,30 @@
 package validator // import "miniflux.app/v2/internal/validator"
 
 import (
+	"log/slog"
 	"slices"
 	"strings"
 
+	"miniflux.app/v2/internal/integration"
 	"miniflux.app/v2/internal/locale"
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/reader/filter"
+	"miniflux.app/v2/internal/storage"
 )
 
 func IsValidFilterRules(filterEntryRules string, filterType string) *locale.LocalizedError {
-	// Valid Format: FieldName=RegEx\nFieldName=RegEx...
+	// Valid Format: FieldName=RegEx\nFieldName=RegEx... or one boolean expression per line.
 	fieldNames := []string{"EntryTitle", "EntryURL", "EntryCommentsURL", "EntryContent", "EntryAuthor", "EntryTag", "EntryDate"}
 
 	rules := strings.Split(filterEntryRules, "\n")
 	for i, rule := range rules {
+		if filter.IsExpressionRule(rule) {
+			if err := filter.ValidateRule(rule); err != nil {
+				return locale.NewLocalizedError("error.settings_"+filterType+"_rule_invalid_expression", i+1, err.Error())
+			}
+			continue
+		}
+
 		// Check if rule starts with a valid fieldName
 		idx := slices.IndexFunc(fieldNames, func(fieldName string) bool { return strings.HasPrefix(rule, fieldName) })
 		if idx == -1 {
@@ -41,3 +53,59 @@ func IsValidFilterRules(filterEntryRules string, filterType string) *locale.Loca
 	}
 	return nil
 }
+
+// IsValidActionRules checks that each non-empty line is a valid action rule.
+func IsValidActionRules(actionEntryRules string) *locale.LocalizedError {
+	integrationNames := integration.EntryIntegrationNames()
+	for i, line := range strings.Split(actionEntryRules, "\n") {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		if err := filter.ValidateActionRule(line, integrationNames); err != nil {
+			return locale.NewLocalizedError("error.settings_action_rule_invalid", i+1, err.Error())
+		}
+	}
+	return nil
+}
+
+// ValidateFilterApplyRequest validates the outcome and the scope of a request to apply the filter rules to stored entries.
+func ValidateFilterApplyRequest(store *storage.Storage, userID int64, request *model.FilterApplyRequest) *locale.LocalizedError {
+	if !slices.Contains(model.FilterOutcomes(), request.Outcome) {
+		return locale.NewLocalizedError("error.invalid_filter_outcome")
+	}
+
+	if request.FeedID != 0 && request.CategoryID != 0 {
+		return locale.NewLocalizedError("error.filter_scope_conflict")
+	}
+
+	if request.FeedID != 0 {
+		feedExists, err := store.FeedExists(userID, request.FeedID)
+		if err != nil {
+			slog.Error("validator: unable to check if feed exists",
+				slog.Int64("user_id", userID),
+				slog.Int64("feed_id", request.FeedID),
+				slog.Any("error", err),
+			)
+		}
+		if !feedExists {
+			return locale.NewLocalizedError("error.feed_not_found")
+		}
+	}
+
+	if request.CategoryID != 0 {
+		categoryExists, err := store.CategoryIDExists(userID, request.CategoryID)
+		if err != nil {
+			slog.Error("validator: unable to check if category exists",
+				slog.Int64("user_id", userID),
+				slog.Int64("category_id", request.CategoryID),
+				slog.Any("error", err),
+			)
+		}
+		if !categoryExists {
+			return locale.NewLocalizedError("error.category_not_found")
+		}
+	}
+
+	return nil
+}
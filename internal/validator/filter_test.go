@@ -3,7 +3,11 @@
 
 package validator // import "miniflux.app/v2/internal/validator"
 
-import "testing"
+import (
+	"testing"
+
+	"miniflux.app/v2/internal/model"
+)
 
 func TestIsValidFilterRules(t *testing.T) {
 	tests := []struct {
@@ -41,6 +45,21 @@ func TestIsValidFilterRules(t *testing.T) {
 			rules:   "EntryTitle=[",
 			wantErr: true,
 		},
+		{
+			name:    "valid expression mixed with legacy rules",
+			rules:   "EntryTitle=foo\ntitle ~ \"(?i)go\" AND NOT (author = \"Bob\" OR reading_time > 10)",
+			wantErr: false,
+		},
+		{
+			name:    "expression with unknown operator",
+			rules:   "date contains \"2024\"",
+			wantErr: true,
+		},
+		{
+			name:    "expression with missing parenthesis",
+			rules:   "(title ~ \"foo\" OR url contains \"bar\"",
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -53,3 +72,61 @@ func TestIsValidFilterRules(t *testing.T) {
 		})
 	}
 }
+
+func TestIsValidActionRules(t *testing.T) {
+	tests := []struct {
+		name    string
+		rules   string
+		wantErr bool
+	}{
+		{
+			name:    "empty rules",
+			rules:   "",
+			wantErr: false,
+		},
+		{
+			name:    "valid rules with blank lines",
+			rules:   "EntryTitle=(?i)cve => star, tag:security\n\nEntryAuthor=Bob => integration:wallabag, notify",
+			wantErr: false,
+		},
+		{
+			name:    "missing actions",
+			rules:   "EntryTitle=foo",
+			wantErr: true,
+		},
+		{
+			name:    "unknown action",
+			rules:   "EntryTitle=foo => archive",
+			wantErr: true,
+		},
+		{
+			name:    "unknown integration",
+			rules:   "EntryTitle=foo => integration:unknown",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tc := tt
+		t.Run(tc.name, func(t *testing.T) {
+			err := IsValidActionRules(tc.rules)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("expected error=%v, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestValidateFilterApplyRequest(t *testing.T) {
+	if err := ValidateFilterApplyRequest(nil, 1, &model.FilterApplyRequest{Outcome: "star"}); err == nil {
+		t.Error(`An unknown outcome should be rejected`)
+	}
+
+	if err := ValidateFilterApplyRequest(nil, 1, &model.FilterApplyRequest{Outcome: model.FilterOutcomeRead, FeedID: 1, CategoryID: 1}); err == nil {
+		t.Error(`A request scoped to both a feed and a category should be rejected`)
+	}
+
+	if err := ValidateFilterApplyRequest(nil, 1, &model.FilterApplyRequest{Outcome: model.FilterOutcomeRemove}); err != nil {
+		t.Errorf(`A request scoped to the whole account should be accepted, got %v`, err)
+	}
+}
@@ -0,0 +1,24 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package validator // import "miniflux.app/v2/internal/validator"
+
+import (
+	"strings"
+
+	"miniflux.app/v2/internal/locale"
+	"miniflux.app/v2/internal/model"
+)
+
+// ValidateHighlightCreation validates highlight creation.
+func ValidateHighlightCreation(request *model.HighlightCreationRequest) *locale.LocalizedError {
+	if strings.TrimSpace(request.Quote) == "" {
+		return locale.NewLocalizedError("error.highlight_quote_required")
+	}
+
+	if request.StartOffset < 0 || request.EndOffset < request.StartOffset {
+		return locale.NewLocalizedError("error.highlight_invalid_offsets")
+	}
+
+	return nil
+}
@@ -0,0 +1,32 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package validator // import "miniflux.app/v2/internal/validator"
+
+import (
+	"reflect"
+	"testing"
+
+	"miniflux.app/v2/internal/locale"
+	"miniflux.app/v2/internal/model"
+)
+
+func TestValidateHighlightCreation(t *testing.T) {
+	scenarios := map[string]struct {
+		request  model.HighlightCreationRequest
+		expected *locale.LocalizedError
+	}{
+		"valid":            {model.HighlightCreationRequest{Quote: "quote", StartOffset: 10, EndOffset: 15}, nil},
+		"without offsets":  {model.HighlightCreationRequest{Quote: "quote"}, nil},
+		"empty quote":      {model.HighlightCreationRequest{Quote: "  ", StartOffset: 10, EndOffset: 12}, locale.NewLocalizedError("error.highlight_quote_required")},
+		"negative offset":  {model.HighlightCreationRequest{Quote: "quote", StartOffset: -1, EndOffset: 4}, locale.NewLocalizedError("error.highlight_invalid_offsets")},
+		"reversed offsets": {model.HighlightCreationRequest{Quote: "quote", StartOffset: 15, EndOffset: 10}, locale.NewLocalizedError("error.highlight_invalid_offsets")},
+	}
+
+	for name, scenario := range scenarios {
+		result := ValidateHighlightCreation(&scenario.request)
+		if !reflect.DeepEqual(result, scenario.expected) {
+			t.Errorf(`%s: got %v instead of %v`, name, result, scenario.expected)
+		}
+	}
+}
@@ -0,0 +1,40 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package validator // import "miniflux.app/v2/internal/validator"
+
+import (
+	"strings"
+
+	"miniflux.app/v2/internal/locale"
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/storage"
+)
+
+// ValidateLabelCreation validates label creation.
+func ValidateLabelCreation(store *storage.Storage, userID int64, request *model.LabelCreationRequest) *locale.LocalizedError {
+	if strings.TrimSpace(request.Title) == "" {
+		return locale.NewLocalizedError("error.title_required")
+	}
+
+	if store.LabelTitleExists(userID, request.Title) {
+		return locale.NewLocalizedError("error.label_already_exists")
+	}
+
+	return nil
+}
+
+// ValidateLabelModification validates label modification.
+func ValidateLabelModification(store *storage.Storage, userID, labelID int64, request *model.LabelModificationRequest) *locale.LocalizedError {
+	if request.Title != nil {
+		if strings.TrimSpace(*request.Title) == "" {
+			return locale.NewLocalizedError("error.title_required")
+		}
+
+		if store.AnotherLabelExists(userID, labelID, *request.Title) {
+			return locale.NewLocalizedError("error.label_already_exists")
+		}
+	}
+
+	return nil
+}
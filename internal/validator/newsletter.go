@@ -0,0 +1,63 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package validator // import "miniflux.app/v2/internal/validator"
+
+import (
+	"log/slog"
+	"strings"
+
+	"miniflux.app/v2/internal/locale"
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/storage"
+)
+
+// ValidateNewsletterAddressCreation ensures newsletter address creation requests have a unique description,
+// a valid category and well-formed allowed senders.
+func ValidateNewsletterAddressCreation(store *storage.Storage, userID int64, request *model.NewsletterAddressCreationRequest) *locale.LocalizedError {
+	if request.Description == "" || request.CategoryID == 0 {
+		return locale.NewLocalizedError("error.fields_mandatory")
+	}
+
+	if store.NewsletterAddressDescriptionExists(userID, request.Description) {
+		return locale.NewLocalizedError("error.newsletter_address_already_exists")
+	}
+
+	categoryExists, err := store.CategoryIDExists(userID, request.CategoryID)
+	if err != nil {
+		slog.Error("validator: unable to check if newsletter address category exists",
+			slog.Int64("user_id", userID),
+			slog.Int64("category_id", request.CategoryID),
+			slog.Any("error", err),
+		)
+	}
+
+	if !categoryExists {
+		return locale.NewLocalizedError("error.category_not_found")
+	}
+
+	for _, allowedSender := range request.AllowedSenders {
+		if !isValidAllowedSender(allowedSender) {
+			return locale.NewLocalizedError("error.invalid_allowed_sender", allowedSender)
+		}
+	}
+
+	return nil
+}
+
+// isValidAllowedSender accepts complete email addresses and domain names.
+func isValidAllowedSender(allowedSender string) bool {
+	if allowedSender == "" || strings.ContainsAny(allowedSender, " \t<>,;") {
+		return false
+	}
+
+	domain := allowedSender
+	if localPart, addressDomain, found := strings.Cut(allowedSender, "@"); found {
+		if localPart == "" || strings.Contains(addressDomain, "@") {
+			return false
+		}
+		domain = addressDomain
+	}
+
+	return strings.Contains(domain, ".") && !strings.HasPrefix(domain, ".") && !strings.HasSuffix(domain, ".")
+}
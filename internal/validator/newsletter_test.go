@@ -0,0 +1,27 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package validator // import "miniflux.app/v2/internal/validator"
+
+import "testing"
+
+func TestIsValidAllowedSender(t *testing.T) {
+	scenarios := map[string]bool{
+		"news@example.org":      true,
+		"example.org":           true,
+		"mail.example.co.uk":    true,
+		"":                      false,
+		"localhost":             false,
+		"@example.org":          false,
+		"a@b@example.org":       false,
+		"news@example":          false,
+		"news@example.org, foo": false,
+		".example.org":          false,
+	}
+
+	for input, expected := range scenarios {
+		if result := isValidAllowedSender(input); result != expected {
+			t.Errorf(`Unexpected result for %q: got %v instead of %v`, input, result, expected)
+		}
+	}
+}
@@ -0,0 +1,44 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package validator // import "miniflux.app/v2/internal/validator"
+
+import (
+	"log/slog"
+
+	"miniflux.app/v2/internal/locale"
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/storage"
+)
+
+// ValidatePublicFeedCreation ensures public feed creation requests have a unique title and a valid category.
+func ValidatePublicFeedCreation(store *storage.Storage, userID int64, request *model.PublicFeedCreationRequest) *locale.LocalizedError {
+	if request.Title == "" {
+		return locale.NewLocalizedError("error.fields_mandatory")
+	}
+
+	if store.PublicFeedTitleExists(userID, request.Title) {
+		return locale.NewLocalizedError("error.public_feed_already_exists")
+	}
+
+	if request.CategoryID < 0 {
+		return locale.NewLocalizedError("error.category_not_found")
+	}
+
+	if request.CategoryID > 0 {
+		categoryExists, err := store.CategoryIDExists(userID, request.CategoryID)
+		if err != nil {
+			slog.Error("validator: unable to check if public feed category exists",
+				slog.Int64("user_id", userID),
+				slog.Int64("category_id", request.CategoryID),
+				slog.Any("error", err),
+			)
+		}
+
+		if !categoryExists {
+			return locale.NewLocalizedError("error.category_not_found")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,105 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package validator // import "miniflux.app/v2/internal/validator"
+
+import (
+	"log/slog"
+	"strings"
+
+	"miniflux.app/v2/internal/locale"
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/storage"
+)
+
+// ValidateSavedSearchCreation validates saved search creation.
+func ValidateSavedSearchCreation(store *storage.Storage, userID int64, request *model.SavedSearchCreationRequest) *locale.LocalizedError {
+	if strings.TrimSpace(request.Title) == "" {
+		return locale.NewLocalizedError("error.title_required")
+	}
+
+	if store.SavedSearchTitleExists(userID, request.Title) {
+		return locale.NewLocalizedError("error.saved_search_already_exists")
+	}
+
+	return validateSavedSearchFilters(store, userID, request.Statuses, request.CategoryIDs, request.FeedIDs, request.MaxAgeDays)
+}
+
+// ValidateSavedSearchModification validates saved search modification.
+func ValidateSavedSearchModification(store *storage.Storage, userID int64, savedSearch *model.SavedSearch, request *model.SavedSearchModificationRequest) *locale.LocalizedError {
+	if request.Title != nil {
+		if strings.TrimSpace(*request.Title) == "" {
+			return locale.NewLocalizedError("error.title_required")
+		}
+
+		if store.AnotherSavedSearchExists(userID, savedSearch.ID, *request.Title) {
+			return locale.NewLocalizedError("error.saved_search_already_exists")
+		}
+	}
+
+	var statuses []string
+	if request.Statuses != nil {
+		statuses = *request.Statuses
+	}
+
+	var categoryIDs []int64
+	if request.CategoryIDs != nil {
+		categoryIDs = *request.CategoryIDs
+	}
+
+	var feedIDs []int64
+	if request.FeedIDs != nil {
+		feedIDs = *request.FeedIDs
+	}
+
+	var maxAgeDays int
+	if request.MaxAgeDays != nil {
+		maxAgeDays = *request.MaxAgeDays
+	}
+
+	return validateSavedSearchFilters(store, userID, statuses, categoryIDs, feedIDs, maxAgeDays)
+}
+
+func validateSavedSearchFilters(store *storage.Storage, userID int64, statuses []string, categoryIDs, feedIDs []int64, maxAgeDays int) *locale.LocalizedError {
+	for _, status := range statuses {
+		if err := ValidateEntryStatus(status); err != nil {
+			return locale.NewLocalizedError("error.invalid_saved_search_status")
+		}
+	}
+
+	for _, categoryID := range categoryIDs {
+		categoryExists, err := store.CategoryIDExists(userID, categoryID)
+		if err != nil {
+			slog.Error("validator: unable to check if saved search category exists",
+				slog.Int64("user_id", userID),
+				slog.Int64("category_id", categoryID),
+				slog.Any("error", err),
+			)
+		}
+
+		if !categoryExists {
+			return locale.NewLocalizedError("error.category_not_found")
+		}
+	}
+
+	for _, feedID := range feedIDs {
+		feedExists, err := store.FeedExists(userID, feedID)
+		if err != nil {
+			slog.Error("validator: unable to check if saved search feed exists",
+				slog.Int64("user_id", userID),
+				slog.Int64("feed_id", feedID),
+				slog.Any("error", err),
+			)
+		}
+
+		if !feedExists {
+			return locale.NewLocalizedError("error.feed_not_found")
+		}
+	}
+
+	if maxAgeDays < 0 {
+		return locale.NewLocalizedError("error.invalid_saved_search_max_age")
+	}
+
+	return nil
+}
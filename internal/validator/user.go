@@ -104,6 +104,12 @@ func ValidateUserModification(store *storage.Storage, userID int64, changes *mod
 		}
 	}
 
+	if changes.EntryListLayout != nil {
+		if err := validateEntryListLayout(*changes.EntryListLayout); err != nil {
+			return err
+		}
+	}
+
 	if changes.DefaultReadingSpeed != nil {
 		if err := validateReadingSpeed(*changes.DefaultReadingSpeed); err != nil {
 			return err
@@ -144,6 +150,12 @@ func ValidateUserModification(store *storage.Storage, userID int64, changes *mod
 		}
 	}
 
+	if changes.ActionEntryRules != nil {
+		if err := IsValidActionRules(*changes.ActionEntryRules); err != nil {
+			return err
+		}
+	}
+
 	if changes.ExternalFontHosts != nil {
 		if !IsValidDomainList(*changes.ExternalFontHosts) {
 			return locale.NewLocalizedError("error.settings_invalid_domain_list")
@@ -248,6 +260,13 @@ func validateGestureNav(gestureNav string) *locale.LocalizedError {
 	return nil
 }
 
+func validateEntryListLayout(entryListLayout string) *locale.LocalizedError {
+	if entryListLayout != "list" && entryListLayout != "cards" {
+		return locale.NewLocalizedError("error.invalid_entry_list_layout")
+	}
+	return nil
+}
+
 func validateDefaultHomePage(defaultHomePage string) *locale.LocalizedError {
 	defaultHomePages := model.HomePages()
 	if _, found := defaultHomePages[defaultHomePage]; !found {
@@ -178,6 +178,18 @@ func TestValidateGestureNav(t *testing.T) {
 	}
 }
 
+func TestValidateEntryListLayout(t *testing.T) {
+	for _, layout := range []string{"list", "cards"} {
+		if err := validateEntryListLayout(layout); err != nil {
+			t.Errorf("expected valid layout %q to pass, got %v", layout, err)
+		}
+	}
+
+	if err := validateEntryListLayout("masonry"); err == nil {
+		t.Error("expected invalid layout to fail")
+	}
+}
+
 func TestValidateDefaultHomePage(t *testing.T) {
 	if err := validateDefaultHomePage("unread"); err != nil {
 		t.Errorf("expected valid home page to pass, got %v", err)
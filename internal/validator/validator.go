@@ -10,6 +10,8 @@ import (
 	"strings"
 
 	"miniflux.app/v2/internal/model"
+
+	"github.com/andybalholm/cascadia"
 )
 
 var domainRegex = regexp.MustCompile(`^(?:[a-z0-9](?:[a-z0-9-]{0,61}[a-z0-9])?\.)+[a-z]{2,}$`)
@@ -47,6 +49,12 @@ func IsValidRegex(expr string) bool {
 	return err == nil
 }
 
+// IsValidCSSSelector verifies if the CSS selector can be compiled.
+func IsValidCSSSelector(selector string) bool {
+	_, err := cascadia.Compile(selector)
+	return err == nil
+}
+
 // IsValidDomain verifies a single domain name against length and character constraints.
 func IsValidDomain(domain string) bool {
 	domain = strings.ToLower(domain)
@@ -0,0 +1,228 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package websub // import "miniflux.app/v2/internal/websub"
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"hash"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"miniflux.app/v2/internal/config"
+	"miniflux.app/v2/internal/http/request"
+	"miniflux.app/v2/internal/http/response"
+	"miniflux.app/v2/internal/model"
+	feedHandler "miniflux.app/v2/internal/reader/handler"
+	"miniflux.app/v2/internal/storage"
+)
+
+// NewHandler returns an http.Handler for the WebSub subscriber callbacks.
+// The returned handler expects the base path to be stripped from the request URL.
+func NewHandler(store *storage.Storage) http.Handler {
+	h := &handler{store: store}
+	h.refreshFeed = h.refreshFeedWithPushedContent
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /websub/{callbackToken}", h.verifyIntent)
+	mux.HandleFunc("POST /websub/{callbackToken}", h.receiveContent)
+	return mux
+}
+
+type handler struct {
+	store       *storage.Storage
+	refreshFeed func(userID, feedID int64, body []byte)
+}
+
+// verifyIntent answers the hub verification of a subscription request.
+//
+// Specs: https://www.w3.org/TR/websub/#hub-verifies-intent
+func (h *handler) verifyIntent(w http.ResponseWriter, r *http.Request) {
+	if subscription := h.findSubscription(w, r); subscription != nil {
+		h.verifySubscriptionIntent(w, r, subscription)
+	}
+}
+
+func (h *handler) verifySubscriptionIntent(w http.ResponseWriter, r *http.Request, subscription *model.WebSubSubscription) {
+	mode := r.URL.Query().Get("hub.mode")
+	topic := r.URL.Query().Get("hub.topic")
+
+	if topic != subscription.TopicURL {
+		slog.Warn("[WebSub] Verification request for an unexpected topic",
+			slog.Int64("feed_id", subscription.FeedID),
+			slog.String("expected_topic", subscription.TopicURL),
+			slog.String("topic", topic),
+		)
+		writeStatus(w, r, http.StatusNotFound)
+		return
+	}
+
+	switch mode {
+	case "subscribe":
+		challenge := r.URL.Query().Get("hub.challenge")
+		if challenge == "" {
+			slog.Warn("[WebSub] Verification request without challenge",
+				slog.Int64("feed_id", subscription.FeedID),
+			)
+			writeStatus(w, r, http.StatusBadRequest)
+			return
+		}
+
+		leaseDuration := config.Opts.WebSubLeaseDuration()
+		if leaseSeconds, err := strconv.Atoi(r.URL.Query().Get("hub.lease_seconds")); err == nil && leaseSeconds > 0 {
+			leaseDuration = time.Duration(leaseSeconds) * time.Second
+		}
+
+		if err := h.store.ActivateWebSubSubscription(subscription.FeedID, leaseDuration); err != nil {
+			slog.Error("[WebSub] Unable to activate subscription",
+				slog.Int64("feed_id", subscription.FeedID),
+				slog.Any("error", err),
+			)
+			writeStatus(w, r, http.StatusInternalServerError)
+			return
+		}
+
+		slog.Debug("[WebSub] Subscription verified",
+			slog.Int64("user_id", subscription.UserID),
+			slog.Int64("feed_id", subscription.FeedID),
+			slog.String("hub_url", subscription.HubURL),
+			slog.Duration("lease_duration", leaseDuration),
+		)
+
+		response.Text(w, r, challenge)
+	case "denied":
+		if err := h.store.DenyWebSubSubscription(subscription.FeedID); err != nil {
+			slog.Error("[WebSub] Unable to deny subscription",
+				slog.Int64("feed_id", subscription.FeedID),
+				slog.Any("error", err),
+			)
+			writeStatus(w, r, http.StatusInternalServerError)
+			return
+		}
+
+		slog.Warn("[WebSub] Subscription denied by the hub",
+			slog.Int64("user_id", subscription.UserID),
+			slog.Int64("feed_id", subscription.FeedID),
+			slog.String("hub_url", subscription.HubURL),
+			slog.String("reason", r.URL.Query().Get("hub.reason")),
+		)
+
+		response.Text(w, r, "")
+	default:
+		// Miniflux never unsubscribes on its own: refuse any other request.
+		writeStatus(w, r, http.StatusNotFound)
+	}
+}
+
+// receiveContent processes the content distributed by the hub.
+//
+// Specs: https://www.w3.org/TR/websub/#content-distribution
+func (h *handler) receiveContent(w http.ResponseWriter, r *http.Request) {
+	if subscription := h.findSubscription(w, r); subscription != nil {
+		h.receiveSubscriptionContent(w, r, subscription)
+	}
+}
+
+func (h *handler) receiveSubscriptionContent(w http.ResponseWriter, r *http.Request, subscription *model.WebSubSubscription) {
+	body, err := io.ReadAll(io.LimitReader(r.Body, config.Opts.HTTPClientMaxBodySize()))
+	if err != nil {
+		writeStatus(w, r, http.StatusBadRequest)
+		return
+	}
+
+	// The subscriber must acknowledge the request even when the signature does not match,
+	// but the content is discarded.
+	if !isValidSignature(subscription.Secret, r.Header.Get("X-Hub-Signature"), body) {
+		slog.Warn("[WebSub] Ignoring pushed content with an invalid signature",
+			slog.Int64("feed_id", subscription.FeedID),
+			slog.String("client_ip", request.ClientIP(r)),
+		)
+		writeStatus(w, r, http.StatusAccepted)
+		return
+	}
+
+	// The hub may keep distributing content after the end of the lease, when the renewal failed for example.
+	if !subscription.IsActive() {
+		slog.Debug("[WebSub] Ignoring pushed content for an inactive or expired subscription",
+			slog.Int64("feed_id", subscription.FeedID),
+			slog.String("state", subscription.State),
+			slog.Any("lease_expires_at", subscription.LeaseExpiresAt),
+		)
+		writeStatus(w, r, http.StatusAccepted)
+		return
+	}
+
+	go h.refreshFeed(subscription.UserID, subscription.FeedID, body)
+
+	writeStatus(w, r, http.StatusAccepted)
+}
+
+func (h *handler) refreshFeedWithPushedContent(userID, feedID int64, body []byte) {
+	if localizedError := feedHandler.RefreshFeedWithPushedContent(h.store, userID, feedID, body); localizedError != nil {
+		slog.Warn("[WebSub] Unable to process pushed content",
+			slog.Int64("user_id", userID),
+			slog.Int64("feed_id", feedID),
+			slog.Any("error", localizedError.Error()),
+		)
+	}
+}
+
+func (h *handler) findSubscription(w http.ResponseWriter, r *http.Request) *model.WebSubSubscription {
+	subscription, err := h.store.WebSubSubscriptionByCallbackToken(request.RouteStringParam(r, "callbackToken"))
+	if err != nil {
+		slog.Error("[WebSub] Unable to fetch subscription", slog.Any("error", err))
+		writeStatus(w, r, http.StatusInternalServerError)
+		return nil
+	}
+
+	if subscription == nil {
+		writeStatus(w, r, http.StatusNotFound)
+		return nil
+	}
+
+	return subscription
+}
+
+func writeStatus(w http.ResponseWriter, r *http.Request, statusCode int) {
+	response.NewBuilder(w, r).WithStatus(statusCode).Write()
+}
+
+// isValidSignature checks the X-Hub-Signature header, formatted as "method=signature",
+// against the HMAC of the body computed with the subscription secret.
+func isValidSignature(secret, signatureHeader string, body []byte) bool {
+	method, signature, found := strings.Cut(signatureHeader, "=")
+	if !found || secret == "" {
+		return false
+	}
+
+	var hashFunc func() hash.Hash
+	switch strings.ToLower(method) {
+	case "sha1":
+		hashFunc = sha1.New
+	case "sha256":
+		hashFunc = sha256.New
+	case "sha384":
+		hashFunc = sha512.New384
+	case "sha512":
+		hashFunc = sha512.New
+	default:
+		return false
+	}
+
+	expectedSignature, err := hex.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+
+	mac := hmac.New(hashFunc, []byte(secret))
+	mac.Write(body)
+	return hmac.Equal(mac.Sum(nil), expectedSignature)
+}
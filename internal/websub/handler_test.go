@@ -0,0 +1,162 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package websub // import "miniflux.app/v2/internal/websub"
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
+	"hash"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"miniflux.app/v2/internal/config"
+	"miniflux.app/v2/internal/model"
+)
+
+func sign(hashFunc func() hash.Hash, secret string, body []byte) string {
+	mac := hmac.New(hashFunc, []byte(secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestIsValidSignature(t *testing.T) {
+	body := []byte(`<feed xmlns="http://www.w3.org/2005/Atom"></feed>`)
+
+	scenarios := []struct {
+		name     string
+		secret   string
+		header   string
+		expected bool
+	}{
+		{"sha1", "secret", "sha1=" + sign(sha1.New, "secret", body), true},
+		{"sha256", "secret", "sha256=" + sign(sha256.New, "secret", body), true},
+		{"uppercase method", "secret", "SHA256=" + sign(sha256.New, "secret", body), true},
+		{"wrong secret", "secret", "sha256=" + sign(sha256.New, "other", body), false},
+		{"unsupported method", "secret", "md5=" + sign(sha256.New, "secret", body), false},
+		{"invalid hexadecimal", "secret", "sha256=zz", false},
+		{"missing method", "secret", sign(sha256.New, "secret", body), false},
+		{"empty header", "secret", "", false},
+		{"empty secret", "", "sha256=" + sign(sha256.New, "", body), false},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			if result := isValidSignature(scenario.secret, scenario.header, body); result != scenario.expected {
+				t.Errorf("Expected %v, got %v", scenario.expected, result)
+			}
+		})
+	}
+}
+
+func newTestSubscription(leaseExpiresAt time.Time) *model.WebSubSubscription {
+	return &model.WebSubSubscription{
+		FeedID:         1,
+		UserID:         1,
+		HubURL:         "https://hub.example.org/",
+		TopicURL:       "https://example.org/feed.xml",
+		Secret:         "secret",
+		CallbackToken:  "token",
+		State:          model.WebSubStateActive,
+		LeaseExpiresAt: &leaseExpiresAt,
+	}
+}
+
+func TestVerifyIntentRejectsInvalidRequests(t *testing.T) {
+	subscription := newTestSubscription(time.Now().Add(time.Hour))
+
+	scenarios := []struct {
+		name     string
+		query    url.Values
+		expected int
+	}{
+		{"wrong topic", url.Values{"hub.mode": {"subscribe"}, "hub.topic": {"https://example.org/other.xml"}, "hub.challenge": {"challenge"}}, http.StatusNotFound},
+		{"missing topic", url.Values{"hub.mode": {"subscribe"}, "hub.challenge": {"challenge"}}, http.StatusNotFound},
+		{"unsubscribe mode", url.Values{"hub.mode": {"unsubscribe"}, "hub.topic": {subscription.TopicURL}, "hub.challenge": {"challenge"}}, http.StatusNotFound},
+		{"unknown mode", url.Values{"hub.mode": {"other"}, "hub.topic": {subscription.TopicURL}, "hub.challenge": {"challenge"}}, http.StatusNotFound},
+		{"missing challenge", url.Values{"hub.mode": {"subscribe"}, "hub.topic": {subscription.TopicURL}}, http.StatusBadRequest},
+		{"empty challenge", url.Values{"hub.mode": {"subscribe"}, "hub.topic": {subscription.TopicURL}, "hub.challenge": {""}}, http.StatusBadRequest},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "/websub/token?"+scenario.query.Encode(), nil)
+			w := httptest.NewRecorder()
+
+			// The store is not set: the request must be rejected before the subscription is activated.
+			h := &handler{}
+			h.verifySubscriptionIntent(w, r, subscription)
+
+			if w.Code != scenario.expected {
+				t.Errorf(`Unexpected status code, got %d instead of %d`, w.Code, scenario.expected)
+			}
+
+			if strings.Contains(w.Body.String(), "challenge") {
+				t.Errorf(`The challenge should not be echoed, got %q`, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestReceiveContent(t *testing.T) {
+	var err error
+	if config.Opts, err = config.NewConfigParser().ParseEnvironmentVariables(); err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	body := `<feed xmlns="http://www.w3.org/2005/Atom"></feed>`
+
+	scenarios := []struct {
+		name           string
+		subscription   *model.WebSubSubscription
+		signature      string
+		expectsRefresh bool
+	}{
+		{"valid signature", newTestSubscription(time.Now().Add(time.Hour)), "sha256=" + sign(sha256.New, "secret", []byte(body)), true},
+		{"wrong signature", newTestSubscription(time.Now().Add(time.Hour)), "sha256=" + sign(sha256.New, "other", []byte(body)), false},
+		{"missing signature", newTestSubscription(time.Now().Add(time.Hour)), "", false},
+		{"expired lease", newTestSubscription(time.Now().Add(-time.Minute)), "sha256=" + sign(sha256.New, "secret", []byte(body)), false},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			refreshed := make(chan string, 1)
+			h := &handler{refreshFeed: func(userID, feedID int64, body []byte) {
+				refreshed <- string(body)
+			}}
+
+			r := httptest.NewRequest(http.MethodPost, "/websub/token", strings.NewReader(body))
+			r.Header.Set("X-Hub-Signature", scenario.signature)
+			w := httptest.NewRecorder()
+
+			h.receiveSubscriptionContent(w, r, scenario.subscription)
+
+			if w.Code != http.StatusAccepted {
+				t.Errorf(`Unexpected status code, got %d instead of %d`, w.Code, http.StatusAccepted)
+			}
+
+			if !scenario.expectsRefresh {
+				// The refresh is started before the handler returns, discarded content never reaches it.
+				if len(refreshed) != 0 {
+					t.Error(`The pushed content should be discarded`)
+				}
+				return
+			}
+
+			select {
+			case pushedContent := <-refreshed:
+				if pushedContent != body {
+					t.Errorf(`Unexpected pushed content, got %q`, pushedContent)
+				}
+			case <-time.After(5 * time.Second):
+				t.Error(`The feed should be refreshed with the pushed content`)
+			}
+		})
+	}
+}